@@ -13,15 +13,20 @@ import (
 	"g.echo.tech/dev/sac/internal/admin"
 	"g.echo.tech/dev/sac/internal/agent"
 	"g.echo.tech/dev/sac/internal/auth"
+	"g.echo.tech/dev/sac/internal/claudesettings"
 	"g.echo.tech/dev/sac/internal/container"
 	"g.echo.tech/dev/sac/internal/ctxkeys"
 	"g.echo.tech/dev/sac/internal/database"
 	"g.echo.tech/dev/sac/internal/group"
 	"g.echo.tech/dev/sac/internal/history"
+	"g.echo.tech/dev/sac/internal/mcp"
+	"g.echo.tech/dev/sac/internal/notify"
 	sacredis "g.echo.tech/dev/sac/internal/redis"
 	"g.echo.tech/dev/sac/internal/session"
 	"g.echo.tech/dev/sac/internal/skill"
 	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/subagent"
+	"g.echo.tech/dev/sac/internal/webhook"
 	"g.echo.tech/dev/sac/internal/workspace"
 	"g.echo.tech/dev/sac/pkg/config"
 	"g.echo.tech/dev/sac/pkg/logger"
@@ -61,6 +66,7 @@ func main() {
 	// Initialize Redis (optional)
 	var outputHub *workspace.OutputHub
 	var syncHub *skill.SyncHub
+	var taskHub *session.TaskHub
 	if cfg.RedisURL == "" {
 		log.Warn().Msg("REDIS_URL not set, output watch disabled")
 	} else if err := sacredis.Initialize(cfg.RedisURL); err != nil {
@@ -69,10 +75,12 @@ func main() {
 		defer sacredis.Close()
 		outputHub = workspace.NewOutputHub(sacredis.Client)
 		syncHub = skill.NewSyncHub(sacredis.Client)
+		taskHub = session.NewTaskHub(sacredis.Client)
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		go outputHub.Start(ctx)
 		go syncHub.Start(ctx)
+		go taskHub.Start(ctx)
 	}
 
 	// ---- gRPC Server (in-process, no network listener) ----
@@ -87,6 +95,11 @@ func main() {
 		syncService.SetPublisher(syncHub)
 	}
 
+	// Outbound user notifications (webhook / email / Slack)
+	notifier := notify.NewNotifier(database.DB)
+	notifier.SetAllowPrivateTargets(cfg.NotifyAllowPrivateTargets)
+	syncService.SetNotifier(notifier)
+
 	// Register all gRPC service implementations
 	authServer := auth.NewServer(database.DB, jwtService, settingsService)
 	sacv1.RegisterAuthServiceServer(grpcServer, authServer)
@@ -99,20 +112,48 @@ func main() {
 	sacv1.RegisterAdminGroupServiceServer(grpcServer, groupServer)
 
 	historyServer := history.NewServer(database.DB)
+	historyServer.SetNotifier(notifier)
 	sacv1.RegisterHistoryServiceServer(grpcServer, historyServer)
 
 	agentServer := agent.NewServer(database.DB, containerMgr, syncService, settingsService, syncHub)
 	sacv1.RegisterAgentServiceServer(grpcServer, agentServer)
 
-	sessionServer := session.NewServer(database.DB, containerMgr, syncService, settingsService, storageProvider)
+	sessionServer := session.NewServer(database.DB, containerMgr, syncService, settingsService, storageProvider, taskHub)
+	if taskHub != nil {
+		sessionServer.SetRedis(sacredis.Client)
+	}
 	sacv1.RegisterSessionServiceServer(grpcServer, sessionServer)
 
 	adminServer := admin.NewServer2(database.DB, containerMgr, fmt.Sprintf("%s/%s", cfg.DockerRegistry, cfg.DockerImage))
 	sacv1.RegisterAdminServiceServer(grpcServer, adminServer)
 
-	workspaceServer := workspace.NewWorkspaceServer(database.DB, storageProvider, outputHub)
+	// Wrong share link passwords are counted in Redis when it is available,
+	// and shared by the gRPC meta RPC and the download route either way.
+	shareGuard := workspace.NewShareGuard(nil)
+	if outputHub != nil {
+		shareGuard = workspace.NewShareGuard(sacredis.Client)
+	}
+
+	workspaceServer := workspace.NewWorkspaceServer(database.DB, storageProvider, outputHub, containerMgr)
+	workspaceServer.SetNotifier(notifier)
+	workspaceServer.SetShareGuard(shareGuard)
 	sacv1.RegisterWorkspaceServiceServer(grpcServer, workspaceServer)
 
+	webhookServer := webhook.NewServer(database.DB)
+	sacv1.RegisterWebhookServiceServer(grpcServer, webhookServer)
+
+	notificationServer := notify.NewServer(database.DB, notifier)
+	sacv1.RegisterNotificationServiceServer(grpcServer, notificationServer)
+
+	mcpServer := mcp.NewServer(database.DB, mcp.NewSyncer(database.DB, containerMgr))
+	sacv1.RegisterMCPServiceServer(grpcServer, mcpServer)
+
+	subagentServer := subagent.NewServer(database.DB, subagent.NewSyncer(database.DB, containerMgr))
+	sacv1.RegisterSubagentServiceServer(grpcServer, subagentServer)
+
+	claudeSettingsServer := claudesettings.NewServer(database.DB)
+	sacv1.RegisterClaudeSettingsServiceServer(grpcServer, claudeSettingsServer)
+
 	// ---- gRPC-Gateway Mux (in-process calls) ----
 	ctx := context.Background()
 	gwMux := runtime.NewServeMux(
@@ -137,6 +178,11 @@ func main() {
 	must(sacv1.RegisterSessionServiceHandlerServer(ctx, gwMux, sessionServer))
 	must(sacv1.RegisterAdminServiceHandlerServer(ctx, gwMux, adminServer))
 	must(sacv1.RegisterWorkspaceServiceHandlerServer(ctx, gwMux, workspaceServer))
+	must(sacv1.RegisterWebhookServiceHandlerServer(ctx, gwMux, webhookServer))
+	must(sacv1.RegisterNotificationServiceHandlerServer(ctx, gwMux, notificationServer))
+	must(sacv1.RegisterMCPServiceHandlerServer(ctx, gwMux, mcpServer))
+	must(sacv1.RegisterSubagentServiceHandlerServer(ctx, gwMux, subagentServer))
+	must(sacv1.RegisterClaudeSettingsServiceHandlerServer(ctx, gwMux, claudeSettingsServer))
 
 	// ---- Gin Router (special endpoints only) ----
 	router := gin.Default()
@@ -161,8 +207,10 @@ func main() {
 		c.JSON(200, gin.H{"status": "healthy"})
 	})
 
-	// Workspace handler for output file download/WS/SSE endpoints
-	workspaceHandler := workspace.NewHandler(database.DB, storageProvider, outputHub, jwtService)
+	// Workspace handler for output download, input upload and WS endpoints
+	workspaceHandler := workspace.NewHandler(database.DB, storageProvider, outputHub, jwtService, containerMgr)
+	workspaceHandler.SetNotifier(notifier)
+	workspaceHandler.SetShareGuard(shareGuard)
 
 	// Internal routes (no JWT, pod-internal calls) — only multipart upload
 	internalGroup := router.Group("/api/internal")
@@ -170,13 +218,19 @@ func main() {
 
 	// History internal route (pod-internal, no JWT)
 	historyHandler := history.NewHandler(database.DB)
+	historyHandler.SetNotifier(notifier)
 	historyHandler.RegisterInternalRoutes(internalGroup)
 
 	// Public routes (no auth) — WS and shared file download
 	router.GET("/api/workspace/output/watch", workspaceHandler.WatchOutput)
-	router.GET("/api/skill-sync/watch", skill.WatchSync(syncHub, jwtService))
+	router.GET("/api/skill-sync/watch", skill.WatchSync(syncHub, syncService, jwtService))
 	router.GET("/api/s/:code/raw", workspaceHandler.RequireOSS(), workspaceHandler.DownloadSharedFile)
 
+	// Inbound webhooks (token in path + signature, no JWT)
+	webhookHandler := webhook.NewHandler(database.DB, sessionServer)
+	router.POST("/api/hooks/:token", webhookHandler.Receive)
+	router.POST("/api/skill-sources/hooks/:token", skillHandler.SourceWebhook)
+
 	// Protected file routes (JWT auth + multipart/streaming)
 	protected := router.Group("/api")
 	protected.Use(auth.AuthMiddleware(jwtService))
@@ -184,9 +238,16 @@ func main() {
 		ws := protected.Group("/workspace")
 		ws.GET("/output/files/download", workspaceHandler.RequireOSS(), workspaceHandler.DownloadOutputFile)
 		ws.POST("/output/files", workspaceHandler.RequireOSS(), workspaceHandler.UploadOutputFile)
+		workspaceHandler.RegisterInputRoutes(ws)
+		ws.GET("/pod/files/download", workspaceHandler.DownloadPodFile)
 
 		// Skill file management (multipart upload, not suitable for gRPC-gateway)
 		skillHandler.RegisterFileRoutes(protected)
+		skillHandler.RegisterArchiveRoutes(protected)
+
+		// Headless task events (SSE, not suitable for gRPC-gateway)
+		taskHandler := session.NewTaskHandler(database.DB, taskHub)
+		protected.GET("/tasks/:task_id/events", taskHandler.StreamTask)
 
 		// CSV exports (streaming response, not suitable for gRPC-gateway)
 		protected.GET("/conversations/export", historyHandler.ExportConversations)
@@ -200,6 +261,15 @@ func main() {
 	// Fallback: all unmatched routes go to gRPC-gateway with JWT auth injected.
 	router.NoRoute(gatewayAuthMiddleware(jwtService, gwMux))
 
+	// Agent pod crash notifications (Redis dedupes across replicas)
+	go notifier.WatchPodCrashes(context.Background(), containerMgr, sacredis.Client)
+
+	// In-process scheduler for per-agent scheduled jobs
+	go sessionServer.StartScheduler(context.Background())
+
+	// Stop sessions idle past their timeout
+	go sessionServer.StartIdleReaper(context.Background())
+
 	// Reconcile maintenance CronJob on startup
 	go adminServer.ReconcileMaintenanceCronJob(context.Background())
 
@@ -266,8 +336,22 @@ func gatewayAuthMiddleware(jwtService *auth.JWTService, gwMux http.Handler) gin.
 		gw := &gatewayResponseWriter{ResponseWriter: c.Writer}
 
 		// Public routes — no auth needed
-		if publicPaths[path] || strings.HasPrefix(path, "/api/s/") ||
-			strings.HasPrefix(path, "/api/internal/") {
+		if publicPaths[path] || strings.HasPrefix(path, "/api/internal/") {
+			gwMux.ServeHTTP(gw, c.Request)
+			return
+		}
+
+		// Shared links are public, but restricted ones need to know the
+		// viewer, so a valid token is passed through when present.
+		if strings.HasPrefix(path, "/api/s/") {
+			if tokenStr, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer "); ok && tokenStr != "" {
+				if claims, err := jwtService.ValidateToken(tokenStr); err == nil {
+					ctx := context.WithValue(c.Request.Context(), ctxkeys.UserIDKey, claims.UserID)
+					ctx = context.WithValue(ctx, ctxkeys.UsernameKey, claims.Username)
+					ctx = context.WithValue(ctx, ctxkeys.RoleKey, claims.Role)
+					c.Request = c.Request.WithContext(ctx)
+				}
+			}
 			gwMux.ServeHTTP(gw, c.Request)
 			return
 		}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,8 +11,10 @@ import (
 	"g.echo.tech/dev/sac/internal/container"
 	"g.echo.tech/dev/sac/internal/database"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/internal/skill"
 	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/workspace"
 	"g.echo.tech/dev/sac/pkg/config"
 	"g.echo.tech/dev/sac/pkg/logger"
 	"github.com/rs/zerolog/log"
@@ -41,8 +44,15 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 	defer cancel()
 
+	// Skill sync results are notified to agent owners; flushed before exit.
+	notifier := notify.NewNotifier(database.DB)
+	notifier.SetAllowPrivateTargets(cfg.NotifyAllowPrivateTargets)
+
+	// --- Task 0: Pull Git skill sources (before the sync pushes them to pods) ---
+	pullSkillSources(ctx, containerMgr)
+
 	// --- Task 1: Skill sync ---
-	syncSkills(ctx, containerMgr)
+	syncSkills(ctx, containerMgr, notifier)
 
 	// --- Task 2: Conversation history cleanup ---
 	cleanupConversations(ctx)
@@ -54,12 +64,23 @@ func main() {
 	storageProvider := storage.NewStorageProvider(database.DB)
 	cleanupOrphanedWorkspaceFiles(ctx, storageProvider)
 
+	// --- Task 5: Fail headless tasks orphaned by a gateway restart ---
+	failStaleTasks(ctx)
+
+	// --- Task 6: Abandoned resumable uploads ---
+	cleanupExpiredUploads(ctx, storageProvider)
+
+	// --- Task 7: Reconcile workspace quota usage with storage ---
+	reconcileWorkspaceQuotas(ctx, storageProvider)
+
+	notifier.Wait()
 	log.Info().Msg("maintenance: all tasks complete")
 }
 
-func syncSkills(ctx context.Context, containerMgr *container.Manager) {
+func syncSkills(ctx context.Context, containerMgr *container.Manager, notifier *notify.Notifier) {
 	storageProvider := storage.NewStorageProvider(database.DB)
 	syncService := skill.NewSyncService(database.DB, containerMgr, storageProvider)
+	syncService.SetNotifier(notifier)
 
 	var agents []models.Agent
 	err := database.DB.NewSelect().Model(&agents).Column("id", "created_by").Scan(ctx)
@@ -70,10 +91,15 @@ func syncSkills(ctx context.Context, containerMgr *container.Manager) {
 
 	log.Info().Int("count", len(agents)).Msg("maintenance: skill-sync: syncing agents")
 
-	var failed, restarted int
+	// Skills that failed to sync are retried with exponential backoff.
+	var failed, offline, restarted int
 	for _, a := range agents {
 		userID := fmt.Sprintf("%d", a.CreatedBy)
-		if err := syncService.SyncAllSkillsToAgent(ctx, userID, a.ID); err != nil {
+		if err := syncService.SyncDueSkillsToAgent(ctx, userID, a.ID); err != nil {
+			if errors.Is(err, skill.ErrPodUnavailable) {
+				offline++
+				continue
+			}
 			log.Error().Err(err).Int64("agent_id", a.ID).Msg("maintenance: skill-sync: agent failed")
 			failed++
 			continue
@@ -88,7 +114,15 @@ func syncSkills(ctx context.Context, containerMgr *container.Manager) {
 		}
 	}
 
-	log.Info().Int("synced", len(agents)-failed).Int("restarted", restarted).Int("failed", failed).Msg("maintenance: skill-sync: done")
+	log.Info().Int("synced", len(agents)-failed-offline).Int("offline", offline).Int("restarted", restarted).Int("failed", failed).Msg("maintenance: skill-sync: done")
+}
+
+func pullSkillSources(ctx context.Context, containerMgr *container.Manager) {
+	storageProvider := storage.NewStorageProvider(database.DB)
+	syncService := skill.NewSyncService(database.DB, containerMgr, storageProvider)
+
+	synced, failed := syncService.SyncDueSources(ctx)
+	log.Info().Int("synced", synced).Int("failed", failed).Msg("maintenance: skill-sources: done")
 }
 
 func cleanupConversations(ctx context.Context) {
@@ -151,6 +185,27 @@ func cleanupStaleSessions(ctx context.Context) {
 	log.Info().Int64("deleted_sessions", rows).Msg("maintenance: session-cleanup: done")
 }
 
+// failStaleTasks marks headless tasks that are still pending/running well past
+// their timeout as failed. This happens when the gateway replica executing
+// the task restarts mid-run.
+func failStaleTasks(ctx context.Context) {
+	res, err := database.DB.NewUpdate().
+		Model((*models.AgentTask)(nil)).
+		Set("status = ?", models.TaskStatusFailed).
+		Set("error = ?", "task interrupted").
+		Set("finished_at = ?", time.Now()).
+		Where("status IN (?)", bun.In([]models.TaskStatus{models.TaskStatusPending, models.TaskStatusRunning})).
+		Where("COALESCE(started_at, created_at) + INTERVAL '1 second' * timeout_seconds < NOW() - INTERVAL '5 minutes'").
+		Exec(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("maintenance: task-cleanup: failed")
+		return
+	}
+
+	rows, _ := res.RowsAffected()
+	log.Info().Int64("failed_tasks", rows).Msg("maintenance: task-cleanup: done")
+}
+
 func cleanupOrphanedWorkspaceFiles(ctx context.Context, storageProvider *storage.StorageProvider) {
 	// Find workspace_files whose agent_id no longer exists in agents table
 	var orphans []models.WorkspaceFile
@@ -218,3 +273,100 @@ func cleanupOrphanedWorkspaceFiles(ctx context.Context, storageProvider *storage
 
 	log.Info().Int("s3_deleted", s3Deleted).Int64("db_deleted", dbRows).Int64("quotas_deleted", quotaRows).Msg("maintenance: orphan-cleanup: done")
 }
+
+// cleanupExpiredUploads removes resumable input uploads that have not
+// received a chunk for a day, together with their stored chunks.
+func cleanupExpiredUploads(ctx context.Context, storageProvider *storage.StorageProvider) {
+	var uploads []models.WorkspaceUpload
+	err := database.DB.NewSelect().
+		Model(&uploads).
+		Where("updated_at < ?", time.Now().Add(-24*time.Hour)).
+		Scan(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("maintenance: upload-cleanup: failed to query")
+		return
+	}
+	if len(uploads) == 0 {
+		return
+	}
+
+	backend := storageProvider.GetClient(ctx)
+	ids := make([]string, 0, len(uploads))
+	for _, u := range uploads {
+		if backend != nil {
+			prefix := fmt.Sprintf("users/%d/agents/%d/uploads/%s/", u.UserID, u.AgentID, u.ID)
+			if err := backend.DeletePrefix(ctx, prefix); err != nil {
+				log.Warn().Err(err).Str("upload_id", u.ID).Msg("maintenance: upload-cleanup: failed to delete chunks")
+				continue
+			}
+		}
+		ids = append(ids, u.ID)
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	if _, err := database.DB.NewDelete().
+		Model((*models.WorkspaceUpload)(nil)).
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx); err != nil {
+		log.Error().Err(err).Msg("maintenance: upload-cleanup: failed to delete records")
+		return
+	}
+	log.Info().Int("count", len(ids)).Msg("maintenance: upload-cleanup: done")
+}
+
+// reconcileWorkspaceQuotas corrects drift between the quota counters and
+// the objects actually stored for each agent (failed deletes, uploads
+// interrupted between storage and database, pre-quota data). Group usage
+// is recounted from workspace_files.
+func reconcileWorkspaceQuotas(ctx context.Context, storageProvider *storage.StorageProvider) {
+	backend := storageProvider.GetClient(ctx)
+	if backend == nil {
+		return
+	}
+
+	var agents []models.Agent
+	err := database.DB.NewSelect().Model(&agents).Column("id", "created_by").Scan(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("maintenance: quota-reconcile: failed to list agents")
+		return
+	}
+
+	var corrected, failed int
+	for _, a := range agents {
+		old, actual, changed, err := workspace.ReconcileQuota(ctx, database.DB, backend, a.CreatedBy, a.ID)
+		if err != nil {
+			log.Warn().Err(err).Int64("agent_id", a.ID).Msg("maintenance: quota-reconcile: agent failed")
+			failed++
+			continue
+		}
+		if changed {
+			log.Info().Int64("agent_id", a.ID).Int64("recorded_bytes", old).Int64("actual_bytes", actual).
+				Msg("maintenance: quota-reconcile: corrected usage")
+			corrected++
+		}
+	}
+
+	res, err := database.DB.ExecContext(ctx, `
+		UPDATE group_workspace_quotas gwq
+		SET used_bytes = COALESCE(u.bytes, 0), file_count = COALESCE(u.files, 0), updated_at = NOW()
+		FROM group_workspace_quotas g
+		LEFT JOIN (
+			SELECT group_id, SUM(size_bytes) AS bytes, COUNT(*) AS files
+			FROM workspace_files
+			WHERE group_id IS NOT NULL AND NOT is_directory
+			GROUP BY group_id
+		) u ON u.group_id = g.group_id
+		WHERE gwq.group_id = g.group_id
+		  AND (gwq.used_bytes != COALESCE(u.bytes, 0) OR gwq.file_count != COALESCE(u.files, 0))
+	`)
+	var groupRows int64
+	if err != nil {
+		log.Warn().Err(err).Msg("maintenance: quota-reconcile: failed to recount group usage")
+	} else {
+		groupRows, _ = res.RowsAffected()
+	}
+
+	log.Info().Int("agents", len(agents)).Int("corrected", corrected).Int("failed", failed).Int64("groups_corrected", groupRows).Msg("maintenance: quota-reconcile: done")
+}
@@ -1,16 +1,21 @@
 package main
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"g.echo.tech/dev/sac/internal/auth"
 	"g.echo.tech/dev/sac/internal/database"
+	sacredis "g.echo.tech/dev/sac/internal/redis"
 	"g.echo.tech/dev/sac/internal/websocket"
 	"g.echo.tech/dev/sac/pkg/config"
 	"g.echo.tech/dev/sac/pkg/logger"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
 )
 
@@ -37,18 +42,36 @@ func main() {
 
 	// Create WebSocket proxy handler
 	proxyHandler := websocket.NewProxyHandler(database.DB, jwtService)
+	proxyHandler.SetLimits(cfg.WSMaxConnections, cfg.WSMaxConnectionsPerUser)
+
+	// Revoked share grants are pushed over Redis (optional); without it they
+	// take effect at the next periodic access check.
+	if cfg.RedisURL == "" {
+		log.Warn().Msg("REDIS_URL not set, share revocations apply on the next access check")
+	} else if err := sacredis.Initialize(cfg.RedisURL); err != nil {
+		log.Warn().Err(err).Msg("Redis not available, share revocations apply on the next access check")
+	} else {
+		defer sacredis.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go proxyHandler.WatchShareRevocations(ctx, sacredis.Client)
+	}
 
 	// Register routes
 	router.GET("/health", proxyHandler.HealthCheck)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 	router.GET("/ws/:sessionId", proxyHandler.HandleWebSocket)
 
 	// Start server (listen on all interfaces for remote debugging)
 	addr := "0.0.0.0:" + cfg.WSProxyPort
-	log.Info().Str("addr", addr).Msg("WebSocket Proxy starting")
+	log.Info().Str("addr", addr).
+		Int("max_connections", cfg.WSMaxConnections).
+		Int("max_connections_per_user", cfg.WSMaxConnectionsPerUser).
+		Msg("WebSocket Proxy starting")
 
-	// Graceful shutdown
+	srv := &http.Server{Addr: addr, Handler: router}
 	go func() {
-		if err := router.Run(addr); err != nil {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatal().Err(err).Msg("failed to start server")
 		}
 	}()
@@ -58,5 +81,16 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	// Graceful shutdown: fail readiness, let open terminals finish, then
+	// ask the rest to reconnect elsewhere.
+	log.Info().Int("drain_timeout_seconds", cfg.WSDrainTimeoutSeconds).Msg("draining WebSocket Proxy")
+	drainCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.WSDrainTimeoutSeconds)*time.Second)
+	proxyHandler.Drain(drainCtx)
+	cancel()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = srv.Shutdown(shutdownCtx)
+
 	log.Info().Msg("shutting down WebSocket Proxy")
 }
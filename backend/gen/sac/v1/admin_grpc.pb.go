@@ -19,22 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetSettings_FullMethodName          = "/sac.v1.AdminService/GetSettings"
-	AdminService_UpdateSetting_FullMethodName        = "/sac.v1.AdminService/UpdateSetting"
-	AdminService_GetUsers_FullMethodName             = "/sac.v1.AdminService/GetUsers"
-	AdminService_UpdateUserRole_FullMethodName       = "/sac.v1.AdminService/UpdateUserRole"
-	AdminService_GetUserSettings_FullMethodName      = "/sac.v1.AdminService/GetUserSettings"
-	AdminService_SetUserSetting_FullMethodName       = "/sac.v1.AdminService/SetUserSetting"
-	AdminService_DeleteUserSetting_FullMethodName    = "/sac.v1.AdminService/DeleteUserSetting"
-	AdminService_GetUserAgents_FullMethodName        = "/sac.v1.AdminService/GetUserAgents"
-	AdminService_DeleteUserAgent_FullMethodName      = "/sac.v1.AdminService/DeleteUserAgent"
-	AdminService_RestartUserAgent_FullMethodName     = "/sac.v1.AdminService/RestartUserAgent"
-	AdminService_UpdateAgentResources_FullMethodName = "/sac.v1.AdminService/UpdateAgentResources"
-	AdminService_UpdateAgentImage_FullMethodName     = "/sac.v1.AdminService/UpdateAgentImage"
-	AdminService_BatchUpdateImage_FullMethodName     = "/sac.v1.AdminService/BatchUpdateImage"
-	AdminService_ResetUserPassword_FullMethodName    = "/sac.v1.AdminService/ResetUserPassword"
-	AdminService_GetConversations_FullMethodName     = "/sac.v1.AdminService/GetConversations"
-	AdminService_TriggerMaintenance_FullMethodName   = "/sac.v1.AdminService/TriggerMaintenance"
+	AdminService_GetSettings_FullMethodName            = "/sac.v1.AdminService/GetSettings"
+	AdminService_UpdateSetting_FullMethodName          = "/sac.v1.AdminService/UpdateSetting"
+	AdminService_GetUsers_FullMethodName               = "/sac.v1.AdminService/GetUsers"
+	AdminService_UpdateUserRole_FullMethodName         = "/sac.v1.AdminService/UpdateUserRole"
+	AdminService_GetUserSettings_FullMethodName        = "/sac.v1.AdminService/GetUserSettings"
+	AdminService_SetUserSetting_FullMethodName         = "/sac.v1.AdminService/SetUserSetting"
+	AdminService_DeleteUserSetting_FullMethodName      = "/sac.v1.AdminService/DeleteUserSetting"
+	AdminService_GetUserAgents_FullMethodName          = "/sac.v1.AdminService/GetUserAgents"
+	AdminService_DeleteUserAgent_FullMethodName        = "/sac.v1.AdminService/DeleteUserAgent"
+	AdminService_RestartUserAgent_FullMethodName       = "/sac.v1.AdminService/RestartUserAgent"
+	AdminService_UpdateAgentResources_FullMethodName   = "/sac.v1.AdminService/UpdateAgentResources"
+	AdminService_UpdateAgentImage_FullMethodName       = "/sac.v1.AdminService/UpdateAgentImage"
+	AdminService_BatchUpdateImage_FullMethodName       = "/sac.v1.AdminService/BatchUpdateImage"
+	AdminService_ResetUserPassword_FullMethodName      = "/sac.v1.AdminService/ResetUserPassword"
+	AdminService_GetUserWorkspaceQuotas_FullMethodName = "/sac.v1.AdminService/GetUserWorkspaceQuotas"
+	AdminService_SetUserWorkspaceQuota_FullMethodName  = "/sac.v1.AdminService/SetUserWorkspaceQuota"
+	AdminService_SetAgentWorkspaceQuota_FullMethodName = "/sac.v1.AdminService/SetAgentWorkspaceQuota"
+	AdminService_GetGroupWorkspaceQuota_FullMethodName = "/sac.v1.AdminService/GetGroupWorkspaceQuota"
+	AdminService_SetGroupWorkspaceQuota_FullMethodName = "/sac.v1.AdminService/SetGroupWorkspaceQuota"
+	AdminService_GetConversations_FullMethodName       = "/sac.v1.AdminService/GetConversations"
+	AdminService_TriggerMaintenance_FullMethodName     = "/sac.v1.AdminService/TriggerMaintenance"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -57,6 +62,12 @@ type AdminServiceClient interface {
 	UpdateAgentImage(ctx context.Context, in *UpdateAgentImageByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
 	BatchUpdateImage(ctx context.Context, in *BatchUpdateImageRequest, opts ...grpc.CallOption) (*BatchUpdateImageResponse, error)
 	ResetUserPassword(ctx context.Context, in *ResetPasswordByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	// Workspace quotas
+	GetUserWorkspaceQuotas(ctx context.Context, in *GetUserWorkspaceQuotasRequest, opts ...grpc.CallOption) (*WorkspaceQuotaListResponse, error)
+	SetUserWorkspaceQuota(ctx context.Context, in *SetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error)
+	SetAgentWorkspaceQuota(ctx context.Context, in *SetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error)
+	GetGroupWorkspaceQuota(ctx context.Context, in *GetGroupWorkspaceQuotaRequest, opts ...grpc.CallOption) (*GroupWorkspaceQuota, error)
+	SetGroupWorkspaceQuota(ctx context.Context, in *SetGroupWorkspaceQuotaRequest, opts ...grpc.CallOption) (*GroupWorkspaceQuota, error)
 	// Conversations
 	GetConversations(ctx context.Context, in *AdminGetConversationsRequest, opts ...grpc.CallOption) (*AdminConversationListResponse, error)
 	// Maintenance
@@ -211,6 +222,56 @@ func (c *adminServiceClient) ResetUserPassword(ctx context.Context, in *ResetPas
 	return out, nil
 }
 
+func (c *adminServiceClient) GetUserWorkspaceQuotas(ctx context.Context, in *GetUserWorkspaceQuotasRequest, opts ...grpc.CallOption) (*WorkspaceQuotaListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceQuotaListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserWorkspaceQuotas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserWorkspaceQuota(ctx context.Context, in *SetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceQuota)
+	err := c.cc.Invoke(ctx, AdminService_SetUserWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetAgentWorkspaceQuota(ctx context.Context, in *SetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceQuota)
+	err := c.cc.Invoke(ctx, AdminService_SetAgentWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetGroupWorkspaceQuota(ctx context.Context, in *GetGroupWorkspaceQuotaRequest, opts ...grpc.CallOption) (*GroupWorkspaceQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupWorkspaceQuota)
+	err := c.cc.Invoke(ctx, AdminService_GetGroupWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetGroupWorkspaceQuota(ctx context.Context, in *SetGroupWorkspaceQuotaRequest, opts ...grpc.CallOption) (*GroupWorkspaceQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupWorkspaceQuota)
+	err := c.cc.Invoke(ctx, AdminService_SetGroupWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetConversations(ctx context.Context, in *AdminGetConversationsRequest, opts ...grpc.CallOption) (*AdminConversationListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AdminConversationListResponse)
@@ -251,6 +312,12 @@ type AdminServiceServer interface {
 	UpdateAgentImage(context.Context, *UpdateAgentImageByIdRequest) (*SuccessMessage, error)
 	BatchUpdateImage(context.Context, *BatchUpdateImageRequest) (*BatchUpdateImageResponse, error)
 	ResetUserPassword(context.Context, *ResetPasswordByIdRequest) (*SuccessMessage, error)
+	// Workspace quotas
+	GetUserWorkspaceQuotas(context.Context, *GetUserWorkspaceQuotasRequest) (*WorkspaceQuotaListResponse, error)
+	SetUserWorkspaceQuota(context.Context, *SetWorkspaceQuotaRequest) (*WorkspaceQuota, error)
+	SetAgentWorkspaceQuota(context.Context, *SetWorkspaceQuotaRequest) (*WorkspaceQuota, error)
+	GetGroupWorkspaceQuota(context.Context, *GetGroupWorkspaceQuotaRequest) (*GroupWorkspaceQuota, error)
+	SetGroupWorkspaceQuota(context.Context, *SetGroupWorkspaceQuotaRequest) (*GroupWorkspaceQuota, error)
 	// Conversations
 	GetConversations(context.Context, *AdminGetConversationsRequest) (*AdminConversationListResponse, error)
 	// Maintenance
@@ -307,6 +374,21 @@ func (UnimplementedAdminServiceServer) BatchUpdateImage(context.Context, *BatchU
 func (UnimplementedAdminServiceServer) ResetUserPassword(context.Context, *ResetPasswordByIdRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetUserPassword not implemented")
 }
+func (UnimplementedAdminServiceServer) GetUserWorkspaceQuotas(context.Context, *GetUserWorkspaceQuotasRequest) (*WorkspaceQuotaListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserWorkspaceQuotas not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserWorkspaceQuota(context.Context, *SetWorkspaceQuotaRequest) (*WorkspaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserWorkspaceQuota not implemented")
+}
+func (UnimplementedAdminServiceServer) SetAgentWorkspaceQuota(context.Context, *SetWorkspaceQuotaRequest) (*WorkspaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAgentWorkspaceQuota not implemented")
+}
+func (UnimplementedAdminServiceServer) GetGroupWorkspaceQuota(context.Context, *GetGroupWorkspaceQuotaRequest) (*GroupWorkspaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupWorkspaceQuota not implemented")
+}
+func (UnimplementedAdminServiceServer) SetGroupWorkspaceQuota(context.Context, *SetGroupWorkspaceQuotaRequest) (*GroupWorkspaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupWorkspaceQuota not implemented")
+}
 func (UnimplementedAdminServiceServer) GetConversations(context.Context, *AdminGetConversationsRequest) (*AdminConversationListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConversations not implemented")
 }
@@ -586,6 +668,96 @@ func _AdminService_ResetUserPassword_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetUserWorkspaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserWorkspaceQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserWorkspaceQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserWorkspaceQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserWorkspaceQuotas(ctx, req.(*GetUserWorkspaceQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserWorkspaceQuota(ctx, req.(*SetWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetAgentWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetAgentWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetAgentWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetAgentWorkspaceQuota(ctx, req.(*SetWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetGroupWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetGroupWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetGroupWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetGroupWorkspaceQuota(ctx, req.(*GetGroupWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetGroupWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetGroupWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetGroupWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetGroupWorkspaceQuota(ctx, req.(*SetGroupWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminGetConversationsRequest)
 	if err := dec(in); err != nil {
@@ -685,6 +857,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetUserPassword",
 			Handler:    _AdminService_ResetUserPassword_Handler,
 		},
+		{
+			MethodName: "GetUserWorkspaceQuotas",
+			Handler:    _AdminService_GetUserWorkspaceQuotas_Handler,
+		},
+		{
+			MethodName: "SetUserWorkspaceQuota",
+			Handler:    _AdminService_SetUserWorkspaceQuota_Handler,
+		},
+		{
+			MethodName: "SetAgentWorkspaceQuota",
+			Handler:    _AdminService_SetAgentWorkspaceQuota_Handler,
+		},
+		{
+			MethodName: "GetGroupWorkspaceQuota",
+			Handler:    _AdminService_GetGroupWorkspaceQuota_Handler,
+		},
+		{
+			MethodName: "SetGroupWorkspaceQuota",
+			Handler:    _AdminService_SetGroupWorkspaceQuota_Handler,
+		},
 		{
 			MethodName: "GetConversations",
 			Handler:    _AdminService_GetConversations_Handler,
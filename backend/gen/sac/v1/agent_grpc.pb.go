@@ -19,17 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AgentService_ListAgents_FullMethodName       = "/sac.v1.AgentService/ListAgents"
-	AgentService_GetAgent_FullMethodName         = "/sac.v1.AgentService/GetAgent"
-	AgentService_CreateAgent_FullMethodName      = "/sac.v1.AgentService/CreateAgent"
-	AgentService_UpdateAgent_FullMethodName      = "/sac.v1.AgentService/UpdateAgent"
-	AgentService_DeleteAgent_FullMethodName      = "/sac.v1.AgentService/DeleteAgent"
-	AgentService_RestartAgent_FullMethodName     = "/sac.v1.AgentService/RestartAgent"
-	AgentService_InstallSkill_FullMethodName     = "/sac.v1.AgentService/InstallSkill"
-	AgentService_UninstallSkill_FullMethodName   = "/sac.v1.AgentService/UninstallSkill"
-	AgentService_SyncSkills_FullMethodName       = "/sac.v1.AgentService/SyncSkills"
-	AgentService_GetAgentStatuses_FullMethodName = "/sac.v1.AgentService/GetAgentStatuses"
-	AgentService_PreviewClaudeMD_FullMethodName  = "/sac.v1.AgentService/PreviewClaudeMD"
+	AgentService_ListAgents_FullMethodName           = "/sac.v1.AgentService/ListAgents"
+	AgentService_GetAgent_FullMethodName             = "/sac.v1.AgentService/GetAgent"
+	AgentService_CreateAgent_FullMethodName          = "/sac.v1.AgentService/CreateAgent"
+	AgentService_UpdateAgent_FullMethodName          = "/sac.v1.AgentService/UpdateAgent"
+	AgentService_DeleteAgent_FullMethodName          = "/sac.v1.AgentService/DeleteAgent"
+	AgentService_RestartAgent_FullMethodName         = "/sac.v1.AgentService/RestartAgent"
+	AgentService_InstallSkill_FullMethodName         = "/sac.v1.AgentService/InstallSkill"
+	AgentService_UninstallSkill_FullMethodName       = "/sac.v1.AgentService/UninstallSkill"
+	AgentService_SetAgentSkillVersion_FullMethodName = "/sac.v1.AgentService/SetAgentSkillVersion"
+	AgentService_UpgradeAgentSkill_FullMethodName    = "/sac.v1.AgentService/UpgradeAgentSkill"
+	AgentService_SyncSkills_FullMethodName           = "/sac.v1.AgentService/SyncSkills"
+	AgentService_GetAgentSyncStatus_FullMethodName   = "/sac.v1.AgentService/GetAgentSyncStatus"
+	AgentService_GetAgentStatuses_FullMethodName     = "/sac.v1.AgentService/GetAgentStatuses"
+	AgentService_PreviewClaudeMD_FullMethodName      = "/sac.v1.AgentService/PreviewClaudeMD"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -44,7 +47,10 @@ type AgentServiceClient interface {
 	RestartAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
 	InstallSkill(ctx context.Context, in *InstallSkillByAgentRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
 	UninstallSkill(ctx context.Context, in *UninstallSkillRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	SetAgentSkillVersion(ctx context.Context, in *SetAgentSkillVersionRequest, opts ...grpc.CallOption) (*AgentSkill, error)
+	UpgradeAgentSkill(ctx context.Context, in *AgentSkillRequest, opts ...grpc.CallOption) (*AgentSkill, error)
 	SyncSkills(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	GetAgentSyncStatus(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*AgentSyncStatus, error)
 	GetAgentStatuses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentStatusListResponse, error)
 	PreviewClaudeMD(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*ClaudeMDPreview, error)
 }
@@ -137,6 +143,26 @@ func (c *agentServiceClient) UninstallSkill(ctx context.Context, in *UninstallSk
 	return out, nil
 }
 
+func (c *agentServiceClient) SetAgentSkillVersion(ctx context.Context, in *SetAgentSkillVersionRequest, opts ...grpc.CallOption) (*AgentSkill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentSkill)
+	err := c.cc.Invoke(ctx, AgentService_SetAgentSkillVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) UpgradeAgentSkill(ctx context.Context, in *AgentSkillRequest, opts ...grpc.CallOption) (*AgentSkill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentSkill)
+	err := c.cc.Invoke(ctx, AgentService_UpgradeAgentSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentServiceClient) SyncSkills(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SuccessMessage)
@@ -147,6 +173,16 @@ func (c *agentServiceClient) SyncSkills(ctx context.Context, in *GetAgentRequest
 	return out, nil
 }
 
+func (c *agentServiceClient) GetAgentSyncStatus(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*AgentSyncStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentSyncStatus)
+	err := c.cc.Invoke(ctx, AgentService_GetAgentSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentServiceClient) GetAgentStatuses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AgentStatusListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AgentStatusListResponse)
@@ -179,7 +215,10 @@ type AgentServiceServer interface {
 	RestartAgent(context.Context, *GetAgentRequest) (*SuccessMessage, error)
 	InstallSkill(context.Context, *InstallSkillByAgentRequest) (*SuccessMessage, error)
 	UninstallSkill(context.Context, *UninstallSkillRequest) (*SuccessMessage, error)
+	SetAgentSkillVersion(context.Context, *SetAgentSkillVersionRequest) (*AgentSkill, error)
+	UpgradeAgentSkill(context.Context, *AgentSkillRequest) (*AgentSkill, error)
 	SyncSkills(context.Context, *GetAgentRequest) (*SuccessMessage, error)
+	GetAgentSyncStatus(context.Context, *GetAgentRequest) (*AgentSyncStatus, error)
 	GetAgentStatuses(context.Context, *Empty) (*AgentStatusListResponse, error)
 	PreviewClaudeMD(context.Context, *GetAgentRequest) (*ClaudeMDPreview, error)
 	mustEmbedUnimplementedAgentServiceServer()
@@ -216,9 +255,18 @@ func (UnimplementedAgentServiceServer) InstallSkill(context.Context, *InstallSki
 func (UnimplementedAgentServiceServer) UninstallSkill(context.Context, *UninstallSkillRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UninstallSkill not implemented")
 }
+func (UnimplementedAgentServiceServer) SetAgentSkillVersion(context.Context, *SetAgentSkillVersionRequest) (*AgentSkill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAgentSkillVersion not implemented")
+}
+func (UnimplementedAgentServiceServer) UpgradeAgentSkill(context.Context, *AgentSkillRequest) (*AgentSkill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeAgentSkill not implemented")
+}
 func (UnimplementedAgentServiceServer) SyncSkills(context.Context, *GetAgentRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSkills not implemented")
 }
+func (UnimplementedAgentServiceServer) GetAgentSyncStatus(context.Context, *GetAgentRequest) (*AgentSyncStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentSyncStatus not implemented")
+}
 func (UnimplementedAgentServiceServer) GetAgentStatuses(context.Context, *Empty) (*AgentStatusListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAgentStatuses not implemented")
 }
@@ -390,6 +438,42 @@ func _AgentService_UninstallSkill_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_SetAgentSkillVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAgentSkillVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SetAgentSkillVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_SetAgentSkillVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SetAgentSkillVersion(ctx, req.(*SetAgentSkillVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_UpgradeAgentSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpgradeAgentSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_UpgradeAgentSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpgradeAgentSkill(ctx, req.(*AgentSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentService_SyncSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAgentRequest)
 	if err := dec(in); err != nil {
@@ -408,6 +492,24 @@ func _AgentService_SyncSkills_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_GetAgentSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetAgentSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_GetAgentSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetAgentSyncStatus(ctx, req.(*GetAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentService_GetAgentStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -483,10 +585,22 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UninstallSkill",
 			Handler:    _AgentService_UninstallSkill_Handler,
 		},
+		{
+			MethodName: "SetAgentSkillVersion",
+			Handler:    _AgentService_SetAgentSkillVersion_Handler,
+		},
+		{
+			MethodName: "UpgradeAgentSkill",
+			Handler:    _AgentService_UpgradeAgentSkill_Handler,
+		},
 		{
 			MethodName: "SyncSkills",
 			Handler:    _AgentService_SyncSkills_Handler,
 		},
+		{
+			MethodName: "GetAgentSyncStatus",
+			Handler:    _AgentService_GetAgentSyncStatus_Handler,
+		},
 		{
 			MethodName: "GetAgentStatuses",
 			Handler:    _AgentService_GetAgentStatuses_Handler,
@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v6.33.0
+// source: sac/v1/claude_settings.proto
+
+package sacv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ClaudeHook is an extra Claude Code hook command.
+type ClaudeHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event   string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`     // PreToolUse, PostToolUse, Stop, ...
+	Matcher string `protobuf:"bytes,2,opt,name=matcher,proto3" json:"matcher,omitempty"` // tool name pattern, for tool events
+	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Timeout int32  `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"` // seconds, 0 = Claude Code default
+}
+
+func (x *ClaudeHook) Reset() {
+	*x = ClaudeHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaudeHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaudeHook) ProtoMessage() {}
+
+func (x *ClaudeHook) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaudeHook.ProtoReflect.Descriptor instead.
+func (*ClaudeHook) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClaudeHook) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ClaudeHook) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+func (x *ClaudeHook) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ClaudeHook) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// ClaudeSettings is one layer of the Claude Code settings written into agent
+// pods: the platform default, a group, or an agent.
+type ClaudeSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allow                    []string          `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"` // permission rules, e.g. "Bash(git diff:*)"
+	Deny                     []string          `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+	Ask                      []string          `protobuf:"bytes,3,rep,name=ask,proto3" json:"ask,omitempty"`
+	DefaultMode              string            `protobuf:"bytes,4,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"` // default | acceptEdits | plan | bypassPermissions
+	DisableBypassPermissions bool              `protobuf:"varint,5,opt,name=disable_bypass_permissions,json=disableBypassPermissions,proto3" json:"disable_bypass_permissions,omitempty"`
+	Model                    string            `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	Env                      map[string]string `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hooks                    []*ClaudeHook     `protobuf:"bytes,8,rep,name=hooks,proto3" json:"hooks,omitempty"`
+}
+
+func (x *ClaudeSettings) Reset() {
+	*x = ClaudeSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaudeSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaudeSettings) ProtoMessage() {}
+
+func (x *ClaudeSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaudeSettings.ProtoReflect.Descriptor instead.
+func (*ClaudeSettings) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClaudeSettings) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *ClaudeSettings) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
+func (x *ClaudeSettings) GetAsk() []string {
+	if x != nil {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *ClaudeSettings) GetDefaultMode() string {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return ""
+}
+
+func (x *ClaudeSettings) GetDisableBypassPermissions() bool {
+	if x != nil {
+		return x.DisableBypassPermissions
+	}
+	return false
+}
+
+func (x *ClaudeSettings) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ClaudeSettings) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ClaudeSettings) GetHooks() []*ClaudeHook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+type ClaudeSettingsLayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source   string          `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // "platform" | "group" | "agent"
+	Id       int64           `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`        // group or agent id
+	Name     string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Settings *ClaudeSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *ClaudeSettingsLayer) Reset() {
+	*x = ClaudeSettingsLayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaudeSettingsLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaudeSettingsLayer) ProtoMessage() {}
+
+func (x *ClaudeSettingsLayer) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaudeSettingsLayer.ProtoReflect.Descriptor instead.
+func (*ClaudeSettingsLayer) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaudeSettingsLayer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ClaudeSettingsLayer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClaudeSettingsLayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClaudeSettingsLayer) GetSettings() *ClaudeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// ClaudeSettingsPreview shows how the layers of an agent merge.
+type ClaudeSettingsPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layers       []*ClaudeSettingsLayer `protobuf:"bytes,1,rep,name=layers,proto3" json:"layers,omitempty"` // lowest precedence first
+	Merged       *ClaudeSettings        `protobuf:"bytes,2,opt,name=merged,proto3" json:"merged,omitempty"`
+	SettingsJson string                 `protobuf:"bytes,3,opt,name=settings_json,json=settingsJson,proto3" json:"settings_json,omitempty"` // the file written into the pod
+	Notes        []string               `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`                                   // settings dropped while merging, and why
+}
+
+func (x *ClaudeSettingsPreview) Reset() {
+	*x = ClaudeSettingsPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaudeSettingsPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaudeSettingsPreview) ProtoMessage() {}
+
+func (x *ClaudeSettingsPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaudeSettingsPreview.ProtoReflect.Descriptor instead.
+func (*ClaudeSettingsPreview) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClaudeSettingsPreview) GetLayers() []*ClaudeSettingsLayer {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *ClaudeSettingsPreview) GetMerged() *ClaudeSettings {
+	if x != nil {
+		return x.Merged
+	}
+	return nil
+}
+
+func (x *ClaudeSettingsPreview) GetSettingsJson() string {
+	if x != nil {
+		return x.SettingsJson
+	}
+	return ""
+}
+
+func (x *ClaudeSettingsPreview) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type UpdatePlatformClaudeSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *ClaudeSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdatePlatformClaudeSettingsRequest) Reset() {
+	*x = UpdatePlatformClaudeSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlatformClaudeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformClaudeSettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformClaudeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformClaudeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformClaudeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePlatformClaudeSettingsRequest) GetSettings() *ClaudeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GroupClaudeSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GroupClaudeSettingsRequest) Reset() {
+	*x = GroupClaudeSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupClaudeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupClaudeSettingsRequest) ProtoMessage() {}
+
+func (x *GroupClaudeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupClaudeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GroupClaudeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GroupClaudeSettingsRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type UpdateGroupClaudeSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  int64           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Settings *ClaudeSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateGroupClaudeSettingsRequest) Reset() {
+	*x = UpdateGroupClaudeSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupClaudeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupClaudeSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateGroupClaudeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupClaudeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupClaudeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateGroupClaudeSettingsRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateGroupClaudeSettingsRequest) GetSettings() *ClaudeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type AgentClaudeSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *AgentClaudeSettingsRequest) Reset() {
+	*x = AgentClaudeSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentClaudeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentClaudeSettingsRequest) ProtoMessage() {}
+
+func (x *AgentClaudeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentClaudeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*AgentClaudeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AgentClaudeSettingsRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+type UpdateAgentClaudeSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId  int64           `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Settings *ClaudeSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateAgentClaudeSettingsRequest) Reset() {
+	*x = UpdateAgentClaudeSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sac_v1_claude_settings_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAgentClaudeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentClaudeSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateAgentClaudeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sac_v1_claude_settings_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentClaudeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAgentClaudeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sac_v1_claude_settings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateAgentClaudeSettingsRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *UpdateAgentClaudeSettingsRequest) GetSettings() *ClaudeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_sac_v1_claude_settings_proto protoreflect.FileDescriptor
+
+var file_sac_v1_claude_settings_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x73, 0x61, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x5f,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x61, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x0a, 0x43, 0x6c, 0x61,
+	0x75, 0x64, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x0e,
+	0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a,
+	0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61,
+	0x75, 0x64, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x1a, 0x36,
+	0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x15, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x33, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
+	0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4a, 0x73,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x1a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x75,
+	0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x20,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x37, 0x0a, 0x1a, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xef, 0x07, 0x0a, 0x15,
+	0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6c,
+	0x61, 0x75, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x91, 0x01,
+	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b,
+	0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c,
+	0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x73,
+	0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2d,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x3a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x26, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e,
+	0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75,
+	0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
+	0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75,
+	0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x64,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x32, 0x3a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x26, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x61, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2d, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x26, 0x5a,
+	0x24, 0x67, 0x2e, 0x65, 0x63, 0x68, 0x6f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x64, 0x65, 0x76,
+	0x2f, 0x73, 0x61, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x61, 0x63, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x61, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sac_v1_claude_settings_proto_rawDescOnce sync.Once
+	file_sac_v1_claude_settings_proto_rawDescData = file_sac_v1_claude_settings_proto_rawDesc
+)
+
+func file_sac_v1_claude_settings_proto_rawDescGZIP() []byte {
+	file_sac_v1_claude_settings_proto_rawDescOnce.Do(func() {
+		file_sac_v1_claude_settings_proto_rawDescData = protoimpl.X.CompressGZIP(file_sac_v1_claude_settings_proto_rawDescData)
+	})
+	return file_sac_v1_claude_settings_proto_rawDescData
+}
+
+var file_sac_v1_claude_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sac_v1_claude_settings_proto_goTypes = []interface{}{
+	(*ClaudeHook)(nil),                          // 0: sac.v1.ClaudeHook
+	(*ClaudeSettings)(nil),                      // 1: sac.v1.ClaudeSettings
+	(*ClaudeSettingsLayer)(nil),                 // 2: sac.v1.ClaudeSettingsLayer
+	(*ClaudeSettingsPreview)(nil),               // 3: sac.v1.ClaudeSettingsPreview
+	(*UpdatePlatformClaudeSettingsRequest)(nil), // 4: sac.v1.UpdatePlatformClaudeSettingsRequest
+	(*GroupClaudeSettingsRequest)(nil),          // 5: sac.v1.GroupClaudeSettingsRequest
+	(*UpdateGroupClaudeSettingsRequest)(nil),    // 6: sac.v1.UpdateGroupClaudeSettingsRequest
+	(*AgentClaudeSettingsRequest)(nil),          // 7: sac.v1.AgentClaudeSettingsRequest
+	(*UpdateAgentClaudeSettingsRequest)(nil),    // 8: sac.v1.UpdateAgentClaudeSettingsRequest
+	nil,                                         // 9: sac.v1.ClaudeSettings.EnvEntry
+	(*Empty)(nil),                               // 10: sac.v1.Empty
+}
+var file_sac_v1_claude_settings_proto_depIdxs = []int32{
+	9,  // 0: sac.v1.ClaudeSettings.env:type_name -> sac.v1.ClaudeSettings.EnvEntry
+	0,  // 1: sac.v1.ClaudeSettings.hooks:type_name -> sac.v1.ClaudeHook
+	1,  // 2: sac.v1.ClaudeSettingsLayer.settings:type_name -> sac.v1.ClaudeSettings
+	2,  // 3: sac.v1.ClaudeSettingsPreview.layers:type_name -> sac.v1.ClaudeSettingsLayer
+	1,  // 4: sac.v1.ClaudeSettingsPreview.merged:type_name -> sac.v1.ClaudeSettings
+	1,  // 5: sac.v1.UpdatePlatformClaudeSettingsRequest.settings:type_name -> sac.v1.ClaudeSettings
+	1,  // 6: sac.v1.UpdateGroupClaudeSettingsRequest.settings:type_name -> sac.v1.ClaudeSettings
+	1,  // 7: sac.v1.UpdateAgentClaudeSettingsRequest.settings:type_name -> sac.v1.ClaudeSettings
+	10, // 8: sac.v1.ClaudeSettingsService.GetPlatformClaudeSettings:input_type -> sac.v1.Empty
+	4,  // 9: sac.v1.ClaudeSettingsService.UpdatePlatformClaudeSettings:input_type -> sac.v1.UpdatePlatformClaudeSettingsRequest
+	5,  // 10: sac.v1.ClaudeSettingsService.GetGroupClaudeSettings:input_type -> sac.v1.GroupClaudeSettingsRequest
+	6,  // 11: sac.v1.ClaudeSettingsService.UpdateGroupClaudeSettings:input_type -> sac.v1.UpdateGroupClaudeSettingsRequest
+	7,  // 12: sac.v1.ClaudeSettingsService.GetAgentClaudeSettings:input_type -> sac.v1.AgentClaudeSettingsRequest
+	8,  // 13: sac.v1.ClaudeSettingsService.UpdateAgentClaudeSettings:input_type -> sac.v1.UpdateAgentClaudeSettingsRequest
+	7,  // 14: sac.v1.ClaudeSettingsService.PreviewAgentClaudeSettings:input_type -> sac.v1.AgentClaudeSettingsRequest
+	1,  // 15: sac.v1.ClaudeSettingsService.GetPlatformClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	1,  // 16: sac.v1.ClaudeSettingsService.UpdatePlatformClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	1,  // 17: sac.v1.ClaudeSettingsService.GetGroupClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	1,  // 18: sac.v1.ClaudeSettingsService.UpdateGroupClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	1,  // 19: sac.v1.ClaudeSettingsService.GetAgentClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	1,  // 20: sac.v1.ClaudeSettingsService.UpdateAgentClaudeSettings:output_type -> sac.v1.ClaudeSettings
+	3,  // 21: sac.v1.ClaudeSettingsService.PreviewAgentClaudeSettings:output_type -> sac.v1.ClaudeSettingsPreview
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_sac_v1_claude_settings_proto_init() }
+func file_sac_v1_claude_settings_proto_init() {
+	if File_sac_v1_claude_settings_proto != nil {
+		return
+	}
+	file_sac_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_sac_v1_claude_settings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaudeHook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaudeSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaudeSettingsLayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaudeSettingsPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlatformClaudeSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupClaudeSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupClaudeSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentClaudeSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sac_v1_claude_settings_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAgentClaudeSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sac_v1_claude_settings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sac_v1_claude_settings_proto_goTypes,
+		DependencyIndexes: file_sac_v1_claude_settings_proto_depIdxs,
+		MessageInfos:      file_sac_v1_claude_settings_proto_msgTypes,
+	}.Build()
+	File_sac_v1_claude_settings_proto = out.File
+	file_sac_v1_claude_settings_proto_rawDesc = nil
+	file_sac_v1_claude_settings_proto_goTypes = nil
+	file_sac_v1_claude_settings_proto_depIdxs = nil
+}
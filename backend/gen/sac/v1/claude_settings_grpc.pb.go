@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: sac/v1/claude_settings.proto
+
+package sacv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClaudeSettingsService_GetPlatformClaudeSettings_FullMethodName    = "/sac.v1.ClaudeSettingsService/GetPlatformClaudeSettings"
+	ClaudeSettingsService_UpdatePlatformClaudeSettings_FullMethodName = "/sac.v1.ClaudeSettingsService/UpdatePlatformClaudeSettings"
+	ClaudeSettingsService_GetGroupClaudeSettings_FullMethodName       = "/sac.v1.ClaudeSettingsService/GetGroupClaudeSettings"
+	ClaudeSettingsService_UpdateGroupClaudeSettings_FullMethodName    = "/sac.v1.ClaudeSettingsService/UpdateGroupClaudeSettings"
+	ClaudeSettingsService_GetAgentClaudeSettings_FullMethodName       = "/sac.v1.ClaudeSettingsService/GetAgentClaudeSettings"
+	ClaudeSettingsService_UpdateAgentClaudeSettings_FullMethodName    = "/sac.v1.ClaudeSettingsService/UpdateAgentClaudeSettings"
+	ClaudeSettingsService_PreviewAgentClaudeSettings_FullMethodName   = "/sac.v1.ClaudeSettingsService/PreviewAgentClaudeSettings"
+)
+
+// ClaudeSettingsServiceClient is the client API for ClaudeSettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Layered Claude Code settings. Changes apply when a session starts.
+type ClaudeSettingsServiceClient interface {
+	GetPlatformClaudeSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	UpdatePlatformClaudeSettings(ctx context.Context, in *UpdatePlatformClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	GetGroupClaudeSettings(ctx context.Context, in *GroupClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	UpdateGroupClaudeSettings(ctx context.Context, in *UpdateGroupClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	GetAgentClaudeSettings(ctx context.Context, in *AgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	UpdateAgentClaudeSettings(ctx context.Context, in *UpdateAgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error)
+	PreviewAgentClaudeSettings(ctx context.Context, in *AgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettingsPreview, error)
+}
+
+type claudeSettingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClaudeSettingsServiceClient(cc grpc.ClientConnInterface) ClaudeSettingsServiceClient {
+	return &claudeSettingsServiceClient{cc}
+}
+
+func (c *claudeSettingsServiceClient) GetPlatformClaudeSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_GetPlatformClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) UpdatePlatformClaudeSettings(ctx context.Context, in *UpdatePlatformClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_UpdatePlatformClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) GetGroupClaudeSettings(ctx context.Context, in *GroupClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_GetGroupClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) UpdateGroupClaudeSettings(ctx context.Context, in *UpdateGroupClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_UpdateGroupClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) GetAgentClaudeSettings(ctx context.Context, in *AgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_GetAgentClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) UpdateAgentClaudeSettings(ctx context.Context, in *UpdateAgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettings)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_UpdateAgentClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claudeSettingsServiceClient) PreviewAgentClaudeSettings(ctx context.Context, in *AgentClaudeSettingsRequest, opts ...grpc.CallOption) (*ClaudeSettingsPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaudeSettingsPreview)
+	err := c.cc.Invoke(ctx, ClaudeSettingsService_PreviewAgentClaudeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaudeSettingsServiceServer is the server API for ClaudeSettingsService service.
+// All implementations must embed UnimplementedClaudeSettingsServiceServer
+// for forward compatibility.
+//
+// Layered Claude Code settings. Changes apply when a session starts.
+type ClaudeSettingsServiceServer interface {
+	GetPlatformClaudeSettings(context.Context, *Empty) (*ClaudeSettings, error)
+	UpdatePlatformClaudeSettings(context.Context, *UpdatePlatformClaudeSettingsRequest) (*ClaudeSettings, error)
+	GetGroupClaudeSettings(context.Context, *GroupClaudeSettingsRequest) (*ClaudeSettings, error)
+	UpdateGroupClaudeSettings(context.Context, *UpdateGroupClaudeSettingsRequest) (*ClaudeSettings, error)
+	GetAgentClaudeSettings(context.Context, *AgentClaudeSettingsRequest) (*ClaudeSettings, error)
+	UpdateAgentClaudeSettings(context.Context, *UpdateAgentClaudeSettingsRequest) (*ClaudeSettings, error)
+	PreviewAgentClaudeSettings(context.Context, *AgentClaudeSettingsRequest) (*ClaudeSettingsPreview, error)
+	mustEmbedUnimplementedClaudeSettingsServiceServer()
+}
+
+// UnimplementedClaudeSettingsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClaudeSettingsServiceServer struct{}
+
+func (UnimplementedClaudeSettingsServiceServer) GetPlatformClaudeSettings(context.Context, *Empty) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) UpdatePlatformClaudeSettings(context.Context, *UpdatePlatformClaudeSettingsRequest) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlatformClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) GetGroupClaudeSettings(context.Context, *GroupClaudeSettingsRequest) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) UpdateGroupClaudeSettings(context.Context, *UpdateGroupClaudeSettingsRequest) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) GetAgentClaudeSettings(context.Context, *AgentClaudeSettingsRequest) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) UpdateAgentClaudeSettings(context.Context, *UpdateAgentClaudeSettingsRequest) (*ClaudeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) PreviewAgentClaudeSettings(context.Context, *AgentClaudeSettingsRequest) (*ClaudeSettingsPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAgentClaudeSettings not implemented")
+}
+func (UnimplementedClaudeSettingsServiceServer) mustEmbedUnimplementedClaudeSettingsServiceServer() {}
+func (UnimplementedClaudeSettingsServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeClaudeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClaudeSettingsServiceServer will
+// result in compilation errors.
+type UnsafeClaudeSettingsServiceServer interface {
+	mustEmbedUnimplementedClaudeSettingsServiceServer()
+}
+
+func RegisterClaudeSettingsServiceServer(s grpc.ServiceRegistrar, srv ClaudeSettingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClaudeSettingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClaudeSettingsService_ServiceDesc, srv)
+}
+
+func _ClaudeSettingsService_GetPlatformClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).GetPlatformClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_GetPlatformClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).GetPlatformClaudeSettings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_UpdatePlatformClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlatformClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).UpdatePlatformClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_UpdatePlatformClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).UpdatePlatformClaudeSettings(ctx, req.(*UpdatePlatformClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_GetGroupClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).GetGroupClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_GetGroupClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).GetGroupClaudeSettings(ctx, req.(*GroupClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_UpdateGroupClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).UpdateGroupClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_UpdateGroupClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).UpdateGroupClaudeSettings(ctx, req.(*UpdateGroupClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_GetAgentClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).GetAgentClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_GetAgentClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).GetAgentClaudeSettings(ctx, req.(*AgentClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_UpdateAgentClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAgentClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).UpdateAgentClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_UpdateAgentClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).UpdateAgentClaudeSettings(ctx, req.(*UpdateAgentClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaudeSettingsService_PreviewAgentClaudeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentClaudeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaudeSettingsServiceServer).PreviewAgentClaudeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaudeSettingsService_PreviewAgentClaudeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaudeSettingsServiceServer).PreviewAgentClaudeSettings(ctx, req.(*AgentClaudeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClaudeSettingsService_ServiceDesc is the grpc.ServiceDesc for ClaudeSettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClaudeSettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sac.v1.ClaudeSettingsService",
+	HandlerType: (*ClaudeSettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlatformClaudeSettings",
+			Handler:    _ClaudeSettingsService_GetPlatformClaudeSettings_Handler,
+		},
+		{
+			MethodName: "UpdatePlatformClaudeSettings",
+			Handler:    _ClaudeSettingsService_UpdatePlatformClaudeSettings_Handler,
+		},
+		{
+			MethodName: "GetGroupClaudeSettings",
+			Handler:    _ClaudeSettingsService_GetGroupClaudeSettings_Handler,
+		},
+		{
+			MethodName: "UpdateGroupClaudeSettings",
+			Handler:    _ClaudeSettingsService_UpdateGroupClaudeSettings_Handler,
+		},
+		{
+			MethodName: "GetAgentClaudeSettings",
+			Handler:    _ClaudeSettingsService_GetAgentClaudeSettings_Handler,
+		},
+		{
+			MethodName: "UpdateAgentClaudeSettings",
+			Handler:    _ClaudeSettingsService_UpdateAgentClaudeSettings_Handler,
+		},
+		{
+			MethodName: "PreviewAgentClaudeSettings",
+			Handler:    _ClaudeSettingsService_PreviewAgentClaudeSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sac/v1/claude_settings.proto",
+}
@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: sac/v1/mcp.proto
+
+package sacv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MCPService_ListMCPServers_FullMethodName       = "/sac.v1.MCPService/ListMCPServers"
+	MCPService_GetMCPServer_FullMethodName         = "/sac.v1.MCPService/GetMCPServer"
+	MCPService_CreateMCPServer_FullMethodName      = "/sac.v1.MCPService/CreateMCPServer"
+	MCPService_UpdateMCPServer_FullMethodName      = "/sac.v1.MCPService/UpdateMCPServer"
+	MCPService_DeleteMCPServer_FullMethodName      = "/sac.v1.MCPService/DeleteMCPServer"
+	MCPService_AdminCreateMCPServer_FullMethodName = "/sac.v1.MCPService/AdminCreateMCPServer"
+	MCPService_ListAgentMCPServers_FullMethodName  = "/sac.v1.MCPService/ListAgentMCPServers"
+	MCPService_InstallMCPServer_FullMethodName     = "/sac.v1.MCPService/InstallMCPServer"
+	MCPService_UninstallMCPServer_FullMethodName   = "/sac.v1.MCPService/UninstallMCPServer"
+	MCPService_ListUserSecrets_FullMethodName      = "/sac.v1.MCPService/ListUserSecrets"
+	MCPService_SetUserSecret_FullMethodName        = "/sac.v1.MCPService/SetUserSecret"
+	MCPService_DeleteUserSecret_FullMethodName     = "/sac.v1.MCPService/DeleteUserSecret"
+)
+
+// MCPServiceClient is the client API for MCPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MCPServiceClient interface {
+	// Catalogue: official entries plus the caller's own.
+	ListMCPServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MCPServerListResponse, error)
+	GetMCPServer(ctx context.Context, in *MCPServerByIdRequest, opts ...grpc.CallOption) (*MCPServer, error)
+	CreateMCPServer(ctx context.Context, in *CreateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error)
+	UpdateMCPServer(ctx context.Context, in *UpdateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error)
+	DeleteMCPServer(ctx context.Context, in *MCPServerByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	AdminCreateMCPServer(ctx context.Context, in *CreateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error)
+	// Per-agent installation, synced into the pod's Claude Code config.
+	ListAgentMCPServers(ctx context.Context, in *ListAgentMCPServersRequest, opts ...grpc.CallOption) (*AgentMCPServerListResponse, error)
+	InstallMCPServer(ctx context.Context, in *AgentMCPServerRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	UninstallMCPServer(ctx context.Context, in *AgentMCPServerRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	// Secrets referenced as ${secret:NAME}; values are write-only.
+	ListUserSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UserSecretListResponse, error)
+	SetUserSecret(ctx context.Context, in *SetUserSecretRequest, opts ...grpc.CallOption) (*UserSecret, error)
+	DeleteUserSecret(ctx context.Context, in *DeleteUserSecretRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+}
+
+type mCPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMCPServiceClient(cc grpc.ClientConnInterface) MCPServiceClient {
+	return &mCPServiceClient{cc}
+}
+
+func (c *mCPServiceClient) ListMCPServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MCPServerListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPServerListResponse)
+	err := c.cc.Invoke(ctx, MCPService_ListMCPServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) GetMCPServer(ctx context.Context, in *MCPServerByIdRequest, opts ...grpc.CallOption) (*MCPServer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPServer)
+	err := c.cc.Invoke(ctx, MCPService_GetMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) CreateMCPServer(ctx context.Context, in *CreateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPServer)
+	err := c.cc.Invoke(ctx, MCPService_CreateMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) UpdateMCPServer(ctx context.Context, in *UpdateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPServer)
+	err := c.cc.Invoke(ctx, MCPService_UpdateMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) DeleteMCPServer(ctx context.Context, in *MCPServerByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, MCPService_DeleteMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) AdminCreateMCPServer(ctx context.Context, in *CreateMCPServerRequest, opts ...grpc.CallOption) (*MCPServer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPServer)
+	err := c.cc.Invoke(ctx, MCPService_AdminCreateMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) ListAgentMCPServers(ctx context.Context, in *ListAgentMCPServersRequest, opts ...grpc.CallOption) (*AgentMCPServerListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentMCPServerListResponse)
+	err := c.cc.Invoke(ctx, MCPService_ListAgentMCPServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) InstallMCPServer(ctx context.Context, in *AgentMCPServerRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, MCPService_InstallMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) UninstallMCPServer(ctx context.Context, in *AgentMCPServerRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, MCPService_UninstallMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) ListUserSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UserSecretListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSecretListResponse)
+	err := c.cc.Invoke(ctx, MCPService_ListUserSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) SetUserSecret(ctx context.Context, in *SetUserSecretRequest, opts ...grpc.CallOption) (*UserSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSecret)
+	err := c.cc.Invoke(ctx, MCPService_SetUserSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPServiceClient) DeleteUserSecret(ctx context.Context, in *DeleteUserSecretRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, MCPService_DeleteUserSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MCPServiceServer is the server API for MCPService service.
+// All implementations must embed UnimplementedMCPServiceServer
+// for forward compatibility.
+type MCPServiceServer interface {
+	// Catalogue: official entries plus the caller's own.
+	ListMCPServers(context.Context, *Empty) (*MCPServerListResponse, error)
+	GetMCPServer(context.Context, *MCPServerByIdRequest) (*MCPServer, error)
+	CreateMCPServer(context.Context, *CreateMCPServerRequest) (*MCPServer, error)
+	UpdateMCPServer(context.Context, *UpdateMCPServerRequest) (*MCPServer, error)
+	DeleteMCPServer(context.Context, *MCPServerByIdRequest) (*SuccessMessage, error)
+	AdminCreateMCPServer(context.Context, *CreateMCPServerRequest) (*MCPServer, error)
+	// Per-agent installation, synced into the pod's Claude Code config.
+	ListAgentMCPServers(context.Context, *ListAgentMCPServersRequest) (*AgentMCPServerListResponse, error)
+	InstallMCPServer(context.Context, *AgentMCPServerRequest) (*SuccessMessage, error)
+	UninstallMCPServer(context.Context, *AgentMCPServerRequest) (*SuccessMessage, error)
+	// Secrets referenced as ${secret:NAME}; values are write-only.
+	ListUserSecrets(context.Context, *Empty) (*UserSecretListResponse, error)
+	SetUserSecret(context.Context, *SetUserSecretRequest) (*UserSecret, error)
+	DeleteUserSecret(context.Context, *DeleteUserSecretRequest) (*SuccessMessage, error)
+	mustEmbedUnimplementedMCPServiceServer()
+}
+
+// UnimplementedMCPServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMCPServiceServer struct{}
+
+func (UnimplementedMCPServiceServer) ListMCPServers(context.Context, *Empty) (*MCPServerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMCPServers not implemented")
+}
+func (UnimplementedMCPServiceServer) GetMCPServer(context.Context, *MCPServerByIdRequest) (*MCPServer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) CreateMCPServer(context.Context, *CreateMCPServerRequest) (*MCPServer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) UpdateMCPServer(context.Context, *UpdateMCPServerRequest) (*MCPServer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) DeleteMCPServer(context.Context, *MCPServerByIdRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) AdminCreateMCPServer(context.Context, *CreateMCPServerRequest) (*MCPServer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) ListAgentMCPServers(context.Context, *ListAgentMCPServersRequest) (*AgentMCPServerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentMCPServers not implemented")
+}
+func (UnimplementedMCPServiceServer) InstallMCPServer(context.Context, *AgentMCPServerRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) UninstallMCPServer(context.Context, *AgentMCPServerRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallMCPServer not implemented")
+}
+func (UnimplementedMCPServiceServer) ListUserSecrets(context.Context, *Empty) (*UserSecretListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSecrets not implemented")
+}
+func (UnimplementedMCPServiceServer) SetUserSecret(context.Context, *SetUserSecretRequest) (*UserSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSecret not implemented")
+}
+func (UnimplementedMCPServiceServer) DeleteUserSecret(context.Context, *DeleteUserSecretRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSecret not implemented")
+}
+func (UnimplementedMCPServiceServer) mustEmbedUnimplementedMCPServiceServer() {}
+func (UnimplementedMCPServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeMCPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MCPServiceServer will
+// result in compilation errors.
+type UnsafeMCPServiceServer interface {
+	mustEmbedUnimplementedMCPServiceServer()
+}
+
+func RegisterMCPServiceServer(s grpc.ServiceRegistrar, srv MCPServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMCPServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MCPService_ServiceDesc, srv)
+}
+
+func _MCPService_ListMCPServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).ListMCPServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_ListMCPServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).ListMCPServers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_GetMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MCPServerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).GetMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_GetMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).GetMCPServer(ctx, req.(*MCPServerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_CreateMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).CreateMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_CreateMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).CreateMCPServer(ctx, req.(*CreateMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_UpdateMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).UpdateMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_UpdateMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).UpdateMCPServer(ctx, req.(*UpdateMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_DeleteMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MCPServerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).DeleteMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_DeleteMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).DeleteMCPServer(ctx, req.(*MCPServerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_AdminCreateMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).AdminCreateMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_AdminCreateMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).AdminCreateMCPServer(ctx, req.(*CreateMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_ListAgentMCPServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentMCPServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).ListAgentMCPServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_ListAgentMCPServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).ListAgentMCPServers(ctx, req.(*ListAgentMCPServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_InstallMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).InstallMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_InstallMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).InstallMCPServer(ctx, req.(*AgentMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_UninstallMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).UninstallMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_UninstallMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).UninstallMCPServer(ctx, req.(*AgentMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_ListUserSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).ListUserSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_ListUserSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).ListUserSecrets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_SetUserSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).SetUserSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_SetUserSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).SetUserSecret(ctx, req.(*SetUserSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPService_DeleteUserSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPServiceServer).DeleteUserSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPService_DeleteUserSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPServiceServer).DeleteUserSecret(ctx, req.(*DeleteUserSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MCPService_ServiceDesc is the grpc.ServiceDesc for MCPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MCPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sac.v1.MCPService",
+	HandlerType: (*MCPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMCPServers",
+			Handler:    _MCPService_ListMCPServers_Handler,
+		},
+		{
+			MethodName: "GetMCPServer",
+			Handler:    _MCPService_GetMCPServer_Handler,
+		},
+		{
+			MethodName: "CreateMCPServer",
+			Handler:    _MCPService_CreateMCPServer_Handler,
+		},
+		{
+			MethodName: "UpdateMCPServer",
+			Handler:    _MCPService_UpdateMCPServer_Handler,
+		},
+		{
+			MethodName: "DeleteMCPServer",
+			Handler:    _MCPService_DeleteMCPServer_Handler,
+		},
+		{
+			MethodName: "AdminCreateMCPServer",
+			Handler:    _MCPService_AdminCreateMCPServer_Handler,
+		},
+		{
+			MethodName: "ListAgentMCPServers",
+			Handler:    _MCPService_ListAgentMCPServers_Handler,
+		},
+		{
+			MethodName: "InstallMCPServer",
+			Handler:    _MCPService_InstallMCPServer_Handler,
+		},
+		{
+			MethodName: "UninstallMCPServer",
+			Handler:    _MCPService_UninstallMCPServer_Handler,
+		},
+		{
+			MethodName: "ListUserSecrets",
+			Handler:    _MCPService_ListUserSecrets_Handler,
+		},
+		{
+			MethodName: "SetUserSecret",
+			Handler:    _MCPService_SetUserSecret_Handler,
+		},
+		{
+			MethodName: "DeleteUserSecret",
+			Handler:    _MCPService_DeleteUserSecret_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sac/v1/mcp.proto",
+}
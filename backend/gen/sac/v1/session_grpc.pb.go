@@ -19,10 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SessionService_CreateSession_FullMethodName = "/sac.v1.SessionService/CreateSession"
-	SessionService_ListSessions_FullMethodName  = "/sac.v1.SessionService/ListSessions"
-	SessionService_GetSession_FullMethodName    = "/sac.v1.SessionService/GetSession"
-	SessionService_DeleteSession_FullMethodName = "/sac.v1.SessionService/DeleteSession"
+	SessionService_CreateSession_FullMethodName      = "/sac.v1.SessionService/CreateSession"
+	SessionService_ListSessions_FullMethodName       = "/sac.v1.SessionService/ListSessions"
+	SessionService_GetSession_FullMethodName         = "/sac.v1.SessionService/GetSession"
+	SessionService_DeleteSession_FullMethodName      = "/sac.v1.SessionService/DeleteSession"
+	SessionService_CreateSessionShare_FullMethodName = "/sac.v1.SessionService/CreateSessionShare"
+	SessionService_ListSessionShares_FullMethodName  = "/sac.v1.SessionService/ListSessionShares"
+	SessionService_RevokeSessionShare_FullMethodName = "/sac.v1.SessionService/RevokeSessionShare"
+	SessionService_ListSharedSessions_FullMethodName = "/sac.v1.SessionService/ListSharedSessions"
+	SessionService_RunTask_FullMethodName            = "/sac.v1.SessionService/RunTask"
+	SessionService_GetTask_FullMethodName            = "/sac.v1.SessionService/GetTask"
+	SessionService_ListTasks_FullMethodName          = "/sac.v1.SessionService/ListTasks"
+	SessionService_CancelTask_FullMethodName         = "/sac.v1.SessionService/CancelTask"
+	SessionService_CreateJob_FullMethodName          = "/sac.v1.SessionService/CreateJob"
+	SessionService_ListJobs_FullMethodName           = "/sac.v1.SessionService/ListJobs"
+	SessionService_GetJob_FullMethodName             = "/sac.v1.SessionService/GetJob"
+	SessionService_UpdateJob_FullMethodName          = "/sac.v1.SessionService/UpdateJob"
+	SessionService_DeleteJob_FullMethodName          = "/sac.v1.SessionService/DeleteJob"
+	SessionService_RunJobNow_FullMethodName          = "/sac.v1.SessionService/RunJobNow"
+	SessionService_ListJobRuns_FullMethodName        = "/sac.v1.SessionService/ListJobRuns"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -33,6 +48,21 @@ type SessionServiceClient interface {
 	ListSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UserSessionListResponse, error)
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error)
 	DeleteSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	CreateSessionShare(ctx context.Context, in *CreateSessionShareRequest, opts ...grpc.CallOption) (*SessionShare, error)
+	ListSessionShares(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionShareListResponse, error)
+	RevokeSessionShare(ctx context.Context, in *RevokeSessionShareRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	ListSharedSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SharedSessionListResponse, error)
+	RunTask(ctx context.Context, in *RunTaskRequest, opts ...grpc.CallOption) (*AgentTask, error)
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*AgentTask, error)
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*TaskListResponse, error)
+	CancelTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*AgentTask, error)
+	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*AgentJob, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*JobListResponse, error)
+	GetJob(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*AgentJob, error)
+	UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*AgentJob, error)
+	DeleteJob(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	RunJobNow(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*AgentTask, error)
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*TaskListResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -83,6 +113,156 @@ func (c *sessionServiceClient) DeleteSession(ctx context.Context, in *GetSession
 	return out, nil
 }
 
+func (c *sessionServiceClient) CreateSessionShare(ctx context.Context, in *CreateSessionShareRequest, opts ...grpc.CallOption) (*SessionShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionShare)
+	err := c.cc.Invoke(ctx, SessionService_CreateSessionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListSessionShares(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionShareListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionShareListResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListSessionShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeSessionShare(ctx context.Context, in *RevokeSessionShareRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SessionService_RevokeSessionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListSharedSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SharedSessionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedSessionListResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListSharedSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RunTask(ctx context.Context, in *RunTaskRequest, opts ...grpc.CallOption) (*AgentTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentTask)
+	err := c.cc.Invoke(ctx, SessionService_RunTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*AgentTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentTask)
+	err := c.cc.Invoke(ctx, SessionService_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*TaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskListResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CancelTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*AgentTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentTask)
+	err := c.cc.Invoke(ctx, SessionService_CancelTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*AgentJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentJob)
+	err := c.cc.Invoke(ctx, SessionService_CreateJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*JobListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobListResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetJob(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*AgentJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentJob)
+	err := c.cc.Invoke(ctx, SessionService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*AgentJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentJob)
+	err := c.cc.Invoke(ctx, SessionService_UpdateJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteJob(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SessionService_DeleteJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RunJobNow(ctx context.Context, in *JobByIdRequest, opts ...grpc.CallOption) (*AgentTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentTask)
+	err := c.cc.Invoke(ctx, SessionService_RunJobNow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*TaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskListResponse)
+	err := c.cc.Invoke(ctx, SessionService_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility.
@@ -91,6 +271,21 @@ type SessionServiceServer interface {
 	ListSessions(context.Context, *Empty) (*UserSessionListResponse, error)
 	GetSession(context.Context, *GetSessionRequest) (*Session, error)
 	DeleteSession(context.Context, *GetSessionRequest) (*SuccessMessage, error)
+	CreateSessionShare(context.Context, *CreateSessionShareRequest) (*SessionShare, error)
+	ListSessionShares(context.Context, *GetSessionRequest) (*SessionShareListResponse, error)
+	RevokeSessionShare(context.Context, *RevokeSessionShareRequest) (*SuccessMessage, error)
+	ListSharedSessions(context.Context, *Empty) (*SharedSessionListResponse, error)
+	RunTask(context.Context, *RunTaskRequest) (*AgentTask, error)
+	GetTask(context.Context, *GetTaskRequest) (*AgentTask, error)
+	ListTasks(context.Context, *ListTasksRequest) (*TaskListResponse, error)
+	CancelTask(context.Context, *GetTaskRequest) (*AgentTask, error)
+	CreateJob(context.Context, *CreateJobRequest) (*AgentJob, error)
+	ListJobs(context.Context, *ListJobsRequest) (*JobListResponse, error)
+	GetJob(context.Context, *JobByIdRequest) (*AgentJob, error)
+	UpdateJob(context.Context, *UpdateJobRequest) (*AgentJob, error)
+	DeleteJob(context.Context, *JobByIdRequest) (*SuccessMessage, error)
+	RunJobNow(context.Context, *JobByIdRequest) (*AgentTask, error)
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*TaskListResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -113,6 +308,51 @@ func (UnimplementedSessionServiceServer) GetSession(context.Context, *GetSession
 func (UnimplementedSessionServiceServer) DeleteSession(context.Context, *GetSessionRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
 }
+func (UnimplementedSessionServiceServer) CreateSessionShare(context.Context, *CreateSessionShareRequest) (*SessionShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSessionShare not implemented")
+}
+func (UnimplementedSessionServiceServer) ListSessionShares(context.Context, *GetSessionRequest) (*SessionShareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionShares not implemented")
+}
+func (UnimplementedSessionServiceServer) RevokeSessionShare(context.Context, *RevokeSessionShareRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionShare not implemented")
+}
+func (UnimplementedSessionServiceServer) ListSharedSessions(context.Context, *Empty) (*SharedSessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) RunTask(context.Context, *RunTaskRequest) (*AgentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTask not implemented")
+}
+func (UnimplementedSessionServiceServer) GetTask(context.Context, *GetTaskRequest) (*AgentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedSessionServiceServer) ListTasks(context.Context, *ListTasksRequest) (*TaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSessionServiceServer) CancelTask(context.Context, *GetTaskRequest) (*AgentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (UnimplementedSessionServiceServer) CreateJob(context.Context, *CreateJobRequest) (*AgentJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
+}
+func (UnimplementedSessionServiceServer) ListJobs(context.Context, *ListJobsRequest) (*JobListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedSessionServiceServer) GetJob(context.Context, *JobByIdRequest) (*AgentJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedSessionServiceServer) UpdateJob(context.Context, *UpdateJobRequest) (*AgentJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJob not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteJob(context.Context, *JobByIdRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
+}
+func (UnimplementedSessionServiceServer) RunJobNow(context.Context, *JobByIdRequest) (*AgentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunJobNow not implemented")
+}
+func (UnimplementedSessionServiceServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*TaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRuns not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +446,276 @@ func _SessionService_DeleteSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_CreateSessionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CreateSessionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CreateSessionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CreateSessionShare(ctx, req.(*CreateSessionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListSessionShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessionShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListSessionShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessionShares(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeSessionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeSessionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RevokeSessionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeSessionShare(ctx, req.(*RevokeSessionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListSharedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSharedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListSharedSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSharedSessions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RunTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RunTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RunTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RunTask(ctx, req.(*RunTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CancelTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CancelTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CreateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CreateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CreateJob(ctx, req.(*CreateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetJob(ctx, req.(*JobByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UpdateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UpdateJob(ctx, req.(*UpdateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_DeleteJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteJob(ctx, req.(*JobByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RunJobNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RunJobNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RunJobNow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RunJobNow(ctx, req.(*JobByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +739,66 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSession",
 			Handler:    _SessionService_DeleteSession_Handler,
 		},
+		{
+			MethodName: "CreateSessionShare",
+			Handler:    _SessionService_CreateSessionShare_Handler,
+		},
+		{
+			MethodName: "ListSessionShares",
+			Handler:    _SessionService_ListSessionShares_Handler,
+		},
+		{
+			MethodName: "RevokeSessionShare",
+			Handler:    _SessionService_RevokeSessionShare_Handler,
+		},
+		{
+			MethodName: "ListSharedSessions",
+			Handler:    _SessionService_ListSharedSessions_Handler,
+		},
+		{
+			MethodName: "RunTask",
+			Handler:    _SessionService_RunTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _SessionService_GetTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _SessionService_ListTasks_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _SessionService_CancelTask_Handler,
+		},
+		{
+			MethodName: "CreateJob",
+			Handler:    _SessionService_CreateJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _SessionService_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _SessionService_GetJob_Handler,
+		},
+		{
+			MethodName: "UpdateJob",
+			Handler:    _SessionService_UpdateJob_Handler,
+		},
+		{
+			MethodName: "DeleteJob",
+			Handler:    _SessionService_DeleteJob_Handler,
+		},
+		{
+			MethodName: "RunJobNow",
+			Handler:    _SessionService_RunJobNow_Handler,
+		},
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _SessionService_ListJobRuns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sac/v1/session.proto",
@@ -19,15 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SkillService_ListSkills_FullMethodName        = "/sac.v1.SkillService/ListSkills"
-	SkillService_GetSkill_FullMethodName          = "/sac.v1.SkillService/GetSkill"
-	SkillService_CreateSkill_FullMethodName       = "/sac.v1.SkillService/CreateSkill"
-	SkillService_UpdateSkill_FullMethodName       = "/sac.v1.SkillService/UpdateSkill"
-	SkillService_DeleteSkill_FullMethodName       = "/sac.v1.SkillService/DeleteSkill"
-	SkillService_ForkSkill_FullMethodName         = "/sac.v1.SkillService/ForkSkill"
-	SkillService_ListPublicSkills_FullMethodName  = "/sac.v1.SkillService/ListPublicSkills"
-	SkillService_ListGroupSkills_FullMethodName   = "/sac.v1.SkillService/ListGroupSkills"
-	SkillService_ShareSkillToGroup_FullMethodName = "/sac.v1.SkillService/ShareSkillToGroup"
+	SkillService_ListSkills_FullMethodName            = "/sac.v1.SkillService/ListSkills"
+	SkillService_GetSkill_FullMethodName              = "/sac.v1.SkillService/GetSkill"
+	SkillService_CreateSkill_FullMethodName           = "/sac.v1.SkillService/CreateSkill"
+	SkillService_UpdateSkill_FullMethodName           = "/sac.v1.SkillService/UpdateSkill"
+	SkillService_DeleteSkill_FullMethodName           = "/sac.v1.SkillService/DeleteSkill"
+	SkillService_ForkSkill_FullMethodName             = "/sac.v1.SkillService/ForkSkill"
+	SkillService_ListPublicSkills_FullMethodName      = "/sac.v1.SkillService/ListPublicSkills"
+	SkillService_ListPublicSkillTags_FullMethodName   = "/sac.v1.SkillService/ListPublicSkillTags"
+	SkillService_ListGroupSkills_FullMethodName       = "/sac.v1.SkillService/ListGroupSkills"
+	SkillService_ShareSkillToGroup_FullMethodName     = "/sac.v1.SkillService/ShareSkillToGroup"
+	SkillService_ListSkillVersions_FullMethodName     = "/sac.v1.SkillService/ListSkillVersions"
+	SkillService_DiffSkillVersions_FullMethodName     = "/sac.v1.SkillService/DiffSkillVersions"
+	SkillService_RollbackSkill_FullMethodName         = "/sac.v1.SkillService/RollbackSkill"
+	SkillService_ListSkillSources_FullMethodName      = "/sac.v1.SkillService/ListSkillSources"
+	SkillService_CreateSkillSource_FullMethodName     = "/sac.v1.SkillService/CreateSkillSource"
+	SkillService_UpdateSkillSource_FullMethodName     = "/sac.v1.SkillService/UpdateSkillSource"
+	SkillService_DeleteSkillSource_FullMethodName     = "/sac.v1.SkillService/DeleteSkillSource"
+	SkillService_SyncSkillSource_FullMethodName       = "/sac.v1.SkillService/SyncSkillSource"
+	SkillService_ValidateSkill_FullMethodName         = "/sac.v1.SkillService/ValidateSkill"
+	SkillService_RenderSkillInvocation_FullMethodName = "/sac.v1.SkillService/RenderSkillInvocation"
+	SkillService_GetSkillUpstream_FullMethodName      = "/sac.v1.SkillService/GetSkillUpstream"
+	SkillService_MergeUpstream_FullMethodName         = "/sac.v1.SkillService/MergeUpstream"
+	SkillService_SubmitSkillReview_FullMethodName     = "/sac.v1.SkillService/SubmitSkillReview"
+	SkillService_ListSkillReviews_FullMethodName      = "/sac.v1.SkillService/ListSkillReviews"
+	SkillService_ListSkillAuditEvents_FullMethodName  = "/sac.v1.SkillService/ListSkillAuditEvents"
+	SkillService_ListSkillReviewQueue_FullMethodName  = "/sac.v1.SkillService/ListSkillReviewQueue"
+	SkillService_ApproveSkillReview_FullMethodName    = "/sac.v1.SkillService/ApproveSkillReview"
+	SkillService_RejectSkillReview_FullMethodName     = "/sac.v1.SkillService/RejectSkillReview"
+	SkillService_WithdrawSkillReview_FullMethodName   = "/sac.v1.SkillService/WithdrawSkillReview"
 )
 
 // SkillServiceClient is the client API for SkillService service.
@@ -40,9 +60,36 @@ type SkillServiceClient interface {
 	UpdateSkill(ctx context.Context, in *UpdateSkillByIdRequest, opts ...grpc.CallOption) (*Skill, error)
 	DeleteSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
 	ForkSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error)
-	ListPublicSkills(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillListResponse, error)
+	ListPublicSkills(ctx context.Context, in *ListPublicSkillsRequest, opts ...grpc.CallOption) (*SkillListResponse, error)
+	// Tags used by public skills, most used first.
+	ListPublicSkillTags(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillTagListResponse, error)
 	ListGroupSkills(ctx context.Context, in *ListGroupSkillsRequest, opts ...grpc.CallOption) (*SkillListResponse, error)
 	ShareSkillToGroup(ctx context.Context, in *ShareSkillToGroupRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	// Version history
+	ListSkillVersions(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillVersionListResponse, error)
+	DiffSkillVersions(ctx context.Context, in *DiffSkillVersionsRequest, opts ...grpc.CallOption) (*SkillVersionDiff, error)
+	RollbackSkill(ctx context.Context, in *RollbackSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	ListSkillSources(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillSourceListResponse, error)
+	CreateSkillSource(ctx context.Context, in *CreateSkillSourceRequest, opts ...grpc.CallOption) (*SkillSource, error)
+	UpdateSkillSource(ctx context.Context, in *UpdateSkillSourceRequest, opts ...grpc.CallOption) (*SkillSource, error)
+	DeleteSkillSource(ctx context.Context, in *GetSkillSourceRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	SyncSkillSource(ctx context.Context, in *GetSkillSourceRequest, opts ...grpc.CallOption) (*SkillSourceSyncResult, error)
+	// Dry-run of the checks run when a skill is saved.
+	ValidateSkill(ctx context.Context, in *ValidateSkillRequest, opts ...grpc.CallOption) (*SkillValidationResult, error)
+	// Builds the slash command for parameter values, optionally sending it
+	// into the user's terminal.
+	RenderSkillInvocation(ctx context.Context, in *RenderSkillInvocationRequest, opts ...grpc.CallOption) (*RenderSkillInvocationResponse, error)
+	// Upstream tracking for forks.
+	GetSkillUpstream(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillUpstreamStatus, error)
+	MergeUpstream(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*MergeUpstreamResponse, error)
+	// Review workflow: a revision becomes visible to others once approved.
+	SubmitSkillReview(ctx context.Context, in *SubmitSkillReviewRequest, opts ...grpc.CallOption) (*SkillReview, error)
+	ListSkillReviews(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillReviewListResponse, error)
+	ListSkillAuditEvents(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillAuditEventListResponse, error)
+	ListSkillReviewQueue(ctx context.Context, in *ListSkillReviewQueueRequest, opts ...grpc.CallOption) (*SkillReviewListResponse, error)
+	ApproveSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error)
+	RejectSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error)
+	WithdrawSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error)
 }
 
 type skillServiceClient struct {
@@ -113,7 +160,7 @@ func (c *skillServiceClient) ForkSkill(ctx context.Context, in *GetSkillRequest,
 	return out, nil
 }
 
-func (c *skillServiceClient) ListPublicSkills(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillListResponse, error) {
+func (c *skillServiceClient) ListPublicSkills(ctx context.Context, in *ListPublicSkillsRequest, opts ...grpc.CallOption) (*SkillListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SkillListResponse)
 	err := c.cc.Invoke(ctx, SkillService_ListPublicSkills_FullMethodName, in, out, cOpts...)
@@ -123,6 +170,16 @@ func (c *skillServiceClient) ListPublicSkills(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *skillServiceClient) ListPublicSkillTags(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillTagListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillTagListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListPublicSkillTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skillServiceClient) ListGroupSkills(ctx context.Context, in *ListGroupSkillsRequest, opts ...grpc.CallOption) (*SkillListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SkillListResponse)
@@ -143,6 +200,196 @@ func (c *skillServiceClient) ShareSkillToGroup(ctx context.Context, in *ShareSki
 	return out, nil
 }
 
+func (c *skillServiceClient) ListSkillVersions(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillVersionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillVersionListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkillVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) DiffSkillVersions(ctx context.Context, in *DiffSkillVersionsRequest, opts ...grpc.CallOption) (*SkillVersionDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillVersionDiff)
+	err := c.cc.Invoke(ctx, SkillService_DiffSkillVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) RollbackSkill(ctx context.Context, in *RollbackSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, SkillService_RollbackSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ListSkillSources(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkillSourceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillSourceListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkillSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) CreateSkillSource(ctx context.Context, in *CreateSkillSourceRequest, opts ...grpc.CallOption) (*SkillSource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillSource)
+	err := c.cc.Invoke(ctx, SkillService_CreateSkillSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) UpdateSkillSource(ctx context.Context, in *UpdateSkillSourceRequest, opts ...grpc.CallOption) (*SkillSource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillSource)
+	err := c.cc.Invoke(ctx, SkillService_UpdateSkillSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) DeleteSkillSource(ctx context.Context, in *GetSkillSourceRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SkillService_DeleteSkillSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) SyncSkillSource(ctx context.Context, in *GetSkillSourceRequest, opts ...grpc.CallOption) (*SkillSourceSyncResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillSourceSyncResult)
+	err := c.cc.Invoke(ctx, SkillService_SyncSkillSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ValidateSkill(ctx context.Context, in *ValidateSkillRequest, opts ...grpc.CallOption) (*SkillValidationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillValidationResult)
+	err := c.cc.Invoke(ctx, SkillService_ValidateSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) RenderSkillInvocation(ctx context.Context, in *RenderSkillInvocationRequest, opts ...grpc.CallOption) (*RenderSkillInvocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderSkillInvocationResponse)
+	err := c.cc.Invoke(ctx, SkillService_RenderSkillInvocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) GetSkillUpstream(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillUpstreamStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillUpstreamStatus)
+	err := c.cc.Invoke(ctx, SkillService_GetSkillUpstream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) MergeUpstream(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*MergeUpstreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeUpstreamResponse)
+	err := c.cc.Invoke(ctx, SkillService_MergeUpstream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) SubmitSkillReview(ctx context.Context, in *SubmitSkillReviewRequest, opts ...grpc.CallOption) (*SkillReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReview)
+	err := c.cc.Invoke(ctx, SkillService_SubmitSkillReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ListSkillReviews(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReviewListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkillReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ListSkillAuditEvents(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*SkillAuditEventListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillAuditEventListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkillAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ListSkillReviewQueue(ctx context.Context, in *ListSkillReviewQueueRequest, opts ...grpc.CallOption) (*SkillReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReviewListResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkillReviewQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ApproveSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReview)
+	err := c.cc.Invoke(ctx, SkillService_ApproveSkillReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) RejectSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReview)
+	err := c.cc.Invoke(ctx, SkillService_RejectSkillReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) WithdrawSkillReview(ctx context.Context, in *SkillReviewDecisionRequest, opts ...grpc.CallOption) (*SkillReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkillReview)
+	err := c.cc.Invoke(ctx, SkillService_WithdrawSkillReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SkillServiceServer is the server API for SkillService service.
 // All implementations must embed UnimplementedSkillServiceServer
 // for forward compatibility.
@@ -153,9 +400,36 @@ type SkillServiceServer interface {
 	UpdateSkill(context.Context, *UpdateSkillByIdRequest) (*Skill, error)
 	DeleteSkill(context.Context, *GetSkillRequest) (*SuccessMessage, error)
 	ForkSkill(context.Context, *GetSkillRequest) (*Skill, error)
-	ListPublicSkills(context.Context, *Empty) (*SkillListResponse, error)
+	ListPublicSkills(context.Context, *ListPublicSkillsRequest) (*SkillListResponse, error)
+	// Tags used by public skills, most used first.
+	ListPublicSkillTags(context.Context, *Empty) (*SkillTagListResponse, error)
 	ListGroupSkills(context.Context, *ListGroupSkillsRequest) (*SkillListResponse, error)
 	ShareSkillToGroup(context.Context, *ShareSkillToGroupRequest) (*SuccessMessage, error)
+	// Version history
+	ListSkillVersions(context.Context, *GetSkillRequest) (*SkillVersionListResponse, error)
+	DiffSkillVersions(context.Context, *DiffSkillVersionsRequest) (*SkillVersionDiff, error)
+	RollbackSkill(context.Context, *RollbackSkillRequest) (*Skill, error)
+	ListSkillSources(context.Context, *Empty) (*SkillSourceListResponse, error)
+	CreateSkillSource(context.Context, *CreateSkillSourceRequest) (*SkillSource, error)
+	UpdateSkillSource(context.Context, *UpdateSkillSourceRequest) (*SkillSource, error)
+	DeleteSkillSource(context.Context, *GetSkillSourceRequest) (*SuccessMessage, error)
+	SyncSkillSource(context.Context, *GetSkillSourceRequest) (*SkillSourceSyncResult, error)
+	// Dry-run of the checks run when a skill is saved.
+	ValidateSkill(context.Context, *ValidateSkillRequest) (*SkillValidationResult, error)
+	// Builds the slash command for parameter values, optionally sending it
+	// into the user's terminal.
+	RenderSkillInvocation(context.Context, *RenderSkillInvocationRequest) (*RenderSkillInvocationResponse, error)
+	// Upstream tracking for forks.
+	GetSkillUpstream(context.Context, *GetSkillRequest) (*SkillUpstreamStatus, error)
+	MergeUpstream(context.Context, *GetSkillRequest) (*MergeUpstreamResponse, error)
+	// Review workflow: a revision becomes visible to others once approved.
+	SubmitSkillReview(context.Context, *SubmitSkillReviewRequest) (*SkillReview, error)
+	ListSkillReviews(context.Context, *GetSkillRequest) (*SkillReviewListResponse, error)
+	ListSkillAuditEvents(context.Context, *GetSkillRequest) (*SkillAuditEventListResponse, error)
+	ListSkillReviewQueue(context.Context, *ListSkillReviewQueueRequest) (*SkillReviewListResponse, error)
+	ApproveSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error)
+	RejectSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error)
+	WithdrawSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error)
 	mustEmbedUnimplementedSkillServiceServer()
 }
 
@@ -184,15 +458,75 @@ func (UnimplementedSkillServiceServer) DeleteSkill(context.Context, *GetSkillReq
 func (UnimplementedSkillServiceServer) ForkSkill(context.Context, *GetSkillRequest) (*Skill, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForkSkill not implemented")
 }
-func (UnimplementedSkillServiceServer) ListPublicSkills(context.Context, *Empty) (*SkillListResponse, error) {
+func (UnimplementedSkillServiceServer) ListPublicSkills(context.Context, *ListPublicSkillsRequest) (*SkillListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPublicSkills not implemented")
 }
+func (UnimplementedSkillServiceServer) ListPublicSkillTags(context.Context, *Empty) (*SkillTagListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicSkillTags not implemented")
+}
 func (UnimplementedSkillServiceServer) ListGroupSkills(context.Context, *ListGroupSkillsRequest) (*SkillListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSkills not implemented")
 }
 func (UnimplementedSkillServiceServer) ShareSkillToGroup(context.Context, *ShareSkillToGroupRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareSkillToGroup not implemented")
 }
+func (UnimplementedSkillServiceServer) ListSkillVersions(context.Context, *GetSkillRequest) (*SkillVersionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillVersions not implemented")
+}
+func (UnimplementedSkillServiceServer) DiffSkillVersions(context.Context, *DiffSkillVersionsRequest) (*SkillVersionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSkillVersions not implemented")
+}
+func (UnimplementedSkillServiceServer) RollbackSkill(context.Context, *RollbackSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) ListSkillSources(context.Context, *Empty) (*SkillSourceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillSources not implemented")
+}
+func (UnimplementedSkillServiceServer) CreateSkillSource(context.Context, *CreateSkillSourceRequest) (*SkillSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSkillSource not implemented")
+}
+func (UnimplementedSkillServiceServer) UpdateSkillSource(context.Context, *UpdateSkillSourceRequest) (*SkillSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSkillSource not implemented")
+}
+func (UnimplementedSkillServiceServer) DeleteSkillSource(context.Context, *GetSkillSourceRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSkillSource not implemented")
+}
+func (UnimplementedSkillServiceServer) SyncSkillSource(context.Context, *GetSkillSourceRequest) (*SkillSourceSyncResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSkillSource not implemented")
+}
+func (UnimplementedSkillServiceServer) ValidateSkill(context.Context, *ValidateSkillRequest) (*SkillValidationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) RenderSkillInvocation(context.Context, *RenderSkillInvocationRequest) (*RenderSkillInvocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderSkillInvocation not implemented")
+}
+func (UnimplementedSkillServiceServer) GetSkillUpstream(context.Context, *GetSkillRequest) (*SkillUpstreamStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkillUpstream not implemented")
+}
+func (UnimplementedSkillServiceServer) MergeUpstream(context.Context, *GetSkillRequest) (*MergeUpstreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeUpstream not implemented")
+}
+func (UnimplementedSkillServiceServer) SubmitSkillReview(context.Context, *SubmitSkillReviewRequest) (*SkillReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSkillReview not implemented")
+}
+func (UnimplementedSkillServiceServer) ListSkillReviews(context.Context, *GetSkillRequest) (*SkillReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillReviews not implemented")
+}
+func (UnimplementedSkillServiceServer) ListSkillAuditEvents(context.Context, *GetSkillRequest) (*SkillAuditEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillAuditEvents not implemented")
+}
+func (UnimplementedSkillServiceServer) ListSkillReviewQueue(context.Context, *ListSkillReviewQueueRequest) (*SkillReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillReviewQueue not implemented")
+}
+func (UnimplementedSkillServiceServer) ApproveSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSkillReview not implemented")
+}
+func (UnimplementedSkillServiceServer) RejectSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSkillReview not implemented")
+}
+func (UnimplementedSkillServiceServer) WithdrawSkillReview(context.Context, *SkillReviewDecisionRequest) (*SkillReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSkillReview not implemented")
+}
 func (UnimplementedSkillServiceServer) mustEmbedUnimplementedSkillServiceServer() {}
 func (UnimplementedSkillServiceServer) testEmbeddedByValue()                      {}
 
@@ -323,7 +657,7 @@ func _SkillService_ForkSkill_Handler(srv interface{}, ctx context.Context, dec f
 }
 
 func _SkillService_ListPublicSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListPublicSkillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -335,7 +669,25 @@ func _SkillService_ListPublicSkills_Handler(srv interface{}, ctx context.Context
 		FullMethod: SkillService_ListPublicSkills_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SkillServiceServer).ListPublicSkills(ctx, req.(*Empty))
+		return srv.(SkillServiceServer).ListPublicSkills(ctx, req.(*ListPublicSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListPublicSkillTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListPublicSkillTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListPublicSkillTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListPublicSkillTags(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -376,6 +728,348 @@ func _SkillService_ShareSkillToGroup_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkillService_ListSkillVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkillVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkillVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkillVersions(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_DiffSkillVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSkillVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).DiffSkillVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_DiffSkillVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).DiffSkillVersions(ctx, req.(*DiffSkillVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_RollbackSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).RollbackSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_RollbackSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).RollbackSkill(ctx, req.(*RollbackSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListSkillSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkillSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkillSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkillSources(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_CreateSkillSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkillSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).CreateSkillSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_CreateSkillSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).CreateSkillSource(ctx, req.(*CreateSkillSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_UpdateSkillSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSkillSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).UpdateSkillSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_UpdateSkillSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).UpdateSkillSource(ctx, req.(*UpdateSkillSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_DeleteSkillSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).DeleteSkillSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_DeleteSkillSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).DeleteSkillSource(ctx, req.(*GetSkillSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_SyncSkillSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).SyncSkillSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_SyncSkillSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).SyncSkillSource(ctx, req.(*GetSkillSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ValidateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ValidateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ValidateSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ValidateSkill(ctx, req.(*ValidateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_RenderSkillInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderSkillInvocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).RenderSkillInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_RenderSkillInvocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).RenderSkillInvocation(ctx, req.(*RenderSkillInvocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_GetSkillUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).GetSkillUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_GetSkillUpstream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).GetSkillUpstream(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_MergeUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).MergeUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_MergeUpstream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).MergeUpstream(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_SubmitSkillReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSkillReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).SubmitSkillReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_SubmitSkillReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).SubmitSkillReview(ctx, req.(*SubmitSkillReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListSkillReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkillReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkillReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkillReviews(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListSkillAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkillAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkillAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkillAuditEvents(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListSkillReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkillReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkillReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkillReviewQueue(ctx, req.(*ListSkillReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ApproveSkillReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkillReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ApproveSkillReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ApproveSkillReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ApproveSkillReview(ctx, req.(*SkillReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_RejectSkillReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkillReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).RejectSkillReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_RejectSkillReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).RejectSkillReview(ctx, req.(*SkillReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_WithdrawSkillReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkillReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).WithdrawSkillReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_WithdrawSkillReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).WithdrawSkillReview(ctx, req.(*SkillReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SkillService_ServiceDesc is the grpc.ServiceDesc for SkillService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +1105,10 @@ var SkillService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPublicSkills",
 			Handler:    _SkillService_ListPublicSkills_Handler,
 		},
+		{
+			MethodName: "ListPublicSkillTags",
+			Handler:    _SkillService_ListPublicSkillTags_Handler,
+		},
 		{
 			MethodName: "ListGroupSkills",
 			Handler:    _SkillService_ListGroupSkills_Handler,
@@ -419,6 +1117,82 @@ var SkillService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShareSkillToGroup",
 			Handler:    _SkillService_ShareSkillToGroup_Handler,
 		},
+		{
+			MethodName: "ListSkillVersions",
+			Handler:    _SkillService_ListSkillVersions_Handler,
+		},
+		{
+			MethodName: "DiffSkillVersions",
+			Handler:    _SkillService_DiffSkillVersions_Handler,
+		},
+		{
+			MethodName: "RollbackSkill",
+			Handler:    _SkillService_RollbackSkill_Handler,
+		},
+		{
+			MethodName: "ListSkillSources",
+			Handler:    _SkillService_ListSkillSources_Handler,
+		},
+		{
+			MethodName: "CreateSkillSource",
+			Handler:    _SkillService_CreateSkillSource_Handler,
+		},
+		{
+			MethodName: "UpdateSkillSource",
+			Handler:    _SkillService_UpdateSkillSource_Handler,
+		},
+		{
+			MethodName: "DeleteSkillSource",
+			Handler:    _SkillService_DeleteSkillSource_Handler,
+		},
+		{
+			MethodName: "SyncSkillSource",
+			Handler:    _SkillService_SyncSkillSource_Handler,
+		},
+		{
+			MethodName: "ValidateSkill",
+			Handler:    _SkillService_ValidateSkill_Handler,
+		},
+		{
+			MethodName: "RenderSkillInvocation",
+			Handler:    _SkillService_RenderSkillInvocation_Handler,
+		},
+		{
+			MethodName: "GetSkillUpstream",
+			Handler:    _SkillService_GetSkillUpstream_Handler,
+		},
+		{
+			MethodName: "MergeUpstream",
+			Handler:    _SkillService_MergeUpstream_Handler,
+		},
+		{
+			MethodName: "SubmitSkillReview",
+			Handler:    _SkillService_SubmitSkillReview_Handler,
+		},
+		{
+			MethodName: "ListSkillReviews",
+			Handler:    _SkillService_ListSkillReviews_Handler,
+		},
+		{
+			MethodName: "ListSkillAuditEvents",
+			Handler:    _SkillService_ListSkillAuditEvents_Handler,
+		},
+		{
+			MethodName: "ListSkillReviewQueue",
+			Handler:    _SkillService_ListSkillReviewQueue_Handler,
+		},
+		{
+			MethodName: "ApproveSkillReview",
+			Handler:    _SkillService_ApproveSkillReview_Handler,
+		},
+		{
+			MethodName: "RejectSkillReview",
+			Handler:    _SkillService_RejectSkillReview_Handler,
+		},
+		{
+			MethodName: "WithdrawSkillReview",
+			Handler:    _SkillService_WithdrawSkillReview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sac/v1/skill.proto",
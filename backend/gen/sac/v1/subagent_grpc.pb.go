@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: sac/v1/subagent.proto
+
+package sacv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SubagentService_ListSubagents_FullMethodName       = "/sac.v1.SubagentService/ListSubagents"
+	SubagentService_GetSubagent_FullMethodName         = "/sac.v1.SubagentService/GetSubagent"
+	SubagentService_CreateSubagent_FullMethodName      = "/sac.v1.SubagentService/CreateSubagent"
+	SubagentService_UpdateSubagent_FullMethodName      = "/sac.v1.SubagentService/UpdateSubagent"
+	SubagentService_DeleteSubagent_FullMethodName      = "/sac.v1.SubagentService/DeleteSubagent"
+	SubagentService_AdminCreateSubagent_FullMethodName = "/sac.v1.SubagentService/AdminCreateSubagent"
+	SubagentService_ListAgentSubagents_FullMethodName  = "/sac.v1.SubagentService/ListAgentSubagents"
+	SubagentService_InstallSubagent_FullMethodName     = "/sac.v1.SubagentService/InstallSubagent"
+	SubagentService_UninstallSubagent_FullMethodName   = "/sac.v1.SubagentService/UninstallSubagent"
+)
+
+// SubagentServiceClient is the client API for SubagentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubagentServiceClient interface {
+	// Own, official, public and group-shared subagents.
+	ListSubagents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubagentListResponse, error)
+	GetSubagent(ctx context.Context, in *SubagentByIdRequest, opts ...grpc.CallOption) (*Subagent, error)
+	CreateSubagent(ctx context.Context, in *CreateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error)
+	UpdateSubagent(ctx context.Context, in *UpdateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error)
+	DeleteSubagent(ctx context.Context, in *SubagentByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	AdminCreateSubagent(ctx context.Context, in *CreateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error)
+	// Per-agent installation, synced into the pod's .claude/agents.
+	ListAgentSubagents(ctx context.Context, in *ListAgentSubagentsRequest, opts ...grpc.CallOption) (*AgentSubagentListResponse, error)
+	InstallSubagent(ctx context.Context, in *AgentSubagentRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	UninstallSubagent(ctx context.Context, in *AgentSubagentRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+}
+
+type subagentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubagentServiceClient(cc grpc.ClientConnInterface) SubagentServiceClient {
+	return &subagentServiceClient{cc}
+}
+
+func (c *subagentServiceClient) ListSubagents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubagentListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubagentListResponse)
+	err := c.cc.Invoke(ctx, SubagentService_ListSubagents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) GetSubagent(ctx context.Context, in *SubagentByIdRequest, opts ...grpc.CallOption) (*Subagent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subagent)
+	err := c.cc.Invoke(ctx, SubagentService_GetSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) CreateSubagent(ctx context.Context, in *CreateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subagent)
+	err := c.cc.Invoke(ctx, SubagentService_CreateSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) UpdateSubagent(ctx context.Context, in *UpdateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subagent)
+	err := c.cc.Invoke(ctx, SubagentService_UpdateSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) DeleteSubagent(ctx context.Context, in *SubagentByIdRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SubagentService_DeleteSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) AdminCreateSubagent(ctx context.Context, in *CreateSubagentRequest, opts ...grpc.CallOption) (*Subagent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subagent)
+	err := c.cc.Invoke(ctx, SubagentService_AdminCreateSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) ListAgentSubagents(ctx context.Context, in *ListAgentSubagentsRequest, opts ...grpc.CallOption) (*AgentSubagentListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentSubagentListResponse)
+	err := c.cc.Invoke(ctx, SubagentService_ListAgentSubagents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) InstallSubagent(ctx context.Context, in *AgentSubagentRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SubagentService_InstallSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subagentServiceClient) UninstallSubagent(ctx context.Context, in *AgentSubagentRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, SubagentService_UninstallSubagent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubagentServiceServer is the server API for SubagentService service.
+// All implementations must embed UnimplementedSubagentServiceServer
+// for forward compatibility.
+type SubagentServiceServer interface {
+	// Own, official, public and group-shared subagents.
+	ListSubagents(context.Context, *Empty) (*SubagentListResponse, error)
+	GetSubagent(context.Context, *SubagentByIdRequest) (*Subagent, error)
+	CreateSubagent(context.Context, *CreateSubagentRequest) (*Subagent, error)
+	UpdateSubagent(context.Context, *UpdateSubagentRequest) (*Subagent, error)
+	DeleteSubagent(context.Context, *SubagentByIdRequest) (*SuccessMessage, error)
+	AdminCreateSubagent(context.Context, *CreateSubagentRequest) (*Subagent, error)
+	// Per-agent installation, synced into the pod's .claude/agents.
+	ListAgentSubagents(context.Context, *ListAgentSubagentsRequest) (*AgentSubagentListResponse, error)
+	InstallSubagent(context.Context, *AgentSubagentRequest) (*SuccessMessage, error)
+	UninstallSubagent(context.Context, *AgentSubagentRequest) (*SuccessMessage, error)
+	mustEmbedUnimplementedSubagentServiceServer()
+}
+
+// UnimplementedSubagentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubagentServiceServer struct{}
+
+func (UnimplementedSubagentServiceServer) ListSubagents(context.Context, *Empty) (*SubagentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubagents not implemented")
+}
+func (UnimplementedSubagentServiceServer) GetSubagent(context.Context, *SubagentByIdRequest) (*Subagent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) CreateSubagent(context.Context, *CreateSubagentRequest) (*Subagent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) UpdateSubagent(context.Context, *UpdateSubagentRequest) (*Subagent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) DeleteSubagent(context.Context, *SubagentByIdRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) AdminCreateSubagent(context.Context, *CreateSubagentRequest) (*Subagent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) ListAgentSubagents(context.Context, *ListAgentSubagentsRequest) (*AgentSubagentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentSubagents not implemented")
+}
+func (UnimplementedSubagentServiceServer) InstallSubagent(context.Context, *AgentSubagentRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) UninstallSubagent(context.Context, *AgentSubagentRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallSubagent not implemented")
+}
+func (UnimplementedSubagentServiceServer) mustEmbedUnimplementedSubagentServiceServer() {}
+func (UnimplementedSubagentServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSubagentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubagentServiceServer will
+// result in compilation errors.
+type UnsafeSubagentServiceServer interface {
+	mustEmbedUnimplementedSubagentServiceServer()
+}
+
+func RegisterSubagentServiceServer(s grpc.ServiceRegistrar, srv SubagentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSubagentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubagentService_ServiceDesc, srv)
+}
+
+func _SubagentService_ListSubagents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).ListSubagents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_ListSubagents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).ListSubagents(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_GetSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubagentByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).GetSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_GetSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).GetSubagent(ctx, req.(*SubagentByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_CreateSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubagentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).CreateSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_CreateSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).CreateSubagent(ctx, req.(*CreateSubagentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_UpdateSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubagentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).UpdateSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_UpdateSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).UpdateSubagent(ctx, req.(*UpdateSubagentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_DeleteSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubagentByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).DeleteSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_DeleteSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).DeleteSubagent(ctx, req.(*SubagentByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_AdminCreateSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubagentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).AdminCreateSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_AdminCreateSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).AdminCreateSubagent(ctx, req.(*CreateSubagentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_ListAgentSubagents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentSubagentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).ListAgentSubagents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_ListAgentSubagents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).ListAgentSubagents(ctx, req.(*ListAgentSubagentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_InstallSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentSubagentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).InstallSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_InstallSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).InstallSubagent(ctx, req.(*AgentSubagentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubagentService_UninstallSubagent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentSubagentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubagentServiceServer).UninstallSubagent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubagentService_UninstallSubagent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubagentServiceServer).UninstallSubagent(ctx, req.(*AgentSubagentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubagentService_ServiceDesc is the grpc.ServiceDesc for SubagentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubagentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sac.v1.SubagentService",
+	HandlerType: (*SubagentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSubagents",
+			Handler:    _SubagentService_ListSubagents_Handler,
+		},
+		{
+			MethodName: "GetSubagent",
+			Handler:    _SubagentService_GetSubagent_Handler,
+		},
+		{
+			MethodName: "CreateSubagent",
+			Handler:    _SubagentService_CreateSubagent_Handler,
+		},
+		{
+			MethodName: "UpdateSubagent",
+			Handler:    _SubagentService_UpdateSubagent_Handler,
+		},
+		{
+			MethodName: "DeleteSubagent",
+			Handler:    _SubagentService_DeleteSubagent_Handler,
+		},
+		{
+			MethodName: "AdminCreateSubagent",
+			Handler:    _SubagentService_AdminCreateSubagent_Handler,
+		},
+		{
+			MethodName: "ListAgentSubagents",
+			Handler:    _SubagentService_ListAgentSubagents_Handler,
+		},
+		{
+			MethodName: "InstallSubagent",
+			Handler:    _SubagentService_InstallSubagent_Handler,
+		},
+		{
+			MethodName: "UninstallSubagent",
+			Handler:    _SubagentService_UninstallSubagent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sac/v1/subagent.proto",
+}
@@ -22,8 +22,15 @@ const (
 	WorkspaceService_GetStatus_FullMethodName            = "/sac.v1.WorkspaceService/GetStatus"
 	WorkspaceService_ListOutputFiles_FullMethodName      = "/sac.v1.WorkspaceService/ListOutputFiles"
 	WorkspaceService_DeleteOutputFile_FullMethodName     = "/sac.v1.WorkspaceService/DeleteOutputFile"
+	WorkspaceService_ListInputFiles_FullMethodName       = "/sac.v1.WorkspaceService/ListInputFiles"
+	WorkspaceService_DeleteInputFile_FullMethodName      = "/sac.v1.WorkspaceService/DeleteInputFile"
+	WorkspaceService_GetWorkspaceQuota_FullMethodName    = "/sac.v1.WorkspaceService/GetWorkspaceQuota"
+	WorkspaceService_ListPodFiles_FullMethodName         = "/sac.v1.WorkspaceService/ListPodFiles"
+	WorkspaceService_ReadPodFile_FullMethodName          = "/sac.v1.WorkspaceService/ReadPodFile"
+	WorkspaceService_DeletePodFile_FullMethodName        = "/sac.v1.WorkspaceService/DeletePodFile"
 	WorkspaceService_CreateShare_FullMethodName          = "/sac.v1.WorkspaceService/CreateShare"
 	WorkspaceService_DeleteShare_FullMethodName          = "/sac.v1.WorkspaceService/DeleteShare"
+	WorkspaceService_ListMyShares_FullMethodName         = "/sac.v1.WorkspaceService/ListMyShares"
 	WorkspaceService_GetSharedFileMeta_FullMethodName    = "/sac.v1.WorkspaceService/GetSharedFileMeta"
 	WorkspaceService_InternalOutputDelete_FullMethodName = "/sac.v1.WorkspaceService/InternalOutputDelete"
 )
@@ -37,9 +44,19 @@ type WorkspaceServiceClient interface {
 	// Output workspace
 	ListOutputFiles(ctx context.Context, in *ListOutputFilesRequest, opts ...grpc.CallOption) (*FileListResponse, error)
 	DeleteOutputFile(ctx context.Context, in *DeleteOutputFileRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	// Input workspace (uploads are multipart/chunked Gin routes)
+	ListInputFiles(ctx context.Context, in *ListInputFilesRequest, opts ...grpc.CallOption) (*FileListResponse, error)
+	DeleteInputFile(ctx context.Context, in *DeleteInputFileRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	// Quota
+	GetWorkspaceQuota(ctx context.Context, in *GetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error)
+	// Live pod workspace (download is a streaming Gin route)
+	ListPodFiles(ctx context.Context, in *PodFileRequest, opts ...grpc.CallOption) (*FileListResponse, error)
+	ReadPodFile(ctx context.Context, in *ReadPodFileRequest, opts ...grpc.CallOption) (*PodFileContent, error)
+	DeletePodFile(ctx context.Context, in *PodFileRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
 	// Output sharing
 	CreateShare(ctx context.Context, in *CreateShareRequest, opts ...grpc.CallOption) (*ShareResponse, error)
 	DeleteShare(ctx context.Context, in *DeleteShareRequest, opts ...grpc.CallOption) (*SuccessMessage, error)
+	ListMyShares(ctx context.Context, in *ListMySharesRequest, opts ...grpc.CallOption) (*SharedLinkListResponse, error)
 	// Shared file (public, no auth)
 	GetSharedFileMeta(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (*SharedFileMeta, error)
 	// Internal (sidecar, no auth)
@@ -84,6 +101,66 @@ func (c *workspaceServiceClient) DeleteOutputFile(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *workspaceServiceClient) ListInputFiles(ctx context.Context, in *ListInputFilesRequest, opts ...grpc.CallOption) (*FileListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileListResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListInputFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteInputFile(ctx context.Context, in *DeleteInputFileRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteInputFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetWorkspaceQuota(ctx context.Context, in *GetWorkspaceQuotaRequest, opts ...grpc.CallOption) (*WorkspaceQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceQuota)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListPodFiles(ctx context.Context, in *PodFileRequest, opts ...grpc.CallOption) (*FileListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileListResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListPodFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ReadPodFile(ctx context.Context, in *ReadPodFileRequest, opts ...grpc.CallOption) (*PodFileContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PodFileContent)
+	err := c.cc.Invoke(ctx, WorkspaceService_ReadPodFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeletePodFile(ctx context.Context, in *PodFileRequest, opts ...grpc.CallOption) (*SuccessMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessMessage)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeletePodFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) CreateShare(ctx context.Context, in *CreateShareRequest, opts ...grpc.CallOption) (*ShareResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ShareResponse)
@@ -104,6 +181,16 @@ func (c *workspaceServiceClient) DeleteShare(ctx context.Context, in *DeleteShar
 	return out, nil
 }
 
+func (c *workspaceServiceClient) ListMyShares(ctx context.Context, in *ListMySharesRequest, opts ...grpc.CallOption) (*SharedLinkListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedLinkListResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListMyShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) GetSharedFileMeta(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (*SharedFileMeta, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SharedFileMeta)
@@ -133,9 +220,19 @@ type WorkspaceServiceServer interface {
 	// Output workspace
 	ListOutputFiles(context.Context, *ListOutputFilesRequest) (*FileListResponse, error)
 	DeleteOutputFile(context.Context, *DeleteOutputFileRequest) (*SuccessMessage, error)
+	// Input workspace (uploads are multipart/chunked Gin routes)
+	ListInputFiles(context.Context, *ListInputFilesRequest) (*FileListResponse, error)
+	DeleteInputFile(context.Context, *DeleteInputFileRequest) (*SuccessMessage, error)
+	// Quota
+	GetWorkspaceQuota(context.Context, *GetWorkspaceQuotaRequest) (*WorkspaceQuota, error)
+	// Live pod workspace (download is a streaming Gin route)
+	ListPodFiles(context.Context, *PodFileRequest) (*FileListResponse, error)
+	ReadPodFile(context.Context, *ReadPodFileRequest) (*PodFileContent, error)
+	DeletePodFile(context.Context, *PodFileRequest) (*SuccessMessage, error)
 	// Output sharing
 	CreateShare(context.Context, *CreateShareRequest) (*ShareResponse, error)
 	DeleteShare(context.Context, *DeleteShareRequest) (*SuccessMessage, error)
+	ListMyShares(context.Context, *ListMySharesRequest) (*SharedLinkListResponse, error)
 	// Shared file (public, no auth)
 	GetSharedFileMeta(context.Context, *GetSharedFileRequest) (*SharedFileMeta, error)
 	// Internal (sidecar, no auth)
@@ -159,12 +256,33 @@ func (UnimplementedWorkspaceServiceServer) ListOutputFiles(context.Context, *Lis
 func (UnimplementedWorkspaceServiceServer) DeleteOutputFile(context.Context, *DeleteOutputFileRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOutputFile not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) ListInputFiles(context.Context, *ListInputFilesRequest) (*FileListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInputFiles not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteInputFile(context.Context, *DeleteInputFileRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInputFile not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceQuota(context.Context, *GetWorkspaceQuotaRequest) (*WorkspaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceQuota not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListPodFiles(context.Context, *PodFileRequest) (*FileListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPodFiles not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ReadPodFile(context.Context, *ReadPodFileRequest) (*PodFileContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPodFile not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeletePodFile(context.Context, *PodFileRequest) (*SuccessMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePodFile not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) CreateShare(context.Context, *CreateShareRequest) (*ShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShare not implemented")
 }
 func (UnimplementedWorkspaceServiceServer) DeleteShare(context.Context, *DeleteShareRequest) (*SuccessMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShare not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) ListMyShares(context.Context, *ListMySharesRequest) (*SharedLinkListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyShares not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) GetSharedFileMeta(context.Context, *GetSharedFileRequest) (*SharedFileMeta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSharedFileMeta not implemented")
 }
@@ -246,6 +364,114 @@ func _WorkspaceService_DeleteOutputFile_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_ListInputFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInputFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListInputFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListInputFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListInputFiles(ctx, req.(*ListInputFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteInputFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInputFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteInputFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteInputFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteInputFile(ctx, req.(*DeleteInputFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceQuota(ctx, req.(*GetWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListPodFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListPodFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListPodFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListPodFiles(ctx, req.(*PodFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ReadPodFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPodFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ReadPodFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ReadPodFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ReadPodFile(ctx, req.(*ReadPodFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeletePodFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeletePodFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeletePodFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeletePodFile(ctx, req.(*PodFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_CreateShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShareRequest)
 	if err := dec(in); err != nil {
@@ -282,6 +508,24 @@ func _WorkspaceService_DeleteShare_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_ListMyShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListMyShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListMyShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListMyShares(ctx, req.(*ListMySharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_GetSharedFileMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSharedFileRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +581,30 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOutputFile",
 			Handler:    _WorkspaceService_DeleteOutputFile_Handler,
 		},
+		{
+			MethodName: "ListInputFiles",
+			Handler:    _WorkspaceService_ListInputFiles_Handler,
+		},
+		{
+			MethodName: "DeleteInputFile",
+			Handler:    _WorkspaceService_DeleteInputFile_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceQuota",
+			Handler:    _WorkspaceService_GetWorkspaceQuota_Handler,
+		},
+		{
+			MethodName: "ListPodFiles",
+			Handler:    _WorkspaceService_ListPodFiles_Handler,
+		},
+		{
+			MethodName: "ReadPodFile",
+			Handler:    _WorkspaceService_ReadPodFile_Handler,
+		},
+		{
+			MethodName: "DeletePodFile",
+			Handler:    _WorkspaceService_DeletePodFile_Handler,
+		},
 		{
 			MethodName: "CreateShare",
 			Handler:    _WorkspaceService_CreateShare_Handler,
@@ -345,6 +613,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShare",
 			Handler:    _WorkspaceService_DeleteShare_Handler,
 		},
+		{
+			MethodName: "ListMyShares",
+			Handler:    _WorkspaceService_ListMyShares_Handler,
+		},
 		{
 			MethodName: "GetSharedFileMeta",
 			Handler:    _WorkspaceService_GetSharedFileMeta_Handler,
@@ -0,0 +1,148 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+)
+
+func validQuotaLimits(maxBytes int64, maxFileCount int32) error {
+	if maxBytes <= 0 || maxFileCount <= 0 {
+		return grpcerr.BadRequest("max_bytes and max_file_count must be positive")
+	}
+	return nil
+}
+
+// GetUserWorkspaceQuotas lists the user's default (agent_id 0, if set) and
+// per-agent quotas with their current usage.
+func (s *Server) GetUserWorkspaceQuotas(ctx context.Context, req *sacv1.GetUserWorkspaceQuotasRequest) (*sacv1.WorkspaceQuotaListResponse, error) {
+	var quotas []models.WorkspaceQuota
+	err := s.db.NewSelect().Model(&quotas).
+		Where("user_id = ?", req.UserId).
+		Where("agent_id = 0 OR agent_id IN (SELECT id FROM agents WHERE created_by = ?)", req.UserId).
+		OrderExpr("agent_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to fetch workspace quotas", err)
+	}
+	out := make([]*sacv1.WorkspaceQuota, len(quotas))
+	for i := range quotas {
+		out[i] = convert.WorkspaceQuotaToProto(&quotas[i])
+	}
+	return &sacv1.WorkspaceQuotaListResponse{Quotas: out}, nil
+}
+
+// SetUserWorkspaceQuota sets the user's default limits and applies them to
+// every agent the user already has.
+func (s *Server) SetUserWorkspaceQuota(ctx context.Context, req *sacv1.SetWorkspaceQuotaRequest) (*sacv1.WorkspaceQuota, error) {
+	if err := validQuotaLimits(req.MaxBytes, req.MaxFileCount); err != nil {
+		return nil, err
+	}
+	exists, err := s.db.NewSelect().Model((*models.User)(nil)).Where("id = ?", req.UserId).Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load user", err)
+	}
+	if !exists {
+		return nil, grpcerr.NotFound("User not found")
+	}
+
+	quota := &models.WorkspaceQuota{UserID: req.UserId}
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := upsertQuotaLimits(ctx, tx, quota, req); err != nil {
+			return err
+		}
+		_, err := tx.NewUpdate().Model((*models.WorkspaceQuota)(nil)).
+			Set("max_bytes = ?", req.MaxBytes).
+			Set("max_file_count = ?", req.MaxFileCount).
+			Set("updated_at = ?", time.Now()).
+			Where("user_id = ? AND agent_id > 0", req.UserId).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update workspace quota", err)
+	}
+	return convert.WorkspaceQuotaToProto(quota), nil
+}
+
+// SetAgentWorkspaceQuota overrides the limits of one agent.
+func (s *Server) SetAgentWorkspaceQuota(ctx context.Context, req *sacv1.SetWorkspaceQuotaRequest) (*sacv1.WorkspaceQuota, error) {
+	if err := validQuotaLimits(req.MaxBytes, req.MaxFileCount); err != nil {
+		return nil, err
+	}
+	exists, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", req.AgentId, req.UserId).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return nil, grpcerr.NotFound("Agent not found")
+	}
+
+	quota := &models.WorkspaceQuota{UserID: req.UserId, AgentID: req.AgentId}
+	if err := upsertQuotaLimits(ctx, s.db, quota, req); err != nil {
+		return nil, grpcerr.Internal("Failed to update workspace quota", err)
+	}
+	return convert.WorkspaceQuotaToProto(quota), nil
+}
+
+// upsertQuotaLimits sets the limits of quota's row, keeping its usage
+// counters, and loads the stored row back into quota.
+func upsertQuotaLimits(ctx context.Context, db bun.IDB, quota *models.WorkspaceQuota, req *sacv1.SetWorkspaceQuotaRequest) error {
+	quota.MaxBytes = req.MaxBytes
+	quota.MaxFileCount = int(req.MaxFileCount)
+	quota.UpdatedAt = time.Now()
+	_, err := db.NewInsert().Model(quota).
+		On("CONFLICT (user_id, agent_id) DO UPDATE").
+		Set("max_bytes = EXCLUDED.max_bytes").
+		Set("max_file_count = EXCLUDED.max_file_count").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	return err
+}
+
+func (s *Server) GetGroupWorkspaceQuota(ctx context.Context, req *sacv1.GetGroupWorkspaceQuotaRequest) (*sacv1.GroupWorkspaceQuota, error) {
+	var quota models.GroupWorkspaceQuota
+	if err := s.db.NewSelect().Model(&quota).Where("group_id = ?", req.GroupId).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Group quota not found", err)
+	}
+	return convert.GroupWorkspaceQuotaToProto(&quota), nil
+}
+
+func (s *Server) SetGroupWorkspaceQuota(ctx context.Context, req *sacv1.SetGroupWorkspaceQuotaRequest) (*sacv1.GroupWorkspaceQuota, error) {
+	if err := validQuotaLimits(req.MaxBytes, req.MaxFileCount); err != nil {
+		return nil, err
+	}
+	exists, err := s.db.NewSelect().Model((*models.Group)(nil)).Where("id = ?", req.GroupId).Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load group", err)
+	}
+	if !exists {
+		return nil, grpcerr.NotFound("Group not found")
+	}
+
+	quota := &models.GroupWorkspaceQuota{
+		GroupID:      req.GroupId,
+		MaxBytes:     req.MaxBytes,
+		MaxFileCount: int(req.MaxFileCount),
+		UpdatedAt:    time.Now(),
+	}
+	_, err = s.db.NewInsert().Model(quota).
+		On("CONFLICT (group_id) DO UPDATE").
+		Set("max_bytes = EXCLUDED.max_bytes").
+		Set("max_file_count = EXCLUDED.max_file_count").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update group quota", err)
+	}
+	return convert.GroupWorkspaceQuotaToProto(quota), nil
+}
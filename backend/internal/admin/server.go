@@ -562,6 +562,7 @@ func maintenanceEnvVars() []corev1.EnvVar {
 	keys := []string{
 		"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME",
 		"K8S_NAMESPACE", "DOCKER_REGISTRY", "DOCKER_IMAGE", "SIDECAR_IMAGE",
+		"NOTIFY_ALLOW_PRIVATE_TARGETS",
 	}
 	var envs []corev1.EnvVar
 	for _, k := range keys {
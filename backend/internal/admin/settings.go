@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 
 	"g.echo.tech/dev/sac/internal/models"
 	"github.com/uptrace/bun"
@@ -109,3 +110,84 @@ func (s *SettingsService) GetResourceLimits(ctx context.Context, userID int64) R
 	}
 	return rc
 }
+
+// Session idle actions (session_idle_action setting).
+const (
+	IdleActionStopProcess = "stop_process" // stop claude; the pod keeps running
+	IdleActionScaleDown   = "scale_down"   // scale the agent StatefulSet to zero
+	IdleActionNone        = "none"         // only mark the session idle
+)
+
+// IdleConfig is the effective session idle policy for a user.
+type IdleConfig struct {
+	Timeout time.Duration // 0 = never
+	Warning time.Duration // lead time of the client warning, 0 = none
+	Action  string
+}
+
+// GetIdleConfig resolves the idle policy of a user. The timeout comes from
+// a per-user override, else the most lenient override among the user's
+// groups (0 = never wins), else the system default.
+func (s *SettingsService) GetIdleConfig(ctx context.Context, userID int64) IdleConfig {
+	cfg := IdleConfig{Action: IdleActionStopProcess}
+
+	minutes := -1
+	var userSetting models.UserSetting
+	err := s.db.NewSelect().Model(&userSetting).
+		Where("user_id = ? AND key = ?", userID, "session_idle_timeout_minutes").
+		Scan(ctx)
+	if err == nil {
+		minutes = settingInt(userSetting.Value, -1)
+	}
+
+	if minutes < 0 {
+		var groupMinutes []int
+		_ = s.db.NewSelect().
+			TableExpr("groups AS g").
+			Join("JOIN group_members AS gm ON gm.group_id = g.id").
+			ColumnExpr("g.session_idle_timeout_minutes").
+			Where("gm.user_id = ?", userID).
+			Where("g.session_idle_timeout_minutes IS NOT NULL").
+			Scan(ctx, &groupMinutes)
+		for _, m := range groupMinutes {
+			if m == 0 {
+				minutes = 0
+				break
+			}
+			if m > minutes {
+				minutes = m
+			}
+		}
+	}
+
+	if minutes < 0 {
+		val, _ := s.GetSetting(ctx, "session_idle_timeout_minutes")
+		minutes, _ = strconv.Atoi(val)
+	}
+	if minutes > 0 {
+		cfg.Timeout = time.Duration(minutes) * time.Minute
+	}
+
+	if val, err := s.GetSetting(ctx, "session_idle_warning_minutes"); err == nil {
+		if n, err := strconv.Atoi(val); err == nil && n > 0 {
+			cfg.Warning = time.Duration(n) * time.Minute
+		}
+	}
+	if val, _ := s.GetSetting(ctx, "session_idle_action"); val == IdleActionScaleDown || val == IdleActionNone {
+		cfg.Action = val
+	}
+	return cfg
+}
+
+// settingInt decodes a JSONB setting value holding a number or numeric string.
+func settingInt(v models.SettingValue, fallback int) int {
+	var str string
+	if err := json.Unmarshal([]byte(v), &str); err != nil {
+		str = string(v)
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
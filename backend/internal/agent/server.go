@@ -2,6 +2,7 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"g.echo.tech/dev/sac/internal/grpcerr"
 	"g.echo.tech/dev/sac/internal/models"
 	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/subagent"
 	"github.com/uptrace/bun"
 )
 
@@ -263,13 +265,25 @@ func (s *Server) InstallSkill(ctx context.Context, req *sacv1.InstallSkillByAgen
 	var sk models.Skill
 	err = s.db.NewSelect().Model(&sk).
 		Where("id = ?", req.SkillId).
-		Where(`(is_official = ? OR created_by = ? OR is_public = ?
-			OR group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))`,
-			true, userID, true, userID).
+		Where(`(created_by = ? OR (published_version IS NOT NULL AND (is_official OR is_public
+			OR group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))))`,
+			userID, userID).
 		Scan(ctx)
 	if err != nil {
 		return nil, grpcerr.NotFound("Skill not found", err)
 	}
+	if req.PinnedVersion != nil {
+		if err := s.checkPinnableVersion(ctx, &sk, userID, int(*req.PinnedVersion)); err != nil {
+			return nil, err
+		}
+	}
+	missing, err := subagent.MissingForSkill(ctx, s.db, userID, req.AgentId, &sk)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to check subagents", err)
+	}
+	if missing != "" {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("Skill %s runs in subagent %q; install it on this agent first", sk.Name, missing))
+	}
 
 	var maxOrder int
 	_ = s.db.NewSelect().
@@ -285,6 +299,10 @@ func (s *Server) InstallSkill(ctx context.Context, req *sacv1.InstallSkillByAgen
 		SkillID: req.SkillId,
 		Order:   maxOrder + 1,
 	}
+	if req.PinnedVersion != nil {
+		v := int(*req.PinnedVersion)
+		agentSkill.PinnedVersion = &v
+	}
 
 	_, err = s.db.NewInsert().
 		Model(agentSkill).
@@ -294,36 +312,7 @@ func (s *Server) InstallSkill(ctx context.Context, req *sacv1.InstallSkillByAgen
 		return nil, grpcerr.Internal("Failed to install skill", err)
 	}
 
-	go func() {
-		bgCtx := context.Background()
-		userIDStr := fmt.Sprintf("%d", userID)
-		if err := s.syncService.SyncSkillToAgent(bgCtx, userIDStr, req.AgentId, &sk); err != nil {
-			log.Warn().Err(err).Str("command", sk.CommandName).Int64("agent_id", req.AgentId).Msg("failed to sync skill")
-			s.publishSync(bgCtx, userID, req.AgentId, skill.SkillSyncEvent{
-				Action: "error", SkillID: sk.ID, SkillName: sk.Name,
-				CommandName: sk.CommandName, AgentID: req.AgentId,
-				Step: "done", Message: "Failed to sync skill",
-			})
-			return
-		}
-
-		// Restart Claude Code process to reload skills
-		s.publishSync(bgCtx, userID, req.AgentId, skill.SkillSyncEvent{
-			Action: "progress", SkillID: sk.ID, SkillName: sk.Name,
-			CommandName: sk.CommandName, AgentID: req.AgentId,
-			Step: "restarting_process", Message: "Restarting Claude Code...",
-		})
-		podName := fmt.Sprintf("claude-code-%s-%d-0", userIDStr, req.AgentId)
-		if err := s.containerManager.RestartClaudeCodeProcess(bgCtx, podName); err != nil {
-			log.Warn().Err(err).Str("pod", podName).Msg("failed to restart Claude Code")
-		}
-
-		s.publishSync(bgCtx, userID, req.AgentId, skill.SkillSyncEvent{
-			Action: "complete", SkillID: sk.ID, SkillName: sk.Name,
-			CommandName: sk.CommandName, AgentID: req.AgentId,
-			Step: "done", Message: fmt.Sprintf("Skill %s installed", sk.Name),
-		})
-	}()
+	go s.syncInstalledSkill(userID, req.AgentId, &sk, fmt.Sprintf("Skill %s installed", sk.Name))
 
 	return &sacv1.SuccessMessage{Message: "Skill installed successfully"}, nil
 }
@@ -376,6 +365,146 @@ func (s *Server) UninstallSkill(ctx context.Context, req *sacv1.UninstallSkillRe
 	return &sacv1.SuccessMessage{Message: "Skill uninstalled successfully"}, nil
 }
 
+// syncInstalledSkill pushes one installed skill to the agent pod and
+// restarts Claude Code, reporting progress on the sync channel.
+func (s *Server) syncInstalledSkill(userID, agentID int64, sk *models.Skill, doneMessage string) {
+	bgCtx := context.Background()
+	userIDStr := fmt.Sprintf("%d", userID)
+	if err := s.syncService.SyncSkillToAgent(bgCtx, userIDStr, agentID, sk); err != nil {
+		log.Warn().Err(err).Str("command", sk.CommandName).Int64("agent_id", agentID).Msg("failed to sync skill")
+		s.publishSync(bgCtx, userID, agentID, skill.SkillSyncEvent{
+			Action: "error", SkillID: sk.ID, SkillName: sk.Name,
+			CommandName: sk.CommandName, AgentID: agentID,
+			Step: "done", Message: "Failed to sync skill",
+		})
+		return
+	}
+
+	// Restart Claude Code process to reload skills
+	s.publishSync(bgCtx, userID, agentID, skill.SkillSyncEvent{
+		Action: "progress", SkillID: sk.ID, SkillName: sk.Name,
+		CommandName: sk.CommandName, AgentID: agentID,
+		Step: "restarting_process", Message: "Restarting Claude Code...",
+	})
+	podName := fmt.Sprintf("claude-code-%s-%d-0", userIDStr, agentID)
+	if err := s.containerManager.RestartClaudeCodeProcess(bgCtx, podName); err != nil {
+		log.Warn().Err(err).Str("pod", podName).Msg("failed to restart Claude Code")
+	}
+
+	s.publishSync(bgCtx, userID, agentID, skill.SkillSyncEvent{
+		Action: "complete", SkillID: sk.ID, SkillName: sk.Name,
+		CommandName: sk.CommandName, AgentID: agentID,
+		Step: "done", Message: doneMessage,
+	})
+}
+
+// checkPinnableVersion verifies that a skill version exists and that its
+// bundle is still available to sync. The latest version is always pinnable;
+// other users' skills can only be pinned up to the published version.
+func (s *Server) checkPinnableVersion(ctx context.Context, sk *models.Skill, userID int64, version int) error {
+	maxVersion := sk.Version
+	if sk.CreatedBy != userID && sk.PublishedVersion != nil {
+		maxVersion = *sk.PublishedVersion
+	}
+	if version < 1 || version > maxVersion {
+		return grpcerr.BadRequest(fmt.Sprintf("version must be between 1 and %d", maxVersion))
+	}
+	if version == sk.Version {
+		return nil
+	}
+	available, err := s.db.NewSelect().
+		Model((*models.SkillVersion)(nil)).
+		Where("skill_id = ? AND version = ?", sk.ID, version).
+		Where("bundle_key <> ''").
+		Exists(ctx)
+	if err != nil {
+		return grpcerr.Internal("Failed to load skill version", err)
+	}
+	if !available {
+		return grpcerr.BadRequest(fmt.Sprintf("version %d of this skill cannot be pinned", version))
+	}
+	return nil
+}
+
+// loadInstalledSkill returns the agent_skills row with its skill for an
+// agent owned by the user.
+func (s *Server) loadInstalledSkill(ctx context.Context, userID, agentID, skillID int64) (*models.AgentSkill, error) {
+	exists, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", agentID, userID).
+		Exists(ctx)
+	if err != nil || !exists {
+		return nil, grpcerr.NotFound("Agent not found", err)
+	}
+
+	var as models.AgentSkill
+	err = s.db.NewSelect().Model(&as).
+		Relation("Skill").
+		Where("?TableAlias.agent_id = ? AND ?TableAlias.skill_id = ?", agentID, skillID).
+		Scan(ctx)
+	if err != nil || as.Skill == nil {
+		return nil, grpcerr.NotFound("Skill is not installed on this agent", err)
+	}
+	return &as, nil
+}
+
+// SetAgentSkillVersion pins an installed skill to a version, or switches it
+// back to following the latest version, and re-syncs it.
+func (s *Server) SetAgentSkillVersion(ctx context.Context, req *sacv1.SetAgentSkillVersionRequest) (*sacv1.AgentSkill, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	as, err := s.loadInstalledSkill(ctx, userID, req.AgentId, req.SkillId)
+	if err != nil {
+		return nil, err
+	}
+
+	as.PinnedVersion = nil
+	if req.PinnedVersion != nil {
+		if err := s.checkPinnableVersion(ctx, as.Skill, userID, int(*req.PinnedVersion)); err != nil {
+			return nil, err
+		}
+		v := int(*req.PinnedVersion)
+		as.PinnedVersion = &v
+	}
+
+	_, err = s.db.NewUpdate().Model(as).Column("pinned_version").WherePK().Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update skill version", err)
+	}
+
+	msg := fmt.Sprintf("Skill %s now follows the latest version", as.Skill.Name)
+	if as.PinnedVersion != nil {
+		msg = fmt.Sprintf("Skill %s pinned to version %d", as.Skill.Name, *as.PinnedVersion)
+	}
+	go s.syncInstalledSkill(userID, req.AgentId, as.Skill, msg)
+
+	return convert.AgentSkillToProto(as, userID), nil
+}
+
+// UpgradeAgentSkill moves an installed skill to the latest version. A pinned
+// installation is re-pinned to it; one that follows the latest is re-synced.
+func (s *Server) UpgradeAgentSkill(ctx context.Context, req *sacv1.AgentSkillRequest) (*sacv1.AgentSkill, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	as, err := s.loadInstalledSkill(ctx, userID, req.AgentId, req.SkillId)
+	if err != nil {
+		return nil, err
+	}
+
+	if as.PinnedVersion != nil && *as.PinnedVersion != as.Skill.Version {
+		v := as.Skill.Version
+		as.PinnedVersion = &v
+		_, err = s.db.NewUpdate().Model(as).Column("pinned_version").WherePK().Exec(ctx)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to upgrade skill", err)
+		}
+	}
+
+	go s.syncInstalledSkill(userID, req.AgentId, as.Skill,
+		fmt.Sprintf("Skill %s upgraded to version %d", as.Skill.Name, as.Skill.Version))
+
+	return convert.AgentSkillToProto(as, userID), nil
+}
+
 func (s *Server) SyncSkills(ctx context.Context, req *sacv1.GetAgentRequest) (*sacv1.SuccessMessage, error) {
 	userID := ctxkeys.UserID(ctx)
 
@@ -389,6 +518,9 @@ func (s *Server) SyncSkills(ctx context.Context, req *sacv1.GetAgentRequest) (*s
 
 	userIDStr := fmt.Sprintf("%d", userID)
 	if err := s.syncService.SyncAllSkillsToAgent(ctx, userIDStr, req.Id); err != nil {
+		if errors.Is(err, skill.ErrPodUnavailable) {
+			return nil, grpcerr.Unavailable("Agent is not running")
+		}
 		log.Error().Err(err).Int64("agent_id", req.Id).Msg("failed to sync skills")
 		return nil, grpcerr.Internal("Failed to sync skills", err)
 	}
@@ -402,6 +534,42 @@ func (s *Server) SyncSkills(ctx context.Context, req *sacv1.GetAgentRequest) (*s
 	return &sacv1.SuccessMessage{Message: "Skills synced successfully"}, nil
 }
 
+// GetAgentSyncStatus reports the sync status of every skill installed on
+// an agent, including failures from background and maintenance syncs.
+func (s *Server) GetAgentSyncStatus(ctx context.Context, req *sacv1.GetAgentRequest) (*sacv1.AgentSyncStatus, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	exists, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", req.Id, userID).
+		Exists(ctx)
+	if err != nil || !exists {
+		return nil, grpcerr.NotFound("Agent not found", err)
+	}
+
+	var installed []models.AgentSkill
+	err = s.db.NewSelect().Model(&installed).
+		Relation("Skill").
+		Where("?TableAlias.agent_id = ?", req.Id).
+		Order("as.order ASC", "as.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load skill sync status", err)
+	}
+
+	resp := &sacv1.AgentSyncStatus{AgentId: req.Id}
+	for i := range installed {
+		as := &installed[i]
+		switch {
+		case as.SyncError != "":
+			resp.Failed++
+		case as.SyncedVersion == 0:
+			resp.Pending++
+		}
+		resp.Skills = append(resp.Skills, convert.AgentSkillToProto(as, userID))
+	}
+	return resp, nil
+}
+
 func (s *Server) GetAgentStatuses(ctx context.Context, _ *sacv1.Empty) (*sacv1.AgentStatusListResponse, error) {
 	userID := ctxkeys.UserID(ctx)
 	userIDStr := fmt.Sprintf("%d", userID)
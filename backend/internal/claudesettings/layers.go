@@ -0,0 +1,89 @@
+package claudesettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// platformKey is the system setting holding the platform default layer.
+const platformKey = "claude_settings"
+
+// ManagedSettingsPath is where merged settings are written in agent pods.
+// Claude Code gives managed settings precedence over user and project
+// settings, so users cannot loosen them from inside the pod; hooks from
+// the shared settings.json still run alongside.
+const ManagedSettingsPath = "/etc/claude-code/managed-settings.json"
+
+// Platform returns the platform default layer.
+func Platform(ctx context.Context, db bun.IDB) (models.ClaudeSettings, error) {
+	var c models.ClaudeSettings
+	var setting models.SystemSetting
+	err := db.NewSelect().Model(&setting).Where("key = ?", platformKey).Scan(ctx)
+	if err != nil {
+		return c, err
+	}
+	if len(setting.Value) > 0 {
+		if err := json.Unmarshal(setting.Value, &c); err != nil {
+			return c, fmt.Errorf("invalid %s setting: %w", platformKey, err)
+		}
+	}
+	return c, nil
+}
+
+// Layers returns the settings layers of an agent, lowest precedence first:
+// the platform default, the owner's groups by name, then the agent.
+// Layers that set nothing are left out.
+func Layers(ctx context.Context, db bun.IDB, agent *models.Agent) []Layer {
+	var layers []Layer
+	platform, err := Platform(ctx, db)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to load platform claude settings")
+	}
+	if !platform.IsZero() {
+		layers = append(layers, Layer{Source: SourcePlatform, Settings: platform})
+	}
+
+	var groups []models.Group
+	err = db.NewSelect().Model(&groups).
+		Column("g.id", "g.name", "g.claude_settings").
+		Join("JOIN group_members AS gm ON gm.group_id = g.id").
+		Where("gm.user_id = ?", agent.CreatedBy).
+		Where("g.claude_settings != '{}'::jsonb").
+		OrderExpr("g.name ASC").
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("user_id", agent.CreatedBy).Msg("failed to load group claude settings")
+	}
+	for _, g := range groups {
+		if !g.ClaudeSettings.IsZero() {
+			layers = append(layers, Layer{Source: SourceGroup, ID: g.ID, Name: g.Name, Settings: g.ClaudeSettings})
+		}
+	}
+
+	if !agent.ClaudeSettings.IsZero() {
+		layers = append(layers, Layer{Source: SourceAgent, ID: agent.ID, Name: agent.Name, Settings: agent.ClaudeSettings})
+	}
+	return layers
+}
+
+// WriteToPod merges the agent's settings and writes them into its pod,
+// removing the file when no layer sets anything.
+func WriteToPod(ctx context.Context, db bun.IDB, containerManager *container.Manager, agent *models.Agent) error {
+	merged, _ := Merge(Layers(ctx, db, agent))
+	content, err := Render(&merged)
+	if err != nil {
+		return err
+	}
+
+	pod := fmt.Sprintf("claude-code-%d-%d-0", agent.CreatedBy, agent.ID)
+	if content == "" {
+		return containerManager.DeleteFileInPod(ctx, pod, ManagedSettingsPath)
+	}
+	return containerManager.WriteFileInPod(ctx, pod, ManagedSettingsPath, content)
+}
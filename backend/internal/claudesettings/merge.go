@@ -0,0 +1,258 @@
+package claudesettings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/models"
+)
+
+// Layer sources, lowest precedence first.
+const (
+	SourcePlatform = "platform"
+	SourceGroup    = "group"
+	SourceAgent    = "agent"
+)
+
+const (
+	maxRules       = 200
+	maxHooks       = 50
+	maxHookTimeout = 600
+)
+
+var (
+	rulePattern   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\(.+\))?$`)
+	modelPattern  = regexp.MustCompile(`^(default|sonnet|opus|haiku|opusplan|claude-[a-z0-9.-]+)(\[1m\])?$`)
+	envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+var validModes = map[string]bool{
+	"default": true, "acceptEdits": true, "plan": true, "bypassPermissions": true,
+}
+
+var validEvents = map[string]bool{
+	"PreToolUse": true, "PostToolUse": true, "UserPromptSubmit": true, "Notification": true,
+	"Stop": true, "SubagentStop": true, "PreCompact": true, "SessionStart": true, "SessionEnd": true,
+}
+
+// Layer is one level of settings with where it came from.
+type Layer struct {
+	Source   string
+	ID       int64
+	Name     string
+	Settings models.ClaudeSettings
+}
+
+// Validate normalizes a settings layer and rejects invalid ones.
+func Validate(c *models.ClaudeSettings) error {
+	var err error
+	if c.Allow, err = normalizeRules("allow", c.Allow); err != nil {
+		return err
+	}
+	if c.Deny, err = normalizeRules("deny", c.Deny); err != nil {
+		return err
+	}
+	if c.Ask, err = normalizeRules("ask", c.Ask); err != nil {
+		return err
+	}
+
+	c.DefaultMode = strings.TrimSpace(c.DefaultMode)
+	if c.DefaultMode != "" && !validModes[c.DefaultMode] {
+		return errors.New("default_mode must be one of default, acceptEdits, plan, bypassPermissions")
+	}
+	if c.DisableBypassPermissions && c.DefaultMode == "bypassPermissions" {
+		return errors.New("default_mode bypassPermissions contradicts disable_bypass_permissions")
+	}
+	c.Model = strings.TrimSpace(c.Model)
+	if c.Model != "" && !modelPattern.MatchString(c.Model) {
+		return fmt.Errorf("model %q is not supported", c.Model)
+	}
+	for k := range c.Env {
+		if !envKeyPattern.MatchString(k) {
+			return fmt.Errorf("invalid env variable name %q", k)
+		}
+	}
+
+	if len(c.Hooks) > maxHooks {
+		return fmt.Errorf("at most %d hooks", maxHooks)
+	}
+	for i := range c.Hooks {
+		h := &c.Hooks[i]
+		h.Matcher = strings.TrimSpace(h.Matcher)
+		h.Command = strings.TrimSpace(h.Command)
+		if !validEvents[h.Event] {
+			return fmt.Errorf("unknown hook event %q", h.Event)
+		}
+		if h.Command == "" {
+			return errors.New("hook command is required")
+		}
+		if h.Timeout < 0 || h.Timeout > maxHookTimeout {
+			return fmt.Errorf("hook timeout must be between 0 and %d seconds", maxHookTimeout)
+		}
+	}
+	return nil
+}
+
+func normalizeRules(field string, rules []string) ([]string, error) {
+	if len(rules) > maxRules {
+		return nil, fmt.Errorf("at most %d %s rules", maxRules, field)
+	}
+	out := make([]string, 0, len(rules))
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		r = strings.TrimSpace(r)
+		if r == "" || seen[r] {
+			continue
+		}
+		if !rulePattern.MatchString(r) {
+			return nil, fmt.Errorf("invalid %s rule %q", field, r)
+		}
+		seen[r] = true
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// Merge combines layers, lowest precedence first. Deny and ask rules
+// accumulate and an allow rule never overrides a deny from any layer.
+// Default mode and model come from the most specific layer that sets them;
+// env values from later layers win; hooks accumulate. Returns the merged
+// settings and notes on what was dropped.
+func Merge(layers []Layer) (models.ClaudeSettings, []string) {
+	var merged models.ClaudeSettings
+	var notes []string
+	denied := map[string]bool{}
+
+	for _, l := range layers {
+		s := l.Settings
+		merged.Deny = appendUnique(merged.Deny, s.Deny...)
+		merged.Ask = appendUnique(merged.Ask, s.Ask...)
+		for _, r := range s.Deny {
+			denied[r] = true
+		}
+		merged.DisableBypassPermissions = merged.DisableBypassPermissions || s.DisableBypassPermissions
+		if s.DefaultMode != "" {
+			merged.DefaultMode = s.DefaultMode
+		}
+		if s.Model != "" {
+			merged.Model = s.Model
+		}
+		for k, v := range s.Env {
+			if merged.Env == nil {
+				merged.Env = map[string]string{}
+			}
+			merged.Env[k] = v
+		}
+		merged.Hooks = append(merged.Hooks, s.Hooks...)
+	}
+
+	for _, l := range layers {
+		for _, r := range l.Settings.Allow {
+			if denied[r] {
+				notes = append(notes, fmt.Sprintf("allow %q from %s is denied by policy", r, l.label()))
+				continue
+			}
+			merged.Allow = appendUnique(merged.Allow, r)
+		}
+	}
+
+	if merged.DisableBypassPermissions && merged.DefaultMode == "bypassPermissions" {
+		notes = append(notes, "default_mode bypassPermissions is disabled by policy")
+		merged.DefaultMode = ""
+	}
+	return merged, notes
+}
+
+func (l Layer) label() string {
+	if l.Name == "" {
+		return l.Source
+	}
+	return fmt.Sprintf("%s %q", l.Source, l.Name)
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, it := range items {
+		dup := false
+		for _, existing := range list {
+			if existing == it {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			list = append(list, it)
+		}
+	}
+	return list
+}
+
+// Render returns merged settings as a Claude Code settings.json document,
+// or "" when there is nothing to write.
+func Render(c *models.ClaudeSettings) (string, error) {
+	if c.IsZero() {
+		return "", nil
+	}
+
+	doc := map[string]any{}
+	perms := map[string]any{}
+	if len(c.Allow) > 0 {
+		perms["allow"] = c.Allow
+	}
+	if len(c.Deny) > 0 {
+		perms["deny"] = c.Deny
+	}
+	if len(c.Ask) > 0 {
+		perms["ask"] = c.Ask
+	}
+	if c.DefaultMode != "" {
+		perms["defaultMode"] = c.DefaultMode
+	}
+	if c.DisableBypassPermissions {
+		perms["disableBypassPermissionsMode"] = "disable"
+	}
+	if len(perms) > 0 {
+		doc["permissions"] = perms
+	}
+	if c.Model != "" {
+		doc["model"] = c.Model
+	}
+	if len(c.Env) > 0 {
+		doc["env"] = c.Env
+	}
+
+	if len(c.Hooks) > 0 {
+		type matcherGroup struct {
+			Matcher string           `json:"matcher"`
+			Hooks   []map[string]any `json:"hooks"`
+		}
+		hooks := map[string][]*matcherGroup{}
+		for _, h := range c.Hooks {
+			cmd := map[string]any{"type": "command", "command": h.Command}
+			if h.Timeout > 0 {
+				cmd["timeout"] = h.Timeout
+			}
+			var group *matcherGroup
+			for _, g := range hooks[h.Event] {
+				if g.Matcher == h.Matcher {
+					group = g
+					break
+				}
+			}
+			if group == nil {
+				group = &matcherGroup{Matcher: h.Matcher}
+				hooks[h.Event] = append(hooks[h.Event], group)
+			}
+			group.Hooks = append(group.Hooks, cmd)
+		}
+		doc["hooks"] = hooks
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,188 @@
+package claudesettings
+
+import (
+	"context"
+	"encoding/json"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// Server implements ClaudeSettingsServiceServer. Changes take effect in a
+// pod on the next session start.
+type Server struct {
+	sacv1.UnimplementedClaudeSettingsServiceServer
+	db *bun.DB
+}
+
+func NewServer(db *bun.DB) *Server {
+	return &Server{db: db}
+}
+
+func (s *Server) groupRole(ctx context.Context, groupID, userID int64) (string, bool) {
+	var member models.GroupMember
+	err := s.db.NewSelect().Model(&member).
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Scan(ctx)
+	if err != nil {
+		return "", false
+	}
+	return member.Role, true
+}
+
+func (s *Server) ownedAgent(ctx context.Context, agentID int64) (*models.Agent, error) {
+	var agent models.Agent
+	err := s.db.NewSelect().Model(&agent).
+		Where("id = ? AND created_by = ?", agentID, ctxkeys.UserID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Agent not found", err)
+	}
+	return &agent, nil
+}
+
+func validated(pb *sacv1.ClaudeSettings) (models.ClaudeSettings, error) {
+	c := convert.ClaudeSettingsFromProto(pb)
+	if err := Validate(&c); err != nil {
+		return c, grpcerr.BadRequest(err.Error())
+	}
+	return c, nil
+}
+
+func (s *Server) GetPlatformClaudeSettings(ctx context.Context, _ *sacv1.Empty) (*sacv1.ClaudeSettings, error) {
+	if ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Admin access required")
+	}
+	c, err := Platform(ctx, s.db)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load platform settings", err)
+	}
+	return convert.ClaudeSettingsToProto(&c), nil
+}
+
+func (s *Server) UpdatePlatformClaudeSettings(ctx context.Context, req *sacv1.UpdatePlatformClaudeSettingsRequest) (*sacv1.ClaudeSettings, error) {
+	if ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Admin access required")
+	}
+	c, err := validated(req.Settings)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(c)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to encode settings", err)
+	}
+
+	setting := &models.SystemSetting{
+		Key:         platformKey,
+		Value:       models.SettingValue(value),
+		Description: "Platform default Claude Code settings and permission policy",
+	}
+	_, err = s.db.NewInsert().Model(setting).
+		On("CONFLICT (key) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Set("updated_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update platform settings", err)
+	}
+	return convert.ClaudeSettingsToProto(&c), nil
+}
+
+func (s *Server) GetGroupClaudeSettings(ctx context.Context, req *sacv1.GroupClaudeSettingsRequest) (*sacv1.ClaudeSettings, error) {
+	if _, ok := s.groupRole(ctx, req.GroupId, ctxkeys.UserID(ctx)); !ok && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("You are not a member of this group")
+	}
+	var group models.Group
+	if err := s.db.NewSelect().Model(&group).Where("id = ?", req.GroupId).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Group not found", err)
+	}
+	return convert.ClaudeSettingsToProto(&group.ClaudeSettings), nil
+}
+
+func (s *Server) UpdateGroupClaudeSettings(ctx context.Context, req *sacv1.UpdateGroupClaudeSettingsRequest) (*sacv1.ClaudeSettings, error) {
+	if role, _ := s.groupRole(ctx, req.GroupId, ctxkeys.UserID(ctx)); role != "admin" && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Only group admins can change group settings")
+	}
+	c, err := validated(req.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.db.NewUpdate().Model((*models.Group)(nil)).
+		Set("claude_settings = ?", c).
+		Set("updated_at = now()").
+		Where("id = ?", req.GroupId).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update group settings", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Group not found")
+	}
+	return convert.ClaudeSettingsToProto(&c), nil
+}
+
+func (s *Server) GetAgentClaudeSettings(ctx context.Context, req *sacv1.AgentClaudeSettingsRequest) (*sacv1.ClaudeSettings, error) {
+	agent, err := s.ownedAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ClaudeSettingsToProto(&agent.ClaudeSettings), nil
+}
+
+func (s *Server) UpdateAgentClaudeSettings(ctx context.Context, req *sacv1.UpdateAgentClaudeSettingsRequest) (*sacv1.ClaudeSettings, error) {
+	agent, err := s.ownedAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+	c, err := validated(req.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.NewUpdate().Model((*models.Agent)(nil)).
+		Set("claude_settings = ?", c).
+		Set("updated_at = now()").
+		Where("id = ?", agent.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update agent settings", err)
+	}
+	return convert.ClaudeSettingsToProto(&c), nil
+}
+
+// PreviewAgentClaudeSettings shows each layer, the merged result and the
+// exact settings file a new session of the agent would get.
+func (s *Server) PreviewAgentClaudeSettings(ctx context.Context, req *sacv1.AgentClaudeSettingsRequest) (*sacv1.ClaudeSettingsPreview, error) {
+	agent, err := s.ownedAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := Layers(ctx, s.db, agent)
+	merged, notes := Merge(layers)
+	content, err := Render(&merged)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to render settings", err)
+	}
+
+	out := &sacv1.ClaudeSettingsPreview{
+		Merged:       convert.ClaudeSettingsToProto(&merged),
+		SettingsJson: content,
+		Notes:        notes,
+	}
+	for i := range layers {
+		out.Layers = append(out.Layers, &sacv1.ClaudeSettingsLayer{
+			Source:   layers[i].Source,
+			Id:       layers[i].ID,
+			Name:     layers[i].Name,
+			Settings: convert.ClaudeSettingsToProto(&layers[i].Settings),
+		})
+	}
+	return out, nil
+}
@@ -626,6 +626,27 @@ func (m *Manager) UpdateStatefulSetImage(ctx context.Context, userID string, age
 	return nil
 }
 
+// ScaleStatefulSet sets the replica count of an agent StatefulSet. Scaling to
+// zero stops the pod but keeps the StatefulSet and its service.
+func (m *Manager) ScaleStatefulSet(ctx context.Context, userID string, agentID int64, replicas int32) error {
+	name := m.statefulSetName(userID, agentID)
+
+	scale, err := m.clientset.AppsV1().StatefulSets(m.namespace).GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get statefulset %s scale: %w", name, err)
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	if _, err := m.clientset.AppsV1().StatefulSets(m.namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to scale statefulset %s: %w", name, err)
+	}
+
+	log.Info().Str("name", name).Int32("replicas", replicas).Msg("StatefulSet scaled")
+	return nil
+}
+
 // ListStatefulSets lists all claude-code StatefulSets in the namespace.
 func (m *Manager) ListStatefulSets(ctx context.Context) (*appsv1.StatefulSetList, error) {
 	return m.clientset.AppsV1().StatefulSets(m.namespace).List(ctx, metav1.ListOptions{
@@ -820,6 +841,15 @@ func formatMemory(bytes int64) string {
 
 // ExecInPod executes a command inside a pod using SPDY remotecommand.
 func (m *Manager) ExecInPod(ctx context.Context, podName string, command []string, stdin io.Reader) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	err := m.ExecInPodStream(ctx, podName, command, stdin, &stdout, &stderr)
+	return stdout.String(), stderr.String(), err
+}
+
+// ExecInPodStream executes a command in the claude-code container and streams
+// stdout/stderr to the given writers as output arrives, instead of buffering
+// it. Use it for long-running commands whose output is consumed incrementally.
+func (m *Manager) ExecInPodStream(ctx context.Context, podName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
 	req := m.clientset.CoreV1().RESTClient().Post().
 		Resource("pods").
 		Name(podName).
@@ -835,20 +865,18 @@ func (m *Manager) ExecInPod(ctx context.Context, podName string, command []strin
 
 	exec, err := remotecommand.NewSPDYExecutor(m.restConfig, "POST", req.URL())
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create executor: %w", err)
+		return fmt.Errorf("failed to create executor: %w", err)
 	}
 
-	var stdout, stderr bytes.Buffer
 	streamOpts := remotecommand.StreamOptions{
-		Stdout: &stdout,
-		Stderr: &stderr,
+		Stdout: stdout,
+		Stderr: stderr,
 	}
 	if stdin != nil {
 		streamOpts.Stdin = stdin
 	}
 
-	err = exec.StreamWithContext(ctx, streamOpts)
-	return stdout.String(), stderr.String(), err
+	return exec.StreamWithContext(ctx, streamOpts)
 }
 
 // WriteFileInPod writes content to a file inside a pod.
@@ -871,6 +899,28 @@ func (m *Manager) DeleteFileInPod(ctx context.Context, podName, filePath string)
 	return nil
 }
 
+// ExtractTarInPod streams a tar archive into a pod and unpacks it under
+// destDir, creating the directory if needed. The archive is read from r
+// as it is sent, so callers can produce it on the fly without buffering.
+func (m *Manager) ExtractTarInPod(ctx context.Context, podName, destDir string, r io.Reader) error {
+	cmd := []string{"sh", "-c", `mkdir -p "$1" && tar -xf - -C "$1" --no-same-owner`, "sh", destDir}
+	var stderr bytes.Buffer
+	if err := m.ExecInPodStream(ctx, podName, cmd, r, io.Discard, &stderr); err != nil {
+		return fmt.Errorf("failed to extract archive into %s in pod %s: %w (stderr: %s)", destDir, podName, err, stderr.String())
+	}
+	return nil
+}
+
+// RemovePathInPod recursively removes a file or directory from a pod.
+func (m *Manager) RemovePathInPod(ctx context.Context, podName, filePath string) error {
+	cmd := []string{"rm", "-rf", "--", filePath}
+	_, stderr, err := m.ExecInPod(ctx, podName, cmd, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove %s in pod %s: %w (stderr: %s)", filePath, podName, err, stderr)
+	}
+	return nil
+}
+
 // ListFilesInPod lists files in a directory inside a pod.
 func (m *Manager) ListFilesInPod(ctx context.Context, podName, dirPath string) ([]string, error) {
 	cmd := []string{"bash", "-c", fmt.Sprintf("ls -1 %s 2>/dev/null || true", dirPath)}
@@ -906,6 +956,46 @@ func (m *Manager) RestartClaudeCodeProcess(ctx context.Context, podName string)
 	return nil
 }
 
+// idleMarker tells the pod's claude loop not to restart Claude Code until the
+// marker is removed or the user presses Enter in the terminal.
+const idleMarker = "/tmp/claude-idle"
+
+// StopClaudeCodeProcess stops the interactive Claude Code process after the
+// session went idle. The claude loop then waits instead of restarting, and
+// resumes the last conversation once ResumeClaudeCodeProcess is called or
+// the user presses Enter. Images without idle support simply restart it.
+func (m *Manager) StopClaudeCodeProcess(ctx context.Context, podName string) error {
+	cmd := []string{"sh", "-c", "touch " + idleMarker + " && (pkill -x claude || true)"}
+	if _, stderr, err := m.ExecInPod(ctx, podName, cmd, nil); err != nil {
+		return fmt.Errorf("failed to stop Claude Code in pod %s: %w (stderr: %s)", podName, err, stderr)
+	}
+	log.Debug().Str("pod", podName).Msg("stopped Claude Code process")
+	return nil
+}
+
+// ResumeClaudeCodeProcess lets the claude loop start Claude Code again after
+// StopClaudeCodeProcess.
+func (m *Manager) ResumeClaudeCodeProcess(ctx context.Context, podName string) error {
+	cmd := []string{"rm", "-f", idleMarker}
+	if _, stderr, err := m.ExecInPod(ctx, podName, cmd, nil); err != nil {
+		return fmt.Errorf("failed to resume Claude Code in pod %s: %w (stderr: %s)", podName, err, stderr)
+	}
+	return nil
+}
+
+// terminalSocket is the dtach socket of the pod's interactive terminal.
+const terminalSocket = "/tmp/claude.sock"
+
+// SendTerminalInput types input into the pod's interactive terminal, as if
+// the user had entered it. Everyone attached to the terminal sees it.
+func (m *Manager) SendTerminalInput(ctx context.Context, podName, input string) error {
+	cmd := []string{"dtach", "-p", terminalSocket}
+	if _, stderr, err := m.ExecInPod(ctx, podName, cmd, strings.NewReader(input)); err != nil {
+		return fmt.Errorf("failed to send terminal input to pod %s: %w (stderr: %s)", podName, err, stderr)
+	}
+	return nil
+}
+
 // WaitForStatefulSetReady polls until the StatefulSet pod is Running.
 func (m *Manager) WaitForStatefulSetReady(ctx context.Context, userID string, agentID int64, maxRetries int, retryInterval time.Duration) error {
 	name := m.statefulSetName(userID, agentID)
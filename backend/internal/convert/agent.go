@@ -28,7 +28,7 @@ func AgentToProto(m *models.Agent) *sacv1.Agent {
 		}
 	}
 	for i := range m.InstalledSkills {
-		pb.InstalledSkills = append(pb.InstalledSkills, AgentSkillToProto(&m.InstalledSkills[i]))
+		pb.InstalledSkills = append(pb.InstalledSkills, AgentSkillToProto(&m.InstalledSkills[i], m.CreatedBy))
 	}
 	return pb
 }
@@ -41,7 +41,9 @@ func AgentsToProto(ms []models.Agent) []*sacv1.Agent {
 	return out
 }
 
-func AgentSkillToProto(m *models.AgentSkill) *sacv1.AgentSkill {
+// AgentSkillToProto converts an installed skill of an agent owned by ownerID.
+// Updates of other users' skills become available once they are published.
+func AgentSkillToProto(m *models.AgentSkill, ownerID int64) *sacv1.AgentSkill {
 	pb := &sacv1.AgentSkill{
 		Id:            m.ID,
 		AgentId:       m.AgentID,
@@ -49,9 +51,29 @@ func AgentSkillToProto(m *models.AgentSkill) *sacv1.AgentSkill {
 		Order:         int32(m.Order),
 		SyncedVersion: int32(m.SyncedVersion),
 		CreatedAt:     timestamppb.New(m.CreatedAt),
+		SyncError:     m.SyncError,
+		SyncAttempts:  int32(m.SyncAttempts),
+	}
+	if m.PinnedVersion != nil {
+		v := int32(*m.PinnedVersion)
+		pb.PinnedVersion = &v
+	}
+	if m.LastSyncAttemptAt != nil {
+		pb.LastSyncAttemptAt = timestamppb.New(*m.LastSyncAttemptAt)
+	}
+	if m.LastSyncedAt != nil {
+		pb.LastSyncedAt = timestamppb.New(*m.LastSyncedAt)
+	}
+	if m.NextSyncRetryAt != nil {
+		pb.NextSyncRetryAt = timestamppb.New(*m.NextSyncRetryAt)
 	}
 	if m.Skill != nil {
 		pb.Skill = SkillToProto(m.Skill)
+		latest := m.Skill.Version
+		if m.Skill.CreatedBy != ownerID && m.Skill.PublishedVersion != nil {
+			latest = *m.Skill.PublishedVersion
+		}
+		pb.UpdateAvailable = m.PinnedVersion != nil && latest > *m.PinnedVersion
 	}
 	return pb
 }
@@ -0,0 +1,51 @@
+package convert
+
+import (
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/models"
+)
+
+func ClaudeSettingsToProto(m *models.ClaudeSettings) *sacv1.ClaudeSettings {
+	pb := &sacv1.ClaudeSettings{
+		Allow:                    m.Allow,
+		Deny:                     m.Deny,
+		Ask:                      m.Ask,
+		DefaultMode:              m.DefaultMode,
+		DisableBypassPermissions: m.DisableBypassPermissions,
+		Model:                    m.Model,
+		Env:                      m.Env,
+	}
+	for _, h := range m.Hooks {
+		pb.Hooks = append(pb.Hooks, &sacv1.ClaudeHook{
+			Event:   h.Event,
+			Matcher: h.Matcher,
+			Command: h.Command,
+			Timeout: int32(h.Timeout),
+		})
+	}
+	return pb
+}
+
+func ClaudeSettingsFromProto(pb *sacv1.ClaudeSettings) models.ClaudeSettings {
+	if pb == nil {
+		return models.ClaudeSettings{}
+	}
+	m := models.ClaudeSettings{
+		Allow:                    pb.Allow,
+		Deny:                     pb.Deny,
+		Ask:                      pb.Ask,
+		DefaultMode:              pb.DefaultMode,
+		DisableBypassPermissions: pb.DisableBypassPermissions,
+		Model:                    pb.Model,
+		Env:                      pb.Env,
+	}
+	for _, h := range pb.Hooks {
+		m.Hooks = append(m.Hooks, models.ClaudeHook{
+			Event:   h.Event,
+			Matcher: h.Matcher,
+			Command: h.Command,
+			Timeout: int(h.Timeout),
+		})
+	}
+	return m
+}
@@ -16,6 +16,10 @@ func GroupToProto(m *models.Group) *sacv1.Group {
 		CreatedAt:        timestamppb.New(m.CreatedAt),
 		UpdatedAt:        timestamppb.New(m.UpdatedAt),
 	}
+	if m.IdleTimeoutMinutes != nil {
+		v := int32(*m.IdleTimeoutMinutes)
+		pb.SessionIdleTimeoutMinutes = &v
+	}
 	if m.Owner != nil {
 		pb.Owner = UserBriefToProto(m.Owner)
 	}
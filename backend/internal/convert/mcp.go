@@ -0,0 +1,44 @@
+package convert
+
+import (
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MCPServerToProto converts a catalogue entry. SecretRefs is filled in by
+// the caller.
+func MCPServerToProto(m *models.MCPServer) *sacv1.MCPServer {
+	return &sacv1.MCPServer{
+		Id:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Transport:   m.Transport,
+		Command:     m.Command,
+		Args:        m.Args,
+		Url:         m.URL,
+		Env:         m.Env,
+		Headers:     m.Headers,
+		IsOfficial:  m.IsOfficial,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// UserSecretToProto converts a secret without its value.
+func UserSecretToProto(m *models.UserSecret) *sacv1.UserSecret {
+	return &sacv1.UserSecret{
+		Name:      m.Name,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func UserSecretsToProto(ms []models.UserSecret) []*sacv1.UserSecret {
+	out := make([]*sacv1.UserSecret, len(ms))
+	for i := range ms {
+		out[i] = UserSecretToProto(&ms[i])
+	}
+	return out
+}
@@ -0,0 +1,35 @@
+package convert
+
+import (
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotificationChannelToProto converts a channel. The secret is never returned.
+func NotificationChannelToProto(m *models.NotificationChannel) *sacv1.NotificationChannel {
+	pb := &sacv1.NotificationChannel{
+		Id:        m.ID,
+		Name:      m.Name,
+		Type:      m.Type,
+		Target:    m.Target,
+		HasSecret: m.Secret != "",
+		Events:    m.Events,
+		Enabled:   m.Enabled,
+		LastError: m.LastError,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+	if m.LastSentAt != nil {
+		pb.LastSentAt = timestamppb.New(*m.LastSentAt)
+	}
+	return pb
+}
+
+func NotificationChannelsToProto(ms []models.NotificationChannel) []*sacv1.NotificationChannel {
+	out := make([]*sacv1.NotificationChannel, len(ms))
+	for i := range ms {
+		out[i] = NotificationChannelToProto(&ms[i])
+	}
+	return out
+}
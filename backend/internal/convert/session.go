@@ -28,3 +28,94 @@ func SessionsToProto(ms []models.Session) []*sacv1.Session {
 	}
 	return out
 }
+
+func SessionShareToProto(m *models.SessionShare) *sacv1.SessionShare {
+	pb := &sacv1.SessionShare{
+		Id:        m.ID,
+		SessionId: m.SessionID,
+		OwnerId:   m.OwnerID,
+		Mode:      string(m.Mode),
+		UserIds:   m.UserIDs,
+		GroupId:   m.GroupID,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+	if m.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	return pb
+}
+
+func SessionSharesToProto(ms []models.SessionShare) []*sacv1.SessionShare {
+	out := make([]*sacv1.SessionShare, len(ms))
+	for i := range ms {
+		out[i] = SessionShareToProto(&ms[i])
+	}
+	return out
+}
+
+func AgentTaskToProto(m *models.AgentTask) *sacv1.AgentTask {
+	pb := &sacv1.AgentTask{
+		TaskId:         m.TaskID,
+		AgentId:        m.AgentID,
+		JobId:          m.JobID,
+		Prompt:         m.Prompt,
+		AllowedTools:   m.AllowedTools,
+		TimeoutSeconds: int32(m.TimeoutSeconds),
+		Status:         string(m.Status),
+		Result:         m.Result,
+		Error:          m.Error,
+		Stdout:         m.Stdout,
+		OutputFiles:    m.OutputFiles,
+		CostUsd:        m.CostUSD,
+		NumTurns:       int32(m.NumTurns),
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+	}
+	if m.StartedAt != nil {
+		pb.StartedAt = timestamppb.New(*m.StartedAt)
+	}
+	if m.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*m.FinishedAt)
+	}
+	return pb
+}
+
+func AgentTasksToProto(ms []models.AgentTask) []*sacv1.AgentTask {
+	out := make([]*sacv1.AgentTask, len(ms))
+	for i := range ms {
+		out[i] = AgentTaskToProto(&ms[i])
+	}
+	return out
+}
+
+func AgentJobToProto(m *models.AgentJob) *sacv1.AgentJob {
+	pb := &sacv1.AgentJob{
+		Id:             m.ID,
+		AgentId:        m.AgentID,
+		Name:           m.Name,
+		Schedule:       m.Schedule,
+		Timezone:       m.Timezone,
+		Prompt:         m.Prompt,
+		SkillId:        m.SkillID,
+		SkillArgs:      m.SkillArgs,
+		AllowedTools:   m.AllowedTools,
+		TimeoutSeconds: int32(m.TimeoutSeconds),
+		Enabled:        m.Enabled,
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+		UpdatedAt:      timestamppb.New(m.UpdatedAt),
+	}
+	if m.NextRunAt != nil {
+		pb.NextRunAt = timestamppb.New(*m.NextRunAt)
+	}
+	if m.LastRunAt != nil {
+		pb.LastRunAt = timestamppb.New(*m.LastRunAt)
+	}
+	return pb
+}
+
+func AgentJobsToProto(ms []models.AgentJob) []*sacv1.AgentJob {
+	out := make([]*sacv1.AgentJob, len(ms))
+	for i := range ms {
+		out[i] = AgentJobToProto(&ms[i])
+	}
+	return out
+}
@@ -8,22 +8,43 @@ import (
 
 func SkillToProto(m *models.Skill) *sacv1.Skill {
 	pb := &sacv1.Skill{
-		Id:          m.ID,
-		Name:        m.Name,
-		Description: m.Description,
-		Icon:        m.Icon,
-		Category:    m.Category,
-		Prompt:      m.Prompt,
-		CommandName: m.CommandName,
-		IsOfficial:  m.IsOfficial,
-		CreatedBy:   m.CreatedBy,
-		IsPublic:    m.IsPublic,
-		ForkedFrom:  m.ForkedFrom,
-		GroupId:     m.GroupID,
-		Version:     int32(m.Version),
-		CreatedAt:   timestamppb.New(m.CreatedAt),
-		UpdatedAt:   timestamppb.New(m.UpdatedAt),
-		Frontmatter: FrontmatterToProto(&m.Frontmatter),
+		Id:           m.ID,
+		Name:         m.Name,
+		Description:  m.Description,
+		Icon:         m.Icon,
+		Category:     m.Category,
+		Tags:         m.Tags,
+		Prompt:       m.Prompt,
+		CommandName:  m.CommandName,
+		IsOfficial:   m.IsOfficial,
+		CreatedBy:    m.CreatedBy,
+		IsPublic:     m.IsPublic,
+		ForkedFrom:   m.ForkedFrom,
+		GroupId:      m.GroupID,
+		Version:      int32(m.Version),
+		CreatedAt:    timestamppb.New(m.CreatedAt),
+		UpdatedAt:    timestamppb.New(m.UpdatedAt),
+		Frontmatter:  FrontmatterToProto(&m.Frontmatter),
+		SourceId:     m.SourceID,
+		SourcePath:   m.SourcePath,
+		SourceCommit: m.SourceCommit,
+		BundleError:  m.BundleError,
+
+		InstallCount:    int32(m.InstallCount),
+		ForkCount:       int32(m.ForkCount),
+		InvocationCount: int32(m.InvocationCount),
+	}
+	if m.PublishedVersion != nil {
+		v := int32(*m.PublishedVersion)
+		pb.PublishedVersion = &v
+	}
+	if m.UpstreamVersion != nil {
+		v := int32(*m.UpstreamVersion)
+		pb.UpstreamVersion = &v
+	}
+	if m.UpstreamLatestVersion != nil {
+		v := int32(*m.UpstreamLatestVersion)
+		pb.UpstreamLatestVersion = &v
 	}
 	for _, p := range m.Parameters {
 		pb.Parameters = append(pb.Parameters, &sacv1.SkillParameter{
@@ -119,3 +140,104 @@ func SkillFilesToProto(fs []models.SkillFile) []*sacv1.SkillFile {
 	}
 	return out
 }
+
+// SkillVersionToProto converts a skill revision; skillMD is passed in since
+// seeded revisions render it from prompt and frontmatter.
+func SkillVersionToProto(m *models.SkillVersion, skillMD string) *sacv1.SkillVersion {
+	pb := &sacv1.SkillVersion{
+		Id:              m.ID,
+		SkillId:         m.SkillID,
+		Version:         int32(m.Version),
+		SkillMd:         skillMD,
+		Frontmatter:     FrontmatterToProto(&m.Frontmatter),
+		ContentChecksum: m.ContentChecksum,
+		Note:            m.Note,
+		CreatedBy:       m.CreatedBy,
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+		Restorable:      m.BundleKey != "" || len(m.Files) == 0,
+	}
+	for _, f := range m.Files {
+		pb.Files = append(pb.Files, &sacv1.SkillVersionFile{
+			Filepath:    f.Filepath,
+			Checksum:    f.Checksum,
+			Size:        f.Size,
+			ContentType: f.ContentType,
+		})
+	}
+	return pb
+}
+
+// SkillSourceToProto converts a skill source. The credential and webhook
+// secret are never included; callers set WebhookSecret on creation.
+func SkillSourceToProto(m *models.SkillSource) *sacv1.SkillSource {
+	pb := &sacv1.SkillSource{
+		Id:                  m.ID,
+		Name:                m.Name,
+		RepoUrl:             m.RepoURL,
+		Branch:              m.Branch,
+		Subdir:              m.Subdir,
+		AuthType:            m.AuthType,
+		HasCredential:       m.Credential != "",
+		WebhookPath:         "/api/skill-sources/hooks/" + m.WebhookToken,
+		SyncIntervalMinutes: int32(m.SyncIntervalMinutes),
+		IsPublic:            m.IsPublic,
+		GroupId:             m.GroupID,
+		LastCommit:          m.LastCommit,
+		LastError:           m.LastError,
+		CreatedBy:           m.CreatedBy,
+		CreatedAt:           timestamppb.New(m.CreatedAt),
+		UpdatedAt:           timestamppb.New(m.UpdatedAt),
+	}
+	if m.LastSyncedAt != nil {
+		pb.LastSyncedAt = timestamppb.New(*m.LastSyncedAt)
+	}
+	return pb
+}
+
+func SkillReviewToProto(m *models.SkillReview) *sacv1.SkillReview {
+	pb := &sacv1.SkillReview{
+		Id:            m.ID,
+		SkillId:       m.SkillID,
+		Version:       int32(m.Version),
+		Target:        m.Target,
+		GroupId:       m.GroupID,
+		Status:        m.Status,
+		Comment:       m.Comment,
+		SubmittedBy:   m.SubmittedBy,
+		Submitter:     UserBriefToProto(m.Submitter),
+		ReviewedBy:    m.ReviewedBy,
+		Reviewer:      UserBriefToProto(m.Reviewer),
+		ReviewComment: m.ReviewComment,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+	if m.Skill != nil {
+		pb.SkillName = m.Skill.Name
+		pb.CommandName = m.Skill.CommandName
+	}
+	if m.ReviewedAt != nil {
+		pb.ReviewedAt = timestamppb.New(*m.ReviewedAt)
+	}
+	return pb
+}
+
+func SkillReviewsToProto(ms []models.SkillReview) []*sacv1.SkillReview {
+	out := make([]*sacv1.SkillReview, len(ms))
+	for i := range ms {
+		out[i] = SkillReviewToProto(&ms[i])
+	}
+	return out
+}
+
+func SkillAuditEventToProto(m *models.SkillAuditEvent) *sacv1.SkillAuditEvent {
+	return &sacv1.SkillAuditEvent{
+		Id:        m.ID,
+		SkillId:   m.SkillID,
+		ReviewId:  m.ReviewID,
+		ActorId:   m.ActorID,
+		Actor:     UserBriefToProto(m.Actor),
+		Action:    m.Action,
+		Version:   int32(m.Version),
+		Comment:   m.Comment,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
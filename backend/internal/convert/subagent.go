@@ -0,0 +1,47 @@
+package convert
+
+import (
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func SubagentToProto(m *models.Subagent) *sacv1.Subagent {
+	pb := &sacv1.Subagent{
+		Id:           m.ID,
+		Name:         m.Name,
+		Description:  m.Description,
+		Tools:        m.Tools,
+		Model:        m.Model,
+		SystemPrompt: m.SystemPrompt,
+		IsOfficial:   m.IsOfficial,
+		IsPublic:     m.IsPublic,
+		GroupId:      m.GroupID,
+		CreatedBy:    m.CreatedBy,
+		CreatedAt:    timestamppb.New(m.CreatedAt),
+		UpdatedAt:    timestamppb.New(m.UpdatedAt),
+	}
+	if m.Creator != nil {
+		pb.Creator = UserBriefToProto(m.Creator)
+	}
+	return pb
+}
+
+func SubagentsToProto(ms []models.Subagent) []*sacv1.Subagent {
+	out := make([]*sacv1.Subagent, len(ms))
+	for i := range ms {
+		out[i] = SubagentToProto(&ms[i])
+	}
+	return out
+}
+
+func AgentSubagentToProto(m *models.AgentSubagent) *sacv1.AgentSubagent {
+	pb := &sacv1.AgentSubagent{
+		AgentId:   m.AgentID,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+	if m.Subagent != nil {
+		pb.Subagent = SubagentToProto(m.Subagent)
+	}
+	return pb
+}
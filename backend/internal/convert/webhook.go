@@ -0,0 +1,57 @@
+package convert
+
+import (
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookToProto converts a webhook. The secret is never included; callers
+// that just created or rotated it set it explicitly.
+func WebhookToProto(m *models.AgentWebhook) *sacv1.Webhook {
+	return &sacv1.Webhook{
+		Id:               m.ID,
+		AgentId:          m.AgentID,
+		Name:             m.Name,
+		UrlPath:          "/api/hooks/" + m.Token,
+		SignatureFormat:  m.SignatureFormat,
+		PromptTemplate:   m.PromptTemplate,
+		AllowedTools:     m.AllowedTools,
+		TimeoutSeconds:   int32(m.TimeoutSeconds),
+		RateLimitPerHour: int32(m.RateLimitPerHour),
+		Enabled:          m.Enabled,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+		UpdatedAt:        timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func WebhooksToProto(ms []models.AgentWebhook) []*sacv1.Webhook {
+	out := make([]*sacv1.Webhook, len(ms))
+	for i := range ms {
+		out[i] = WebhookToProto(&ms[i])
+	}
+	return out
+}
+
+func WebhookDeliveryToProto(m *models.WebhookDelivery) *sacv1.WebhookDelivery {
+	return &sacv1.WebhookDelivery{
+		Id:             m.ID,
+		WebhookId:      m.WebhookID,
+		Status:         m.Status,
+		StatusCode:     int32(m.StatusCode),
+		Event:          m.Event,
+		Error:          m.Error,
+		TaskId:         m.TaskID,
+		PayloadExcerpt: m.PayloadExcerpt,
+		RemoteAddr:     m.RemoteAddr,
+		ReceivedAt:     timestamppb.New(m.ReceivedAt),
+	}
+}
+
+func WebhookDeliveriesToProto(ms []models.WebhookDelivery) []*sacv1.WebhookDelivery {
+	out := make([]*sacv1.WebhookDelivery, len(ms))
+	for i := range ms {
+		out[i] = WebhookDeliveryToProto(&ms[i])
+	}
+	return out
+}
@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"time"
+
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -25,3 +27,50 @@ func WorkspaceFileToProto(m *models.WorkspaceFile) *sacv1.WorkspaceFile {
 	}
 	return pb
 }
+
+func WorkspaceQuotaToProto(m *models.WorkspaceQuota) *sacv1.WorkspaceQuota {
+	return &sacv1.WorkspaceQuota{
+		UserId:       m.UserID,
+		AgentId:      m.AgentID,
+		UsedBytes:    m.UsedBytes,
+		MaxBytes:     m.MaxBytes,
+		FileCount:    int32(m.FileCount),
+		MaxFileCount: int32(m.MaxFileCount),
+		UpdatedAt:    timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func GroupWorkspaceQuotaToProto(m *models.GroupWorkspaceQuota) *sacv1.GroupWorkspaceQuota {
+	return &sacv1.GroupWorkspaceQuota{
+		GroupId:      m.GroupID,
+		UsedBytes:    m.UsedBytes,
+		MaxBytes:     m.MaxBytes,
+		FileCount:    int32(m.FileCount),
+		MaxFileCount: int32(m.MaxFileCount),
+		UpdatedAt:    timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func SharedLinkToProto(m *models.SharedLink, now time.Time) *sacv1.SharedLink {
+	pb := &sacv1.SharedLink{
+		ShortCode:     m.ShortCode,
+		Url:           "/s/" + m.ShortCode,
+		AgentId:       m.AgentID,
+		FilePath:      m.FilePath,
+		FileName:      m.FileName,
+		HasPassword:   m.PasswordHash != "",
+		MaxDownloads:  int32(m.MaxDownloads),
+		DownloadCount: int32(m.DownloadCount),
+		Access:        m.Access,
+		GroupIds:      m.GroupIDs,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+		Expired:       m.Expired(now),
+	}
+	if m.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.LastAccessedAt != nil {
+		pb.LastAccessedAt = timestamppb.New(*m.LastAccessedAt)
+	}
+	return pb
+}
@@ -238,6 +238,13 @@ func (s *Server) UpdateGroup(ctx context.Context, req *sacv1.UpdateGroupByIdRequ
 	if req.ClaudeMdTemplate != nil {
 		q = q.Set("claude_md_template = ?", *req.ClaudeMdTemplate)
 	}
+	if req.SessionIdleTimeoutMinutes != nil {
+		if *req.SessionIdleTimeoutMinutes < 0 {
+			q = q.Set("session_idle_timeout_minutes = NULL")
+		} else {
+			q = q.Set("session_idle_timeout_minutes = ?", *req.SessionIdleTimeoutMinutes)
+		}
+	}
 	q = q.Set("updated_at = ?", time.Now())
 
 	_, err := q.Exec(ctx)
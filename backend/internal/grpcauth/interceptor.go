@@ -52,33 +52,25 @@ var adminMethods = map[string]bool{
 
 // AuthUnaryInterceptor returns a gRPC unary interceptor that validates JWT tokens
 // from the "authorization" metadata header and injects user info into the context.
+// Public methods run without a token, but still see the caller when a valid one
+// is sent, so they can serve logged-in users differently.
 func AuthUnaryInterceptor(jwtService *auth.JWTService) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		// Public methods skip auth
+		tokenString, err := bearerToken(ctx)
+
+		// Public methods skip auth; a missing or invalid token means anonymous
 		if publicMethods[info.FullMethod] {
+			if err == nil {
+				if claims, err := jwtService.ValidateToken(tokenString); err == nil {
+					ctx = withClaims(ctx, claims)
+				}
+			}
 			return handler(ctx, req)
 		}
 
-		// Extract authorization from gRPC metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			// Also check grpcgateway-authorization (grpc-gateway forwards it)
-			authHeaders = md.Get("grpcgateway-authorization")
-		}
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization header required")
-		}
-
-		tokenString := strings.TrimPrefix(authHeaders[0], "Bearer ")
-		if tokenString == authHeaders[0] {
-			return nil, status.Error(codes.Unauthenticated, "Bearer token required")
+		if err != nil {
+			return nil, err
 		}
-
 		claims, err := jwtService.ValidateToken(tokenString)
 		if err != nil {
 			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
@@ -89,11 +81,36 @@ func AuthUnaryInterceptor(jwtService *auth.JWTService) grpc.UnaryServerIntercept
 			return nil, status.Error(codes.PermissionDenied, "admin access required")
 		}
 
-		// Inject user info into context
-		ctx = context.WithValue(ctx, ctxkeys.UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, ctxkeys.UsernameKey, claims.Username)
-		ctx = context.WithValue(ctx, ctxkeys.RoleKey, claims.Role)
+		return handler(withClaims(ctx, claims), req)
+	}
+}
+
+// bearerToken extracts the bearer token from the "authorization" metadata.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		// Also check grpcgateway-authorization (grpc-gateway forwards it)
+		authHeaders = md.Get("grpcgateway-authorization")
+	}
+	if len(authHeaders) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization header required")
+	}
 
-		return handler(ctx, req)
+	tokenString := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	if tokenString == authHeaders[0] || tokenString == "" {
+		return "", status.Error(codes.Unauthenticated, "Bearer token required")
 	}
+	return tokenString, nil
+}
+
+// withClaims injects user info into the context.
+func withClaims(ctx context.Context, claims *auth.Claims) context.Context {
+	ctx = context.WithValue(ctx, ctxkeys.UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, ctxkeys.UsernameKey, claims.Username)
+	return context.WithValue(ctx, ctxkeys.RoleKey, claims.Role)
 }
@@ -43,3 +43,7 @@ func Internal(msg string, err error) error {
 func Unavailable(msg string) error {
 	return status.Error(codes.Unavailable, msg)
 }
+
+func TooManyRequests(msg string) error {
+	return status.Error(codes.ResourceExhausted, msg)
+}
@@ -8,6 +8,7 @@ import (
 
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/pkg/protobind"
 	"g.echo.tech/dev/sac/pkg/response"
 	"github.com/gin-gonic/gin"
@@ -15,13 +16,19 @@ import (
 )
 
 type Handler struct {
-	db *bun.DB
+	db       *bun.DB
+	notifier *notify.Notifier
 }
 
 func NewHandler(db *bun.DB) *Handler {
 	return &Handler{db: db}
 }
 
+// SetNotifier enables conversation.stop notifications.
+func (h *Handler) SetNotifier(notifier *notify.Notifier) {
+	h.notifier = notifier
+}
+
 // RegisterInternalRoutes registers internal routes (no JWT, Pod-internal calls).
 func (h *Handler) RegisterInternalRoutes(rg *gin.RouterGroup) {
 	rg.POST("/conversations/events", h.receiveEvents)
@@ -108,6 +115,8 @@ func (h *Handler) receiveEvents(c *gin.Context) {
 		response.InternalError(c, "Failed to insert conversation history", err)
 		return
 	}
+	countSkillInvocations(c.Request.Context(), h.db, records)
+	notifyStop(h.notifier, req.HookEvent, userID, agentID, req.SessionId, records)
 
 	protobind.OK(c, &sacv1.EventsResponse{Inserted: int32(len(records))})
 }
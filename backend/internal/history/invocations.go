@@ -0,0 +1,39 @@
+package history
+
+import (
+	"context"
+	"regexp"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// commandNamePattern finds the slash command Claude Code records in the
+// user message of a command invocation. Keep in sync with the backfill in
+// migration 000039.
+var commandNamePattern = regexp.MustCompile(`<command-name>/?([A-Za-z0-9_:-]+)</command-name>`)
+
+// countSkillInvocations adds the slash commands of new user messages to
+// the invocation counts of the matching skills. Built-in commands match no
+// skill and are ignored.
+func countSkillInvocations(ctx context.Context, db bun.IDB, records []models.ConversationHistory) {
+	counts := make(map[string]int)
+	for _, r := range records {
+		if r.Role != "user" {
+			continue
+		}
+		if m := commandNamePattern.FindStringSubmatch(r.Content); m != nil {
+			counts[m[1]]++
+		}
+	}
+	for cmd, n := range counts {
+		_, err := db.NewUpdate().Model((*models.Skill)(nil)).
+			Set("invocation_count = invocation_count + ?", n).
+			Where("command_name = ?", cmd).
+			Exec(ctx)
+		if err != nil {
+			log.Warn().Err(err).Str("command", cmd).Msg("failed to count skill invocation")
+		}
+	}
+}
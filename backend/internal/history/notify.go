@@ -0,0 +1,40 @@
+package history
+
+import (
+	"unicode/utf8"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
+)
+
+const stopExcerptLen = 500
+
+// notifyStop sends EventConversationStop when the main agent finished a turn.
+// SubagentStop is ignored; hooks from older images send no hook_event and
+// are treated as Stop.
+func notifyStop(n *notify.Notifier, hookEvent string, userID, agentID int64, sessionID string, records []models.ConversationHistory) {
+	if hookEvent != "" && hookEvent != "Stop" {
+		return
+	}
+
+	// Excerpt of the last assistant reply
+	var reply string
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].Role == "assistant" {
+			reply = records[i].Content
+			break
+		}
+	}
+	if utf8.RuneCountInString(reply) > stopExcerptLen {
+		reply = string([]rune(reply)[:stopExcerptLen]) + "…"
+	}
+
+	n.Notify(notify.Event{
+		Type:    notify.EventConversationStop,
+		UserID:  userID,
+		AgentID: agentID,
+		Title:   "Agent finished responding",
+		Message: reply,
+		Data:    map[string]any{"session_id": sessionID},
+	})
+}
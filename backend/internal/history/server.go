@@ -10,19 +10,26 @@ import (
 	"g.echo.tech/dev/sac/internal/ctxkeys"
 	"g.echo.tech/dev/sac/internal/grpcerr"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"github.com/uptrace/bun"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
 	sacv1.UnimplementedHistoryServiceServer
-	db *bun.DB
+	db       *bun.DB
+	notifier *notify.Notifier
 }
 
 func NewServer(db *bun.DB) *Server {
 	return &Server{db: db}
 }
 
+// SetNotifier enables conversation.stop notifications.
+func (s *Server) SetNotifier(notifier *notify.Notifier) {
+	s.notifier = notifier
+}
+
 func (s *Server) ReceiveEvents(ctx context.Context, req *sacv1.EventsRequest) (*sacv1.EventsResponse, error) {
 	if req.UserId == "" || req.AgentId == "" || req.SessionId == "" {
 		return nil, grpcerr.BadRequest("user_id, agent_id, and session_id are required")
@@ -85,6 +92,7 @@ func (s *Server) ReceiveEvents(ctx context.Context, req *sacv1.EventsRequest) (*
 	if err != nil {
 		return nil, grpcerr.Internal("Failed to insert conversation history", err)
 	}
+	notifyStop(s.notifier, req.HookEvent, userID, agentID, req.SessionId, records)
 
 	return &sacv1.EventsResponse{Inserted: int32(len(records))}, nil
 }
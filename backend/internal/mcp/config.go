@@ -0,0 +1,165 @@
+package mcp
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/models"
+)
+
+var (
+	namePattern       = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	secretNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+	envKeyPattern     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	secretRefPattern  = regexp.MustCompile(`\$\{secret:([^}]*)\}`)
+)
+
+// Validate normalizes an MCP server definition and rejects invalid ones.
+func Validate(srv *models.MCPServer) error {
+	srv.Name = strings.TrimSpace(srv.Name)
+	srv.Command = strings.TrimSpace(srv.Command)
+	srv.URL = strings.TrimSpace(srv.URL)
+	if !namePattern.MatchString(srv.Name) {
+		return errors.New("name must be 1-64 letters, digits, '-' or '_'")
+	}
+
+	switch srv.Transport {
+	case models.MCPTransportStdio:
+		if srv.Command == "" {
+			return errors.New("command is required for stdio servers")
+		}
+		if len(srv.Headers) > 0 {
+			return errors.New("headers are only supported for http and sse servers")
+		}
+		srv.URL = ""
+	case models.MCPTransportHTTP, models.MCPTransportSSE:
+		u, err := url.Parse(srv.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("url must be an http(s) URL")
+		}
+		if len(srv.Env) > 0 {
+			return errors.New("env is only supported for stdio servers")
+		}
+		srv.Command = ""
+		srv.Args = nil
+	default:
+		return errors.New("transport must be one of stdio, http, sse")
+	}
+
+	for k := range srv.Env {
+		if !envKeyPattern.MatchString(k) {
+			return fmt.Errorf("invalid env variable name %q", k)
+		}
+	}
+	for k := range srv.Headers {
+		if k == "" || strings.ContainsAny(k, " :\r\n") {
+			return fmt.Errorf("invalid header name %q", k)
+		}
+	}
+	for _, name := range SecretRefs(srv) {
+		if !secretNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid secret reference ${secret:%s}", name)
+		}
+	}
+	if srv.Args == nil {
+		srv.Args = []string{}
+	}
+	if srv.Env == nil {
+		srv.Env = map[string]string{}
+	}
+	if srv.Headers == nil {
+		srv.Headers = map[string]string{}
+	}
+	return nil
+}
+
+// ValidSecretName reports whether name can be referenced as ${secret:name}.
+func ValidSecretName(name string) bool {
+	return secretNamePattern.MatchString(name)
+}
+
+// SecretRefs returns the sorted, distinct secret names referenced by the
+// env and header values of srv.
+func SecretRefs(srv *models.MCPServer) []string {
+	seen := map[string]bool{}
+	collect := func(values map[string]string) {
+		for _, v := range values {
+			for _, m := range secretRefPattern.FindAllStringSubmatch(v, -1) {
+				seen[m[1]] = true
+			}
+		}
+	}
+	collect(srv.Env)
+	collect(srv.Headers)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MissingSecrets returns the secrets referenced by srv that are not set.
+func MissingSecrets(srv *models.MCPServer, secrets map[string]string) []string {
+	var missing []string
+	for _, name := range SecretRefs(srv) {
+		if _, ok := secrets[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+func resolveValues(values map[string]string, secrets map[string]string) map[string]string {
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		out[k] = secretRefPattern.ReplaceAllStringFunc(v, func(ref string) string {
+			return secrets[secretRefPattern.FindStringSubmatch(ref)[1]]
+		})
+	}
+	return out
+}
+
+// RenderConfig renders servers as Claude Code mcpServers entries with
+// secret references resolved. Servers that reference unset secrets or
+// repeat an earlier name are left out and returned with the reason.
+func RenderConfig(servers []models.MCPServer, secrets map[string]string) (map[string]any, map[string]string) {
+	config := make(map[string]any, len(servers))
+	skipped := map[string]string{}
+	seen := make(map[string]bool, len(servers))
+	for i := range servers {
+		srv := &servers[i]
+		if seen[srv.Name] {
+			skipped[srv.Name] = "duplicate name"
+			continue
+		}
+		seen[srv.Name] = true
+		if missing := MissingSecrets(srv, secrets); len(missing) > 0 {
+			skipped[srv.Name] = "missing secrets: " + strings.Join(missing, ", ")
+			continue
+		}
+
+		entry := map[string]any{"type": srv.Transport}
+		if srv.Transport == models.MCPTransportStdio {
+			args := srv.Args
+			if args == nil {
+				args = []string{}
+			}
+			entry["command"] = srv.Command
+			entry["args"] = args
+			entry["env"] = resolveValues(srv.Env, secrets)
+		} else {
+			entry["url"] = srv.URL
+			if len(srv.Headers) > 0 {
+				entry["headers"] = resolveValues(srv.Headers, secrets)
+			}
+		}
+		config[srv.Name] = entry
+	}
+	return config, skipped
+}
@@ -0,0 +1,431 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxSecretsPerUser = 100
+	maxSecretBytes    = 16 << 10
+)
+
+// Server implements MCPServiceServer: the MCP server catalogue, per-agent
+// installations and the secrets they reference.
+type Server struct {
+	sacv1.UnimplementedMCPServiceServer
+	db     *bun.DB
+	syncer *Syncer
+}
+
+func NewServer(db *bun.DB, syncer *Syncer) *Server {
+	return &Server{db: db, syncer: syncer}
+}
+
+func mcpServerToProto(m *models.MCPServer) *sacv1.MCPServer {
+	pb := convert.MCPServerToProto(m)
+	pb.SecretRefs = SecretRefs(m)
+	return pb
+}
+
+// getVisible loads a catalogue entry the caller can see: official ones and
+// their own.
+func (s *Server) getVisible(ctx context.Context, id, userID int64) (*models.MCPServer, error) {
+	var srv models.MCPServer
+	err := s.db.NewSelect().Model(&srv).
+		Where("id = ?", id).
+		Where("(is_official OR created_by = ?)", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("MCP server not found", err)
+	}
+	return &srv, nil
+}
+
+// getEditable loads a catalogue entry the caller may change: their own, or
+// an official one for admins.
+func (s *Server) getEditable(ctx context.Context, id int64) (*models.MCPServer, error) {
+	userID := ctxkeys.UserID(ctx)
+	srv, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if srv.IsOfficial && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Only admins can edit official MCP servers")
+	}
+	if !srv.IsOfficial && srv.CreatedBy != userID {
+		return nil, grpcerr.Forbidden("You don't have permission to edit this MCP server")
+	}
+	return srv, nil
+}
+
+func (s *Server) ListMCPServers(ctx context.Context, _ *sacv1.Empty) (*sacv1.MCPServerListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	var servers []models.MCPServer
+	err := s.db.NewSelect().Model(&servers).
+		Where("is_official OR created_by = ?", userID).
+		OrderExpr("is_official DESC, name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list MCP servers", err)
+	}
+
+	out := make([]*sacv1.MCPServer, len(servers))
+	for i := range servers {
+		out[i] = mcpServerToProto(&servers[i])
+	}
+	return &sacv1.MCPServerListResponse{McpServers: out}, nil
+}
+
+func (s *Server) GetMCPServer(ctx context.Context, req *sacv1.MCPServerByIdRequest) (*sacv1.MCPServer, error) {
+	srv, err := s.getVisible(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return mcpServerToProto(srv), nil
+}
+
+func (s *Server) create(ctx context.Context, req *sacv1.CreateMCPServerRequest, official bool) (*sacv1.MCPServer, error) {
+	srv := &models.MCPServer{
+		Name:        req.Name,
+		Description: req.Description,
+		Transport:   req.Transport,
+		Command:     req.Command,
+		Args:        req.Args,
+		URL:         req.Url,
+		Env:         req.Env,
+		Headers:     req.Headers,
+		IsOfficial:  official,
+		CreatedBy:   ctxkeys.UserID(ctx),
+	}
+	if err := Validate(srv); err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+
+	if _, err := s.db.NewInsert().Model(srv).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create MCP server", err)
+	}
+	return mcpServerToProto(srv), nil
+}
+
+func (s *Server) CreateMCPServer(ctx context.Context, req *sacv1.CreateMCPServerRequest) (*sacv1.MCPServer, error) {
+	return s.create(ctx, req, false)
+}
+
+// AdminCreateMCPServer adds an official entry, visible to every user.
+func (s *Server) AdminCreateMCPServer(ctx context.Context, req *sacv1.CreateMCPServerRequest) (*sacv1.MCPServer, error) {
+	if ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("admin access required")
+	}
+	return s.create(ctx, req, true)
+}
+
+func (s *Server) UpdateMCPServer(ctx context.Context, req *sacv1.UpdateMCPServerRequest) (*sacv1.MCPServer, error) {
+	srv, err := s.getEditable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.Name = req.Name
+	srv.Description = req.Description
+	srv.Transport = req.Transport
+	srv.Command = req.Command
+	srv.Args = req.Args
+	srv.URL = req.Url
+	srv.Env = req.Env
+	srv.Headers = req.Headers
+	srv.UpdatedAt = time.Now()
+	if err := Validate(srv); err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+
+	_, err = s.db.NewUpdate().Model(srv).
+		Column("name", "description", "transport", "command", "args", "url", "env", "headers", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update MCP server", err)
+	}
+
+	go s.syncInstalled(srv.ID)
+	return mcpServerToProto(srv), nil
+}
+
+func (s *Server) DeleteMCPServer(ctx context.Context, req *sacv1.MCPServerByIdRequest) (*sacv1.SuccessMessage, error) {
+	srv, err := s.getEditable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the agents first: the installations go with the entry.
+	agents := s.installedAgents(ctx, srv.ID)
+	if _, err := s.db.NewDelete().Model(srv).WherePK().Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to delete MCP server", err)
+	}
+
+	go s.syncAgents(agents)
+	return &sacv1.SuccessMessage{Message: "MCP server deleted"}, nil
+}
+
+// installedAgents returns the agents a catalogue entry is installed on.
+func (s *Server) installedAgents(ctx context.Context, serverID int64) []models.Agent {
+	var agents []models.Agent
+	err := s.db.NewSelect().Model(&agents).
+		Column("ag.id", "ag.created_by").
+		Join("JOIN agent_mcp_servers AS ams ON ams.agent_id = ag.id").
+		Where("ams.mcp_server_id = ?", serverID).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("mcp_server_id", serverID).Msg("failed to list agents with mcp server")
+	}
+	return agents
+}
+
+// syncInstalled rewrites the MCP config of every agent a catalogue entry is
+// installed on. Agents whose pod is not running pick it up on session start.
+func (s *Server) syncInstalled(serverID int64) {
+	s.syncAgents(s.installedAgents(context.Background(), serverID))
+}
+
+func (s *Server) syncAgents(agents []models.Agent) {
+	for _, a := range agents {
+		s.syncAgent(a.CreatedBy, a.ID)
+	}
+}
+
+func (s *Server) syncAgent(userID, agentID int64) {
+	if _, err := s.syncer.SyncToAgent(context.Background(), userID, agentID); err != nil {
+		log.Debug().Err(err).Int64("agent_id", agentID).Msg("mcp sync skipped")
+	}
+}
+
+// ownAgent checks that the caller owns the agent.
+func (s *Server) ownAgent(ctx context.Context, agentID, userID int64) error {
+	exists, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", agentID, userID).
+		Exists(ctx)
+	if err != nil {
+		return grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return grpcerr.NotFound("Agent not found")
+	}
+	return nil
+}
+
+func (s *Server) ListAgentMCPServers(ctx context.Context, req *sacv1.ListAgentMCPServersRequest) (*sacv1.AgentMCPServerListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+	if err := s.ownAgent(ctx, req.AgentId, userID); err != nil {
+		return nil, err
+	}
+
+	var installs []models.AgentMCPServer
+	err := s.db.NewSelect().Model(&installs).
+		Relation("MCPServer").
+		Where("ams.agent_id = ?", req.AgentId).
+		OrderExpr("ams.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list agent MCP servers", err)
+	}
+	secrets, err := UserSecrets(ctx, s.db, userID)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load secrets", err)
+	}
+
+	out := make([]*sacv1.AgentMCPServer, 0, len(installs))
+	for i := range installs {
+		in := &installs[i]
+		if in.MCPServer == nil {
+			continue
+		}
+		out = append(out, &sacv1.AgentMCPServer{
+			AgentId:        in.AgentID,
+			McpServer:      mcpServerToProto(in.MCPServer),
+			MissingSecrets: MissingSecrets(in.MCPServer, secrets),
+			CreatedAt:      timestamppb.New(in.CreatedAt),
+		})
+	}
+	return &sacv1.AgentMCPServerListResponse{McpServers: out}, nil
+}
+
+func (s *Server) InstallMCPServer(ctx context.Context, req *sacv1.AgentMCPServerRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+	if err := s.ownAgent(ctx, req.AgentId, userID); err != nil {
+		return nil, err
+	}
+	srv, err := s.getVisible(ctx, req.McpServerId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Names are keys in the pod config, so they must be unique per agent.
+	clash, err := s.db.NewSelect().Model((*models.MCPServer)(nil)).
+		Join("JOIN agent_mcp_servers AS ams ON ams.mcp_server_id = mcp.id").
+		Where("ams.agent_id = ?", req.AgentId).
+		Where("mcp.name = ?", srv.Name).
+		Where("mcp.id != ?", srv.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to check installed MCP servers", err)
+	}
+	if clash {
+		return nil, grpcerr.Conflict(fmt.Sprintf("An MCP server named %q is already installed on this agent", srv.Name))
+	}
+
+	_, err = s.db.NewInsert().
+		Model(&models.AgentMCPServer{AgentID: req.AgentId, MCPServerID: srv.ID}).
+		On("CONFLICT (agent_id, mcp_server_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to install MCP server", err)
+	}
+
+	go s.syncAgent(userID, req.AgentId)
+
+	msg := "MCP server installed successfully"
+	if missing := MissingSecrets(srv, s.secretsOrEmpty(ctx, userID)); len(missing) > 0 {
+		msg += "; set secrets " + strings.Join(missing, ", ") + " to enable it"
+	}
+	return &sacv1.SuccessMessage{Message: msg}, nil
+}
+
+func (s *Server) UninstallMCPServer(ctx context.Context, req *sacv1.AgentMCPServerRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+	if err := s.ownAgent(ctx, req.AgentId, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := s.db.NewDelete().Model((*models.AgentMCPServer)(nil)).
+		Where("agent_id = ? AND mcp_server_id = ?", req.AgentId, req.McpServerId).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to uninstall MCP server", err)
+	}
+
+	go s.syncAgent(userID, req.AgentId)
+	return &sacv1.SuccessMessage{Message: "MCP server uninstalled successfully"}, nil
+}
+
+func (s *Server) secretsOrEmpty(ctx context.Context, userID int64) map[string]string {
+	secrets, err := UserSecrets(ctx, s.db, userID)
+	if err != nil {
+		return map[string]string{}
+	}
+	return secrets
+}
+
+func (s *Server) ListUserSecrets(ctx context.Context, _ *sacv1.Empty) (*sacv1.UserSecretListResponse, error) {
+	var secrets []models.UserSecret
+	err := s.db.NewSelect().Model(&secrets).
+		Where("user_id = ?", ctxkeys.UserID(ctx)).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list secrets", err)
+	}
+	return &sacv1.UserSecretListResponse{Secrets: convert.UserSecretsToProto(secrets)}, nil
+}
+
+// SetUserSecret creates or replaces a secret and refreshes the MCP config
+// of the caller's agents that reference it.
+func (s *Server) SetUserSecret(ctx context.Context, req *sacv1.SetUserSecretRequest) (*sacv1.UserSecret, error) {
+	userID := ctxkeys.UserID(ctx)
+	if !ValidSecretName(req.Name) {
+		return nil, grpcerr.BadRequest("name must start with a letter or '_' and contain only letters, digits and '_'")
+	}
+	if len(req.Value) > maxSecretBytes {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("value must be at most %d bytes", maxSecretBytes))
+	}
+
+	count, err := s.db.NewSelect().Model((*models.UserSecret)(nil)).
+		Where("user_id = ?", userID).
+		Where("name != ?", req.Name).
+		Count(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to count secrets", err)
+	}
+	if count >= maxSecretsPerUser {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("at most %d secrets per user", maxSecretsPerUser))
+	}
+
+	now := time.Now()
+	secret := &models.UserSecret{UserID: userID, Name: req.Name, Value: req.Value, CreatedAt: now, UpdatedAt: now}
+	_, err = s.db.NewInsert().Model(secret).
+		On("CONFLICT (user_id, name) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to save secret", err)
+	}
+
+	go s.syncSecretUsers(userID, req.Name)
+	return convert.UserSecretToProto(secret), nil
+}
+
+func (s *Server) DeleteUserSecret(ctx context.Context, req *sacv1.DeleteUserSecretRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+	res, err := s.db.NewDelete().Model((*models.UserSecret)(nil)).
+		Where("user_id = ? AND name = ?", userID, req.Name).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to delete secret", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Secret not found")
+	}
+
+	go s.syncSecretUsers(userID, req.Name)
+	return &sacv1.SuccessMessage{Message: "Secret deleted"}, nil
+}
+
+// syncSecretUsers resyncs the user's agents with an MCP server that
+// references the named secret.
+func (s *Server) syncSecretUsers(userID int64, name string) {
+	ctx := context.Background()
+	var agentIDs []int64
+	err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Column("id").
+		Where("created_by = ?", userID).
+		Where("EXISTS (SELECT 1 FROM agent_mcp_servers AS ams WHERE ams.agent_id = ag.id)").
+		Scan(ctx, &agentIDs)
+	if err != nil {
+		log.Warn().Err(err).Int64("user_id", userID).Msg("failed to list agents for secret change")
+		return
+	}
+	for _, agentID := range agentIDs {
+		servers, err := InstalledServers(ctx, s.db, agentID)
+		if err != nil {
+			continue
+		}
+		for i := range servers {
+			if refersTo(&servers[i], name) {
+				s.syncAgent(userID, agentID)
+				break
+			}
+		}
+	}
+}
+
+func refersTo(srv *models.MCPServer, secret string) bool {
+	for _, ref := range SecretRefs(srv) {
+		if ref == secret {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// ConfigPath is the MCP config file in the agent pod. The entrypoint passes
+// it to claude via --mcp-config when present.
+const ConfigPath = "/root/.claude/sac-mcp.json"
+
+// Syncer writes the MCP servers installed on an agent into its pod.
+type Syncer struct {
+	db               *bun.DB
+	containerManager *container.Manager
+}
+
+// NewSyncer creates a new Syncer.
+func NewSyncer(db *bun.DB, containerManager *container.Manager) *Syncer {
+	return &Syncer{db: db, containerManager: containerManager}
+}
+
+// InstalledServers returns the MCP servers installed on an agent in
+// installation order.
+func InstalledServers(ctx context.Context, db bun.IDB, agentID int64) ([]models.MCPServer, error) {
+	var servers []models.MCPServer
+	err := db.NewSelect().
+		Model(&servers).
+		Join("JOIN agent_mcp_servers AS ams ON ams.mcp_server_id = mcp.id").
+		Where("ams.agent_id = ?", agentID).
+		OrderExpr("ams.id ASC").
+		Scan(ctx)
+	return servers, err
+}
+
+// UserSecrets returns the secret values of a user keyed by name.
+func UserSecrets(ctx context.Context, db bun.IDB, userID int64) (map[string]string, error) {
+	var rows []models.UserSecret
+	if err := db.NewSelect().Model(&rows).Where("user_id = ?", userID).Scan(ctx); err != nil {
+		return nil, err
+	}
+	secrets := make(map[string]string, len(rows))
+	for _, r := range rows {
+		secrets[r.Name] = r.Value
+	}
+	return secrets, nil
+}
+
+// SyncToAgent renders the agent's MCP servers with the owner's secrets and
+// writes them to ConfigPath, removing the file when nothing is installed.
+// Claude Code reads the file at startup, so when it changed the Claude Code
+// process is restarted. Returns whether the file changed.
+func (s *Syncer) SyncToAgent(ctx context.Context, userID int64, agentID int64) (bool, error) {
+	servers, err := InstalledServers(ctx, s.db, agentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load mcp servers of agent %d: %w", agentID, err)
+	}
+	secrets, err := UserSecrets(ctx, s.db, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load secrets of user %d: %w", userID, err)
+	}
+
+	pod := fmt.Sprintf("claude-code-%d-%d-0", userID, agentID)
+	current, _, err := s.containerManager.ExecInPod(ctx, pod, []string{"sh", "-c", "cat " + ConfigPath + " 2>/dev/null || true"}, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to read mcp config in pod %s: %w", pod, err)
+	}
+
+	config, skipped := RenderConfig(servers, secrets)
+	for name, reason := range skipped {
+		log.Warn().Str("server", name).Str("reason", reason).Str("pod", pod).Msg("skipped mcp server")
+	}
+
+	if len(config) == 0 {
+		if strings.TrimSpace(current) == "" {
+			return false, nil
+		}
+		if err := s.containerManager.DeleteFileInPod(ctx, pod, ConfigPath); err != nil {
+			return false, fmt.Errorf("failed to remove mcp config in pod %s: %w", pod, err)
+		}
+	} else {
+		data, err := json.MarshalIndent(map[string]any{"mcpServers": config}, "", "  ")
+		if err != nil {
+			return false, fmt.Errorf("failed to encode mcp config: %w", err)
+		}
+		content := string(data) + "\n"
+		if current == content {
+			return false, nil
+		}
+		if err := s.containerManager.WriteFileInPod(ctx, pod, ConfigPath, content); err != nil {
+			return false, fmt.Errorf("failed to write mcp config in pod %s: %w", pod, err)
+		}
+	}
+
+	if err := s.containerManager.RestartClaudeCodeProcess(ctx, pod); err != nil {
+		log.Warn().Err(err).Str("pod", pod).Msg("failed to restart Claude Code after mcp sync")
+	}
+	log.Info().Int("servers", len(config)).Int("skipped", len(skipped)).Str("pod", pod).Msg("synced mcp servers")
+	return true, nil
+}
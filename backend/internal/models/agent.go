@@ -60,6 +60,8 @@ type Agent struct {
 	CreatedAt     time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
 	UpdatedAt     time.Time   `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
 
+	ClaudeSettings ClaudeSettings `bun:"claude_settings,type:jsonb,notnull,default:'{}'" json:"claude_settings"` // agent layer of the pod's Claude Code settings
+
 	// Relations
 	Creator         *User        `bun:"rel:belongs-to,join:created_by=id" json:"creator,omitempty"`
 	InstalledSkills []AgentSkill `bun:"rel:has-many,join:id=agent_id" json:"installed_skills,omitempty"`
@@ -74,8 +76,16 @@ type AgentSkill struct {
 	SkillID       int64     `bun:"skill_id,notnull" json:"skill_id"`
 	Order         int       `bun:"order,notnull,default:0" json:"order"` // Display order
 	SyncedVersion int       `bun:"synced_version,notnull,default:0" json:"synced_version"`
+	PinnedVersion *int      `bun:"pinned_version" json:"pinned_version,omitempty"` // nil follows the latest version
 	CreatedAt     time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
 
+	// Sync status of the installation in the agent pod.
+	LastSyncAttemptAt *time.Time `bun:"last_sync_attempt_at" json:"last_sync_attempt_at,omitempty"`
+	LastSyncedAt      *time.Time `bun:"last_synced_at" json:"last_synced_at,omitempty"`
+	SyncError         string     `bun:"sync_error,notnull,default:''" json:"sync_error,omitempty"`
+	SyncAttempts      int        `bun:"sync_attempts,notnull,default:0" json:"sync_attempts"`   // consecutive failures
+	NextSyncRetryAt   *time.Time `bun:"next_sync_retry_at" json:"next_sync_retry_at,omitempty"` // maintenance backoff after a failure
+
 	// Relations
 	Agent *Agent `bun:"rel:belongs-to,join:agent_id=id" json:"agent,omitempty"`
 	Skill *Skill `bun:"rel:belongs-to,join:skill_id=id" json:"skill,omitempty"`
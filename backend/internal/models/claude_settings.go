@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// ClaudeHook is an extra Claude Code hook command, run for Event on tools
+// matching Matcher.
+type ClaudeHook struct {
+	Event   string `json:"event"`
+	Matcher string `json:"matcher,omitempty"`
+	Command string `json:"command"`
+	Timeout int    `json:"timeout,omitempty"` // seconds, 0 = Claude Code default
+}
+
+// ClaudeSettings is one layer of the Claude Code settings written into agent
+// pods. Layers (platform default, groups, agent) are merged server-side.
+type ClaudeSettings struct {
+	Allow                    []string          `json:"allow,omitempty"`
+	Deny                     []string          `json:"deny,omitempty"`
+	Ask                      []string          `json:"ask,omitempty"`
+	DefaultMode              string            `json:"default_mode,omitempty"`
+	DisableBypassPermissions bool              `json:"disable_bypass_permissions,omitempty"`
+	Model                    string            `json:"model,omitempty"`
+	Env                      map[string]string `json:"env,omitempty"`
+	Hooks                    []ClaudeHook      `json:"hooks,omitempty"`
+}
+
+// IsZero returns true if the layer sets nothing.
+func (c *ClaudeSettings) IsZero() bool {
+	return len(c.Allow) == 0 && len(c.Deny) == 0 && len(c.Ask) == 0 &&
+		c.DefaultMode == "" && !c.DisableBypassPermissions && c.Model == "" &&
+		len(c.Env) == 0 && len(c.Hooks) == 0
+}
+
+// Scan implements sql.Scanner for JSONB column.
+func (c *ClaudeSettings) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(bytes, c)
+}
+
+// Value implements driver.Valuer for JSONB column.
+func (c ClaudeSettings) Value() (driver.Value, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// MCP server transports, matching the "type" of a Claude Code mcpServers entry.
+const (
+	MCPTransportStdio = "stdio"
+	MCPTransportHTTP  = "http"
+	MCPTransportSSE   = "sse"
+)
+
+// MCPServer is a catalogue entry describing how Claude Code starts or
+// reaches an MCP server. Env and header values may reference the installing
+// user's secrets as ${secret:NAME}; they are resolved when synced to a pod.
+type MCPServer struct {
+	bun.BaseModel `bun:"table:mcp_servers,alias:mcp"`
+
+	ID          int64             `bun:"id,pk,autoincrement" json:"id"`
+	Name        string            `bun:"name,notnull" json:"name"` // key under mcpServers
+	Description string            `bun:"description,notnull" json:"description"`
+	Transport   string            `bun:"transport,notnull" json:"transport"`
+	Command     string            `bun:"command,notnull" json:"command"` // stdio only
+	Args        []string          `bun:"args,array" json:"args"`         // stdio only
+	URL         string            `bun:"url,notnull" json:"url"`         // http and sse only
+	Env         map[string]string `bun:"env,type:jsonb,notnull,default:'{}'" json:"env"`
+	Headers     map[string]string `bun:"headers,type:jsonb,notnull,default:'{}'" json:"headers"`
+	IsOfficial  bool              `bun:"is_official,notnull" json:"is_official"`
+	CreatedBy   int64             `bun:"created_by,notnull" json:"created_by"`
+	CreatedAt   time.Time         `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt   time.Time         `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
+
+// AgentMCPServer installs a catalogue entry on an agent.
+type AgentMCPServer struct {
+	bun.BaseModel `bun:"table:agent_mcp_servers,alias:ams"`
+
+	ID          int64     `bun:"id,pk,autoincrement" json:"id"`
+	AgentID     int64     `bun:"agent_id,notnull" json:"agent_id"`
+	MCPServerID int64     `bun:"mcp_server_id,notnull" json:"mcp_server_id"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+
+	MCPServer *MCPServer `bun:"rel:belongs-to,join:mcp_server_id=id" json:"mcp_server,omitempty"`
+}
+
+// UserSecret is a named value referenced from MCP server env and headers.
+// The value is never returned by the API.
+type UserSecret struct {
+	bun.BaseModel `bun:"table:user_secrets,alias:us"`
+
+	ID        int64     `bun:"id,pk,autoincrement" json:"id"`
+	UserID    int64     `bun:"user_id,notnull" json:"user_id"`
+	Name      string    `bun:"name,notnull" json:"name"`
+	Value     string    `bun:"value,notnull" json:"-"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Notification channel types.
+const (
+	NotificationChannelWebhook = "webhook" // generic JSON POST, optional HMAC signature
+	NotificationChannelEmail   = "email"   // SMTP, configured via smtp_* system settings
+	NotificationChannelSlack   = "slack"   // Slack-compatible incoming webhook
+)
+
+// NotificationChannel is a per-user destination for platform events.
+type NotificationChannel struct {
+	bun.BaseModel `bun:"table:notification_channels,alias:nc"`
+
+	ID         int64      `bun:"id,pk,autoincrement" json:"id"`
+	UserID     int64      `bun:"user_id,notnull" json:"user_id"`
+	Name       string     `bun:"name,notnull" json:"name"`
+	Type       string     `bun:"type,notnull" json:"type"`
+	Target     string     `bun:"target,notnull" json:"target"`
+	Secret     string     `bun:"secret,notnull" json:"-"`
+	Events     []string   `bun:"events,array" json:"events"` // empty = all events
+	Enabled    bool       `bun:"enabled,notnull" json:"enabled"`
+	LastSentAt *time.Time `bun:"last_sent_at" json:"last_sent_at,omitempty"`
+	LastError  string     `bun:"last_error,notnull" json:"last_error"`
+	CreatedAt  time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt  time.Time  `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
@@ -33,3 +33,26 @@ type Session struct {
 	// Relations
 	User *User `bun:"rel:belongs-to,join:user_id=id" json:"user,omitempty"`
 }
+
+type SessionShareMode string
+
+const (
+	SessionShareReadOnly  SessionShareMode = "read_only"
+	SessionShareReadWrite SessionShareMode = "read_write"
+)
+
+// SessionShare grants other users access to a live terminal session.
+// A grant targets explicit users, a group, or both, and stops matching
+// once expires_at has passed.
+type SessionShare struct {
+	bun.BaseModel `bun:"table:session_shares,alias:ss"`
+
+	ID        int64            `bun:"id,pk,autoincrement" json:"id"`
+	SessionID string           `bun:"session_id,notnull" json:"session_id"`
+	OwnerID   int64            `bun:"owner_id,notnull" json:"owner_id"`
+	Mode      SessionShareMode `bun:"mode,notnull" json:"mode"`
+	UserIDs   []int64          `bun:"user_ids,array" json:"user_ids"`
+	GroupID   *int64           `bun:"group_id" json:"group_id,omitempty"`
+	ExpiresAt *time.Time       `bun:"expires_at" json:"expires_at,omitempty"`
+	CreatedAt time.Time        `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
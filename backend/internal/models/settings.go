@@ -20,7 +20,8 @@ func (sv *SettingValue) Scan(value any) error {
 	if !ok {
 		return nil
 	}
-	*sv = SettingValue(bytes)
+	// The driver may reuse the buffer for the next row.
+	*sv = SettingValue(append([]byte(nil), bytes...))
 	return nil
 }
 
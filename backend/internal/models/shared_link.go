@@ -6,15 +6,38 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// Who may open a shared link besides its owner.
+const (
+	ShareAccessPublic = "public" // anyone with the link
+	ShareAccessLogin  = "login"  // any logged-in user
+	ShareAccessGroups = "groups" // members of GroupIDs
+)
+
 type SharedLink struct {
 	bun.BaseModel `bun:"table:shared_links,alias:sl"`
 
-	ID        int64     `bun:"id,pk,autoincrement" json:"id"`
-	ShortCode string    `bun:"short_code,notnull" json:"short_code"`
-	UserID    int64     `bun:"user_id,notnull" json:"user_id"`
-	AgentID   int64     `bun:"agent_id,notnull" json:"agent_id"`
-	FilePath  string    `bun:"file_path,notnull" json:"file_path"`
-	OSSKey    string    `bun:"oss_key,notnull" json:"oss_key"`
-	FileName  string    `bun:"file_name,notnull" json:"file_name"`
-	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	ID             int64      `bun:"id,pk,autoincrement" json:"id"`
+	ShortCode      string     `bun:"short_code,notnull" json:"short_code"`
+	UserID         int64      `bun:"user_id,notnull" json:"user_id"`
+	AgentID        int64      `bun:"agent_id,notnull" json:"agent_id"`
+	FilePath       string     `bun:"file_path,notnull" json:"file_path"`
+	OSSKey         string     `bun:"oss_key,notnull" json:"oss_key"`
+	FileName       string     `bun:"file_name,notnull" json:"file_name"`
+	ExpiresAt      *time.Time `bun:"expires_at" json:"expires_at,omitempty"`
+	PasswordHash   string     `bun:"password_hash,notnull,default:''" json:"-"`
+	MaxDownloads   int        `bun:"max_downloads,notnull,default:0" json:"max_downloads"` // 0 = unlimited
+	DownloadCount  int        `bun:"download_count,notnull,default:0" json:"download_count"`
+	Access         string     `bun:"access,notnull,default:'public'" json:"access"`
+	GroupIDs       []int64    `bun:"group_ids,array,notnull,default:'{}'" json:"group_ids,omitempty"`
+	LastAccessedAt *time.Time `bun:"last_accessed_at" json:"last_accessed_at,omitempty"`
+	CreatedAt      time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
+
+// Expired reports whether the link can no longer be used because its
+// expiry time passed or its download limit was reached.
+func (l *SharedLink) Expired(now time.Time) bool {
+	if l.ExpiresAt != nil && !now.Before(*l.ExpiresAt) {
+		return true
+	}
+	return l.MaxDownloads > 0 && l.DownloadCount >= l.MaxDownloads
 }
@@ -102,27 +102,167 @@ type SkillFile struct {
 type Skill struct {
 	bun.BaseModel `bun:"table:skills,alias:sk"`
 
-	ID              int64            `bun:"id,pk,autoincrement" json:"id"`
-	Name            string           `bun:"name,notnull" json:"name"`
-	Description     string           `bun:"description" json:"description"`
-	Icon            string           `bun:"icon" json:"icon"`
-	Category        string           `bun:"category,notnull" json:"category"`
-	Prompt          string           `bun:"prompt,type:text,notnull" json:"prompt"`
-	CommandName     string           `bun:"command_name" json:"command_name"`
-	Parameters      SkillParameters  `bun:"parameters,type:jsonb" json:"parameters,omitempty"`
-	Frontmatter     SkillFrontmatter `bun:"frontmatter,type:jsonb,notnull,default:'{}'" json:"frontmatter"`
-	IsOfficial      bool             `bun:"is_official,notnull,default:false" json:"is_official"`
-	CreatedBy       int64            `bun:"created_by,notnull" json:"created_by"`
-	IsPublic        bool             `bun:"is_public,notnull,default:false" json:"is_public"`
-	ForkedFrom      *int64           `bun:"forked_from" json:"forked_from,omitempty"`
-	GroupID         *int64           `bun:"group_id" json:"group_id,omitempty"`
-	Version         int              `bun:"version,notnull,default:1" json:"version"`
-	ContentChecksum string           `bun:"content_checksum,notnull,default:''" json:"content_checksum"`
-	CreatedAt       time.Time        `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
-	UpdatedAt       time.Time        `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+	ID               int64            `bun:"id,pk,autoincrement" json:"id"`
+	Name             string           `bun:"name,notnull" json:"name"`
+	Description      string           `bun:"description" json:"description"`
+	Icon             string           `bun:"icon" json:"icon"`
+	Category         string           `bun:"category,notnull" json:"category"`
+	Tags             []string         `bun:"tags,array,notnull,default:'{}'" json:"tags"`
+	Prompt           string           `bun:"prompt,type:text,notnull" json:"prompt"`
+	CommandName      string           `bun:"command_name" json:"command_name"`
+	Parameters       SkillParameters  `bun:"parameters,type:jsonb" json:"parameters,omitempty"`
+	Frontmatter      SkillFrontmatter `bun:"frontmatter,type:jsonb,notnull,default:'{}'" json:"frontmatter"`
+	IsOfficial       bool             `bun:"is_official,notnull,default:false" json:"is_official"`
+	CreatedBy        int64            `bun:"created_by,notnull" json:"created_by"`
+	IsPublic         bool             `bun:"is_public,notnull,default:false" json:"is_public"`
+	ForkedFrom       *int64           `bun:"forked_from" json:"forked_from,omitempty"`
+	UpstreamVersion  *int             `bun:"upstream_version" json:"upstream_version,omitempty"` // revision of forked_from the fork is based on
+	GroupID          *int64           `bun:"group_id" json:"group_id,omitempty"`
+	Version          int              `bun:"version,notnull,default:1" json:"version"`
+	ContentChecksum  string           `bun:"content_checksum,notnull,default:''" json:"content_checksum"`
+	BundleError      string           `bun:"bundle_error,notnull,default:''" json:"bundle_error,omitempty"` // why the last bundle build failed; "" when the bundle is current
+	PublishedVersion *int             `bun:"published_version" json:"published_version,omitempty"`          // approved revision other users see; nil = not shared
+	SourceID         *int64           `bun:"source_id" json:"source_id,omitempty"`                          // set for skills pulled from a Git source (read-only)
+	SourcePath       string           `bun:"source_path,notnull,default:''" json:"source_path,omitempty"`
+	SourceCommit     string           `bun:"source_commit,notnull,default:''" json:"source_commit,omitempty"`
+	CreatedAt        time.Time        `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt        time.Time        `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+
+	// Marketplace statistics, only loaded by queries that select them.
+	InstallCount    int `bun:"install_count,scanonly" json:"install_count"`
+	ForkCount       int `bun:"fork_count,scanonly" json:"fork_count"`
+	InvocationCount int `bun:"invocation_count,scanonly" json:"invocation_count"` // maintained from conversation history
+	// Newest revision of forked_from the fork's owner can see.
+	UpstreamLatestVersion *int `bun:"upstream_latest_version,scanonly" json:"upstream_latest_version,omitempty"`
 
 	// Relations
 	Creator *User       `bun:"rel:belongs-to,join:created_by=id" json:"creator,omitempty"`
 	Group   *Group      `bun:"rel:belongs-to,join:group_id=id" json:"group,omitempty"`
 	Files   []SkillFile `bun:"rel:has-many,join:id=skill_id" json:"files,omitempty"`
 }
+
+// SkillVersionFile is an entry of a skill revision's file manifest.
+type SkillVersionFile struct {
+	Filepath    string `json:"filepath"`
+	Checksum    string `json:"checksum"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+}
+
+// SkillVersion is an immutable snapshot of a skill's content, recorded each
+// time its bundle is rebuilt.
+type SkillVersion struct {
+	bun.BaseModel `bun:"table:skill_versions,alias:sv"`
+
+	ID              int64              `bun:"id,pk,autoincrement" json:"id"`
+	SkillID         int64              `bun:"skill_id,notnull" json:"skill_id"`
+	Version         int                `bun:"version,notnull" json:"version"`
+	SkillMD         string             `bun:"skill_md,notnull" json:"skill_md"` // empty for seeded revisions
+	Prompt          string             `bun:"prompt,notnull" json:"prompt"`
+	Frontmatter     SkillFrontmatter   `bun:"frontmatter,type:jsonb,notnull" json:"frontmatter"`
+	Files           []SkillVersionFile `bun:"files,type:jsonb,notnull" json:"files"`
+	BundleKey       string             `bun:"bundle_key,notnull" json:"bundle_key"` // S3 key of this revision's bundle.tar
+	ContentChecksum string             `bun:"content_checksum,notnull" json:"content_checksum"`
+	Note            string             `bun:"note,notnull" json:"note"`
+	CreatedBy       *int64             `bun:"created_by" json:"created_by,omitempty"`
+	CreatedAt       time.Time          `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
+
+// Skill review targets: the audience a revision is submitted to.
+const (
+	SkillReviewPublic   = "public"
+	SkillReviewGroup    = "group"
+	SkillReviewOfficial = "official"
+)
+
+// Skill review states.
+const (
+	SkillReviewPending   = "pending"
+	SkillReviewApproved  = "approved"
+	SkillReviewRejected  = "rejected"
+	SkillReviewWithdrawn = "withdrawn"
+)
+
+// SkillReview is a request to publish a revision of a skill to an audience.
+// Group admins review group submissions; platform admins review public and
+// official ones.
+type SkillReview struct {
+	bun.BaseModel `bun:"table:skill_reviews,alias:sr"`
+
+	ID            int64      `bun:"id,pk,autoincrement" json:"id"`
+	SkillID       int64      `bun:"skill_id,notnull" json:"skill_id"`
+	Version       int        `bun:"version,notnull" json:"version"`
+	Target        string     `bun:"target,notnull" json:"target"`
+	GroupID       *int64     `bun:"group_id" json:"group_id,omitempty"`
+	Status        string     `bun:"status,notnull,default:'pending'" json:"status"`
+	Comment       string     `bun:"comment,notnull,default:''" json:"comment"`
+	SubmittedBy   int64      `bun:"submitted_by,notnull" json:"submitted_by"`
+	ReviewedBy    *int64     `bun:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewComment string     `bun:"review_comment,notnull,default:''" json:"review_comment"`
+	ReviewedAt    *time.Time `bun:"reviewed_at" json:"reviewed_at,omitempty"`
+	CreatedAt     time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+
+	// Relations
+	Skill     *Skill `bun:"rel:belongs-to,join:skill_id=id" json:"skill,omitempty"`
+	Submitter *User  `bun:"rel:belongs-to,join:submitted_by=id" json:"submitter,omitempty"`
+	Reviewer  *User  `bun:"rel:belongs-to,join:reviewed_by=id" json:"reviewer,omitempty"`
+}
+
+// Skill audit actions.
+const (
+	SkillAuditSubmitted   = "review_submitted"
+	SkillAuditApproved    = "review_approved"
+	SkillAuditRejected    = "review_rejected"
+	SkillAuditWithdrawn   = "review_withdrawn"
+	SkillAuditUnpublished = "unpublished"
+)
+
+// SkillAuditEvent is an append-only record of a publishing decision.
+type SkillAuditEvent struct {
+	bun.BaseModel `bun:"table:skill_audit_events,alias:sae"`
+
+	ID        int64     `bun:"id,pk,autoincrement" json:"id"`
+	SkillID   int64     `bun:"skill_id,notnull" json:"skill_id"`
+	ReviewID  *int64    `bun:"review_id" json:"review_id,omitempty"`
+	ActorID   *int64    `bun:"actor_id" json:"actor_id,omitempty"`
+	Action    string    `bun:"action,notnull" json:"action"`
+	Version   int       `bun:"version,notnull,default:0" json:"version"`
+	Comment   string    `bun:"comment,notnull,default:''" json:"comment"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+
+	// Relations
+	Actor *User `bun:"rel:belongs-to,join:actor_id=id" json:"actor,omitempty"`
+}
+
+// Skill source credential types.
+const (
+	SkillSourceAuthNone      = "none"
+	SkillSourceAuthToken     = "token"      // HTTPS access token
+	SkillSourceAuthDeployKey = "deploy_key" // SSH private key
+)
+
+// SkillSource is a Git repository whose */SKILL.md directories are mirrored
+// as read-only skills owned by the source's creator.
+type SkillSource struct {
+	bun.BaseModel `bun:"table:skill_sources,alias:ss"`
+
+	ID                  int64      `bun:"id,pk,autoincrement" json:"id"`
+	Name                string     `bun:"name,notnull" json:"name"`
+	RepoURL             string     `bun:"repo_url,notnull" json:"repo_url"`
+	Branch              string     `bun:"branch,notnull" json:"branch"`
+	Subdir              string     `bun:"subdir,notnull" json:"subdir"`
+	AuthType            string     `bun:"auth_type,notnull" json:"auth_type"`
+	Credential          string     `bun:"credential,notnull" json:"-"`
+	WebhookToken        string     `bun:"webhook_token,notnull,unique" json:"webhook_token"` // public path component
+	WebhookSecret       string     `bun:"webhook_secret,notnull" json:"-"`
+	SyncIntervalMinutes int        `bun:"sync_interval_minutes,notnull" json:"sync_interval_minutes"` // 0 = webhook/manual only
+	IsPublic            bool       `bun:"is_public,notnull" json:"is_public"`
+	GroupID             *int64     `bun:"group_id" json:"group_id,omitempty"`
+	LastCommit          string     `bun:"last_commit,notnull" json:"last_commit"`
+	LastSyncedAt        *time.Time `bun:"last_synced_at" json:"last_synced_at,omitempty"`
+	LastError           string     `bun:"last_error,notnull" json:"last_error"`
+	SyncStartedAt       *time.Time `bun:"sync_started_at" json:"-"`
+	CreatedBy           int64      `bun:"created_by,notnull" json:"created_by"`
+	CreatedAt           time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt           time.Time  `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
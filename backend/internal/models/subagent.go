@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Subagent is a Claude Code subagent definition, shared like skills:
+// owned by its creator, optionally public, group-shared or official.
+type Subagent struct {
+	bun.BaseModel `bun:"table:subagents,alias:sa"`
+
+	ID           int64     `bun:"id,pk,autoincrement" json:"id"`
+	Name         string    `bun:"name,notnull" json:"name"` // file name under .claude/agents
+	Description  string    `bun:"description,notnull" json:"description"`
+	Tools        []string  `bun:"tools,array,notnull,default:'{}'" json:"tools"` // empty inherits all tools
+	Model        string    `bun:"model,notnull,default:''" json:"model"`
+	SystemPrompt string    `bun:"system_prompt,type:text,notnull" json:"system_prompt"`
+	IsOfficial   bool      `bun:"is_official,notnull,default:false" json:"is_official"`
+	IsPublic     bool      `bun:"is_public,notnull,default:false" json:"is_public"`
+	GroupID      *int64    `bun:"group_id" json:"group_id,omitempty"`
+	CreatedBy    int64     `bun:"created_by,notnull" json:"created_by"`
+	CreatedAt    time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt    time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+
+	Creator *User `bun:"rel:belongs-to,join:created_by=id" json:"creator,omitempty"`
+}
+
+// AgentSubagent installs a subagent on an agent.
+type AgentSubagent struct {
+	bun.BaseModel `bun:"table:agent_subagents,alias:asa"`
+
+	ID         int64     `bun:"id,pk,autoincrement" json:"id"`
+	AgentID    int64     `bun:"agent_id,notnull" json:"agent_id"`
+	SubagentID int64     `bun:"subagent_id,notnull" json:"subagent_id"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+
+	Subagent *Subagent `bun:"rel:belongs-to,join:subagent_id=id" json:"subagent,omitempty"`
+}
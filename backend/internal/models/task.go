@@ -0,0 +1,88 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusRunning   TaskStatus = "running"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusFailed    TaskStatus = "failed"
+	TaskStatusTimeout   TaskStatus = "timeout"
+	TaskStatusCancelled TaskStatus = "cancelled"
+)
+
+// IsTerminal reports whether the task has finished and will not change again.
+func (s TaskStatus) IsTerminal() bool {
+	switch s {
+	case TaskStatusCompleted, TaskStatusFailed, TaskStatusTimeout, TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// TrimAllowedTools drops surrounding whitespace and blank entries from an
+// --allowedTools list as entered by the user.
+func TrimAllowedTools(in []string) []string {
+	out := make([]string, 0, len(in))
+	for _, t := range in {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// AgentTask is a headless, non-interactive Claude Code run inside an agent pod.
+type AgentTask struct {
+	bun.BaseModel `bun:"table:agent_tasks,alias:at"`
+
+	ID              int64      `bun:"id,pk,autoincrement" json:"id"`
+	TaskID          string     `bun:"task_id,notnull,unique" json:"task_id"`
+	UserID          int64      `bun:"user_id,notnull" json:"user_id"`
+	AgentID         int64      `bun:"agent_id,notnull" json:"agent_id"`
+	JobID           *int64     `bun:"job_id" json:"job_id,omitempty"` // set for scheduled runs
+	Prompt          string     `bun:"prompt,notnull" json:"prompt"`
+	AllowedTools    []string   `bun:"allowed_tools,array" json:"allowed_tools"`
+	TimeoutSeconds  int        `bun:"timeout_seconds,notnull" json:"timeout_seconds"`
+	Status          TaskStatus `bun:"status,notnull" json:"status"`
+	Result          string     `bun:"result,notnull" json:"result"`
+	Error           string     `bun:"error,notnull" json:"error"`
+	Stdout          string     `bun:"stdout,notnull" json:"stdout"`           // tail of the raw stream-json output
+	OutputFiles     []string   `bun:"output_files,array" json:"output_files"` // paths relative to /workspace/output
+	CostUSD         float64    `bun:"cost_usd,notnull" json:"cost_usd"`
+	NumTurns        int        `bun:"num_turns,notnull" json:"num_turns"`
+	ClaudeSessionID string     `bun:"claude_session_id,notnull" json:"claude_session_id"`
+	StartedAt       *time.Time `bun:"started_at" json:"started_at,omitempty"`
+	FinishedAt      *time.Time `bun:"finished_at" json:"finished_at,omitempty"`
+	CreatedAt       time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
+
+// AgentJob runs a prompt or skill on an agent on a cron schedule. Each run is
+// recorded as an AgentTask with JobID set.
+type AgentJob struct {
+	bun.BaseModel `bun:"table:agent_jobs,alias:aj"`
+
+	ID             int64      `bun:"id,pk,autoincrement" json:"id"`
+	UserID         int64      `bun:"user_id,notnull" json:"user_id"`
+	AgentID        int64      `bun:"agent_id,notnull" json:"agent_id"`
+	Name           string     `bun:"name,notnull" json:"name"`
+	Schedule       string     `bun:"schedule,notnull" json:"schedule"` // standard 5-field cron expression
+	Timezone       string     `bun:"timezone,notnull" json:"timezone"` // IANA name, e.g. "Asia/Shanghai"
+	Prompt         string     `bun:"prompt,notnull" json:"prompt"`
+	SkillID        *int64     `bun:"skill_id" json:"skill_id,omitempty"`
+	SkillArgs      string     `bun:"skill_args,notnull" json:"skill_args"`
+	AllowedTools   []string   `bun:"allowed_tools,array" json:"allowed_tools"`
+	TimeoutSeconds int        `bun:"timeout_seconds,notnull" json:"timeout_seconds"`
+	Enabled        bool       `bun:"enabled,notnull" json:"enabled"`
+	NextRunAt      *time.Time `bun:"next_run_at" json:"next_run_at,omitempty"`
+	LastRunAt      *time.Time `bun:"last_run_at" json:"last_run_at,omitempty"`
+	CreatedAt      time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt      time.Time  `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
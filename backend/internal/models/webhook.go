@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Webhook signature formats.
+const (
+	WebhookSignatureGitHub     = "github"      // X-Hub-Signature-256: sha256=<hex hmac>
+	WebhookSignatureGitLab     = "gitlab"      // X-Gitlab-Token: <secret>
+	WebhookSignatureHMACSHA256 = "hmac-sha256" // X-Signature: [sha256=]<hex hmac>
+	WebhookSignatureNone       = "none"
+)
+
+// AgentWebhook is an inbound endpoint that turns an HTTP payload into an
+// agent task via a prompt template.
+type AgentWebhook struct {
+	bun.BaseModel `bun:"table:agent_webhooks,alias:wh"`
+
+	ID               int64     `bun:"id,pk,autoincrement" json:"id"`
+	UserID           int64     `bun:"user_id,notnull" json:"user_id"`
+	AgentID          int64     `bun:"agent_id,notnull" json:"agent_id"`
+	Name             string    `bun:"name,notnull" json:"name"`
+	Token            string    `bun:"token,notnull,unique" json:"token"` // public path component
+	Secret           string    `bun:"secret,notnull" json:"-"`
+	SignatureFormat  string    `bun:"signature_format,notnull" json:"signature_format"`
+	PromptTemplate   string    `bun:"prompt_template,notnull" json:"prompt_template"` // text/template over the JSON payload
+	AllowedTools     []string  `bun:"allowed_tools,array" json:"allowed_tools"`
+	TimeoutSeconds   int       `bun:"timeout_seconds,notnull" json:"timeout_seconds"`
+	RateLimitPerHour int       `bun:"rate_limit_per_hour,notnull" json:"rate_limit_per_hour"`
+	Enabled          bool      `bun:"enabled,notnull" json:"enabled"`
+	CreatedAt        time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt        time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
+
+// Webhook delivery outcomes.
+const (
+	DeliveryAccepted    = "accepted"
+	DeliveryRejected    = "rejected"
+	DeliveryRateLimited = "rate_limited"
+	DeliveryFailed      = "failed"
+)
+
+type WebhookDelivery struct {
+	bun.BaseModel `bun:"table:webhook_deliveries,alias:wd"`
+
+	ID             int64     `bun:"id,pk,autoincrement" json:"id"`
+	WebhookID      int64     `bun:"webhook_id,notnull" json:"webhook_id"`
+	Status         string    `bun:"status,notnull" json:"status"`
+	StatusCode     int       `bun:"status_code,notnull" json:"status_code"`
+	Event          string    `bun:"event,notnull" json:"event"`
+	Error          string    `bun:"error,notnull" json:"error"`
+	TaskID         string    `bun:"task_id,notnull" json:"task_id"`
+	PayloadExcerpt string    `bun:"payload_excerpt,notnull" json:"payload_excerpt"`
+	RemoteAddr     string    `bun:"remote_addr,notnull" json:"remote_addr"`
+	ReceivedAt     time.Time `bun:"received_at,nullzero,notnull,default:current_timestamp" json:"received_at"`
+}
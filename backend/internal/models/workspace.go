@@ -36,3 +36,20 @@ type WorkspaceQuota struct {
 	MaxFileCount int       `bun:"max_file_count,notnull,default:1000" json:"max_file_count"`
 	UpdatedAt    time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
 }
+
+// WorkspaceUpload tracks a resumable browser upload into the input workspace.
+// Chunks are stored as separate objects until the upload is completed.
+type WorkspaceUpload struct {
+	bun.BaseModel `bun:"table:workspace_uploads,alias:wu"`
+
+	ID            string    `bun:"id,pk" json:"id"`
+	UserID        int64     `bun:"user_id,notnull" json:"user_id"`
+	AgentID       int64     `bun:"agent_id,notnull" json:"agent_id"`
+	FilePath      string    `bun:"file_path,notnull" json:"file_path"` // relative to /workspace
+	ContentType   string    `bun:"content_type" json:"content_type"`
+	SizeBytes     int64     `bun:"size_bytes,notnull" json:"size_bytes"`
+	ReceivedBytes int64     `bun:"received_bytes,notnull,default:0" json:"received_bytes"`
+	ChunkCount    int       `bun:"chunk_count,notnull,default:0" json:"chunk_count"`
+	CreatedAt     time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt     time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// Event types users can subscribe a channel to.
+const (
+	EventConversationStop   = "conversation.stop"
+	EventOutputUploaded     = "output.uploaded"
+	EventOutputDeleted      = "output.deleted"
+	EventSkillSyncCompleted = "skill.sync_completed"
+	EventSkillSyncFailed    = "skill.sync_failed"
+	EventPodCrashed         = "pod.crashed"
+	EventSkillReviewRequest = "skill.review_requested" // to reviewers
+	EventSkillReviewDecided = "skill.review_decided"   // to the submitter
+	EventTest               = "test"                   // TestNotificationChannel only, not subscribable
+)
+
+const (
+	deliveryTimeout = 30 * time.Second
+	maxLastErrorLen = 1000
+)
+
+// EventTypes lists the subscribable event types.
+var EventTypes = []string{
+	EventConversationStop,
+	EventOutputUploaded,
+	EventOutputDeleted,
+	EventSkillSyncCompleted,
+	EventSkillSyncFailed,
+	EventPodCrashed,
+	EventSkillReviewRequest,
+	EventSkillReviewDecided,
+}
+
+// Event is a platform event addressed to one user.
+type Event struct {
+	Type      string         `json:"type"`
+	UserID    int64          `json:"user_id"`
+	AgentID   int64          `json:"agent_id,omitempty"`
+	AgentName string         `json:"agent_name,omitempty"` // filled in on delivery
+	Title     string         `json:"title"`
+	Message   string         `json:"message"`
+	Data      map[string]any `json:"data,omitempty"`
+	Time      time.Time      `json:"time"`
+}
+
+// Notifier fans events out to the user's notification channels. A nil
+// *Notifier is valid and drops every event, so callers need no guard.
+type Notifier struct {
+	db           *bun.DB
+	client       *http.Client
+	allowPrivate bool
+	wg           sync.WaitGroup
+}
+
+// NewNotifier creates a new Notifier.
+func NewNotifier(db *bun.DB) *Notifier {
+	return &Notifier{db: db, client: newHTTPClient(false)}
+}
+
+// SetAllowPrivateTargets lets webhook and Slack channels point at private
+// and cluster-internal addresses, for installations whose chat server or
+// receiver lives on the internal network. Off by default.
+func (n *Notifier) SetAllowPrivateTargets(allow bool) {
+	n.allowPrivate = allow
+	n.client = newHTTPClient(allow)
+}
+
+func (n *Notifier) privateTargetsAllowed() bool {
+	return n != nil && n.allowPrivate
+}
+
+// Notify delivers ev asynchronously to every enabled channel of ev.UserID
+// subscribed to ev.Type. Delivery failures are recorded on the channel.
+func (n *Notifier) Notify(ev Event) {
+	if n == nil || ev.UserID == 0 {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+		defer cancel()
+		n.deliver(ctx, ev)
+	}()
+}
+
+// Wait blocks until all in-flight deliveries finish. Short-lived processes
+// (maintenance) call it before exiting.
+func (n *Notifier) Wait() {
+	if n != nil {
+		n.wg.Wait()
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, ev Event) {
+	var channels []models.NotificationChannel
+	err := n.db.NewSelect().Model(&channels).
+		Where("user_id = ?", ev.UserID).
+		Where("enabled = true").
+		Where("(cardinality(events) = 0 OR ? = ANY(events))", ev.Type).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Str("event", ev.Type).Int64("user_id", ev.UserID).Msg("failed to load notification channels")
+		return
+	}
+	if len(channels) == 0 {
+		return
+	}
+
+	n.fillAgentName(ctx, &ev)
+	for i := range channels {
+		n.sendAndRecord(ctx, &channels[i], ev)
+	}
+}
+
+func (n *Notifier) fillAgentName(ctx context.Context, ev *Event) {
+	if ev.AgentID == 0 || ev.AgentName != "" {
+		return
+	}
+	_ = n.db.NewSelect().Model((*models.Agent)(nil)).
+		Column("name").
+		Where("id = ?", ev.AgentID).
+		Scan(ctx, &ev.AgentName)
+}
+
+// sendAndRecord sends ev to ch and stores the outcome in last_sent_at/last_error.
+func (n *Notifier) sendAndRecord(ctx context.Context, ch *models.NotificationChannel, ev Event) error {
+	sendErr := n.send(ctx, ch, ev)
+
+	now := time.Now()
+	ch.LastError = ""
+	if sendErr != nil {
+		ch.LastError = sendErr.Error()
+		if len(ch.LastError) > maxLastErrorLen {
+			ch.LastError = ch.LastError[:maxLastErrorLen]
+		}
+		log.Warn().Err(sendErr).Int64("channel_id", ch.ID).Str("type", ch.Type).Str("event", ev.Type).Msg("notification delivery failed")
+	} else {
+		ch.LastSentAt = &now
+	}
+	_, err := n.db.NewUpdate().Model(ch).
+		Column("last_sent_at", "last_error").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("channel_id", ch.ID).Msg("failed to record notification result")
+	}
+	return sendErr
+}
+
+func (n *Notifier) send(ctx context.Context, ch *models.NotificationChannel, ev Event) error {
+	switch ch.Type {
+	case models.NotificationChannelWebhook:
+		return sendWebhook(ctx, n.client, ch.Target, ch.Secret, ev)
+	case models.NotificationChannelSlack:
+		return sendSlack(ctx, n.client, ch.Target, ev)
+	case models.NotificationChannelEmail:
+		cfg, err := loadSMTPConfig(ctx, n.db)
+		if err != nil {
+			return err
+		}
+		return sendEmail(ctx, cfg, ch.Target, ev)
+	}
+	return errUnknownType
+}
@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/container"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	podWatchRetryInterval = 10 * time.Second
+	crashDedupeTTL        = 24 * time.Hour
+)
+
+// WatchPodCrashes watches agent pods and emits EventPodCrashed whenever the
+// claude-code container restarts. With Redis, each restart is claimed with
+// SETNX so only one gateway replica notifies; rdb may be nil.
+func (n *Notifier) WatchPodCrashes(ctx context.Context, mgr *container.Manager, rdb *redis.Client) {
+	if n == nil {
+		return
+	}
+	// Restart counts seen per pod UID, so pre-existing restarts and
+	// replayed events don't notify again.
+	seen := make(map[string]int32)
+
+	for {
+		if err := n.watchPods(ctx, mgr, rdb, seen); err != nil {
+			log.Warn().Err(err).Msg("pod crash watch interrupted, retrying")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(podWatchRetryInterval):
+		}
+	}
+}
+
+func (n *Notifier) watchPods(ctx context.Context, mgr *container.Manager, rdb *redis.Client, seen map[string]int32) error {
+	pods := mgr.GetClientset().CoreV1().Pods(mgr.GetNamespace())
+	opts := metav1.ListOptions{LabelSelector: "app=claude-code"}
+
+	list, err := pods.List(ctx, opts)
+	if err != nil {
+		return err
+	}
+	for i := range list.Items {
+		p := &list.Items[i]
+		if _, ok := seen[string(p.UID)]; !ok {
+			seen[string(p.UID)] = restartCount(p)
+		}
+	}
+
+	opts.ResourceVersion = list.ResourceVersion
+	w, err := pods.Watch(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	for ev := range w.ResultChan() {
+		pod, ok := ev.Object.(*corev1.Pod)
+		if !ok {
+			continue
+		}
+		uid := string(pod.UID)
+		if ev.Type == watch.Deleted {
+			delete(seen, uid)
+			continue
+		}
+
+		count := restartCount(pod)
+		prev, known := seen[uid]
+		seen[uid] = count
+		if !known || count <= prev {
+			continue
+		}
+		n.podCrashed(ctx, rdb, pod, count)
+	}
+	return fmt.Errorf("watch channel closed")
+}
+
+func (n *Notifier) podCrashed(ctx context.Context, rdb *redis.Client, pod *corev1.Pod, count int32) {
+	userID, _ := strconv.ParseInt(pod.Labels["user-id"], 10, 64)
+	agentID, _ := strconv.ParseInt(pod.Labels["agent-id"], 10, 64)
+	if userID == 0 {
+		return
+	}
+
+	if rdb != nil {
+		key := fmt.Sprintf("sac:notify:pod-crash:%s:%d", pod.UID, count)
+		claimed, err := rdb.SetNX(ctx, key, 1, crashDedupeTTL).Result()
+		if err == nil && !claimed {
+			return
+		}
+	}
+
+	reason, exitCode := "Unknown", int32(0)
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name != "claude-code" {
+			continue
+		}
+		if t := cs.LastTerminationState.Terminated; t != nil {
+			reason, exitCode = t.Reason, t.ExitCode
+		}
+	}
+
+	log.Info().Str("pod", pod.Name).Str("reason", reason).Int32("restarts", count).Msg("agent pod crashed")
+	n.Notify(Event{
+		Type:    EventPodCrashed,
+		UserID:  userID,
+		AgentID: agentID,
+		Title:   "Agent pod crashed",
+		Message: fmt.Sprintf("Pod %s restarted (reason: %s, exit code %d, restarts: %d).", pod.Name, reason, exitCode, count),
+		Data: map[string]any{
+			"pod":       pod.Name,
+			"reason":    reason,
+			"exit_code": exitCode,
+			"restarts":  count,
+		},
+	})
+}
+
+// restartCount returns the restart count of the claude-code container.
+func restartCount(pod *corev1.Pod) int32 {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == "claude-code" {
+			return cs.RestartCount
+		}
+	}
+	return 0
+}
@@ -0,0 +1,281 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/smtp"
+	"strings"
+	"syscall"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+)
+
+var (
+	errUnknownType       = errors.New("unknown channel type")
+	errSMTPNotConfigured = errors.New("email notifications are not configured (smtp_host/smtp_from)")
+)
+
+// errPrivateTarget rejects webhook and Slack targets on loopback, link-local,
+// private or otherwise internal addresses, so channels cannot be used to
+// reach cluster services or the cloud metadata endpoint.
+var errPrivateTarget = errors.New("target must be a public address")
+
+// sharedAddressSpace is 100.64.0.0/10 (carrier-grade NAT), which some
+// clusters use for pod and service networks.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicAddr reports whether addr is a globally routable unicast address.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// checkTargetHost rejects target hosts that are obviously internal: private
+// IP literals, localhost and cluster-local names. Names that resolve to
+// internal addresses are caught at send time by the guarded dialer.
+func checkTargetHost(host string) error {
+	if addr, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil {
+		if !publicAddr(addr) {
+			return errPrivateTarget
+		}
+		return nil
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if !strings.Contains(host, ".") {
+		return errPrivateTarget // single-label names resolve via the cluster search path
+	}
+	for _, suffix := range []string{".localhost", ".local", ".internal", ".svc", ".cluster.local"} {
+		if strings.HasSuffix(host, suffix) {
+			return errPrivateTarget
+		}
+	}
+	return nil
+}
+
+// newHTTPClient returns the client for webhook and Slack deliveries. Unless
+// private targets are allowed, every connection (including redirects) is
+// checked after DNS resolution, and proxies are disabled since they would
+// dial on our behalf.
+func newHTTPClient(allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		transport.Proxy = nil
+		dialer := &net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				addr, err := netip.ParseAddr(host)
+				if err != nil || !publicAddr(addr) {
+					return errPrivateTarget
+				}
+				return nil
+			},
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	return &http.Client{Timeout: 10 * time.Second, Transport: transport}
+}
+
+// subject returns the one-line summary used by Slack and email.
+func subject(ev Event) string {
+	if ev.AgentName != "" {
+		return fmt.Sprintf("[%s] %s", ev.AgentName, ev.Title)
+	}
+	return ev.Title
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sac-notifier")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(excerpt)))
+	}
+	return nil
+}
+
+// sendWebhook POSTs the event as JSON. With a secret, the body is signed as
+// X-Signature: sha256=<hex hmac>, the same scheme inbound webhooks accept.
+func sendWebhook(ctx context.Context, client *http.Client, url, secret string, ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{"X-SAC-Event": ev.Type}
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		headers["X-Signature"] = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	return postJSON(ctx, client, url, body, headers)
+}
+
+// sendSlack POSTs a Slack-compatible incoming webhook payload. Mattermost,
+// Rocket.Chat and most chat tools accept the same {"text": ...} shape.
+func sendSlack(ctx context.Context, client *http.Client, url string, ev Event) error {
+	text := "*" + subject(ev) + "*"
+	if ev.Message != "" {
+		text += "\n" + ev.Message
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, client, url, body, nil)
+}
+
+type smtpConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// loadSMTPConfig reads the smtp_* system settings.
+func loadSMTPConfig(ctx context.Context, db *bun.DB) (*smtpConfig, error) {
+	var settings []models.SystemSetting
+	err := db.NewSelect().Model(&settings).
+		Where("key IN (?)", bun.In([]string{"smtp_host", "smtp_port", "smtp_username", "smtp_password", "smtp_from"})).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load SMTP settings: %w", err)
+	}
+
+	cfg := &smtpConfig{Port: "587"}
+	for _, s := range settings {
+		var val string
+		if err := json.Unmarshal([]byte(s.Value), &val); err != nil {
+			val = strings.Trim(string(s.Value), "\"")
+		}
+		switch s.Key {
+		case "smtp_host":
+			cfg.Host = val
+		case "smtp_port":
+			if val != "" {
+				cfg.Port = val
+			}
+		case "smtp_username":
+			cfg.Username = val
+		case "smtp_password":
+			cfg.Password = val
+		case "smtp_from":
+			cfg.From = val
+		}
+	}
+	if cfg.Host == "" || cfg.From == "" {
+		return nil, errSMTPNotConfigured
+	}
+	return cfg, nil
+}
+
+func buildEmail(from, to string, ev Event) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "[SAC] "+subject(ev)))
+	fmt.Fprintf(&b, "Date: %s\r\n", ev.Time.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+
+	body := ev.Message
+	if body == "" {
+		body = ev.Title
+	}
+	body += fmt.Sprintf("\n\nEvent: %s\nTime: %s\n", ev.Type, ev.Time.Format(time.RFC3339))
+	// net/smtp's DATA writer converts line endings and dot-stuffs.
+	b.WriteString(body)
+	return []byte(b.String())
+}
+
+// sendEmail delivers one message. Port 465 uses implicit TLS; other ports
+// upgrade with STARTTLS when the server offers it, so a plain local SMTP
+// stand-in (MailHog, smtp4dev) works without credentials.
+func sendEmail(ctx context.Context, cfg *smtpConfig, to string, ev Event) error {
+	addr := net.JoinHostPort(cfg.Host, cfg.Port)
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+
+	var conn net.Conn
+	var err error
+	if cfg.Port == "465" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: cfg.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("smtp dial: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if cfg.Port != "465" {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: cfg.Host}); err != nil {
+				return fmt.Errorf("smtp starttls: %w", err)
+			}
+		}
+	}
+	if cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(cfg.From); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp RCPT TO: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(buildEmail(cfg.From, to, ev)); err != nil {
+		return fmt.Errorf("smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,232 @@
+package notify
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+)
+
+const maxChannelsPerUser = 20
+
+// Server implements NotificationServiceServer for per-user channel management.
+type Server struct {
+	sacv1.UnimplementedNotificationServiceServer
+	db       *bun.DB
+	notifier *Notifier
+}
+
+func NewServer(db *bun.DB, notifier *Notifier) *Server {
+	return &Server{db: db, notifier: notifier}
+}
+
+func validEventType(t string) bool {
+	for _, e := range EventTypes {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// validate normalizes the channel and rejects invalid settings.
+func validate(ch *models.NotificationChannel, allowPrivate bool) error {
+	if ch.Name == "" {
+		return grpcerr.BadRequest("name is required")
+	}
+
+	ch.Target = strings.TrimSpace(ch.Target)
+	switch ch.Type {
+	case models.NotificationChannelWebhook, models.NotificationChannelSlack:
+		u, err := url.Parse(ch.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return grpcerr.BadRequest("target must be an http(s) URL")
+		}
+		if !allowPrivate {
+			if err := checkTargetHost(u.Hostname()); err != nil {
+				return grpcerr.BadRequest(err.Error())
+			}
+		}
+	case models.NotificationChannelEmail:
+		addr, err := mail.ParseAddress(ch.Target)
+		if err != nil {
+			return grpcerr.BadRequest("target must be an email address")
+		}
+		ch.Target = addr.Address
+	default:
+		return grpcerr.BadRequest("type must be one of webhook, email, slack")
+	}
+	if ch.Secret != "" && ch.Type != models.NotificationChannelWebhook {
+		return grpcerr.BadRequest("secret is only supported for webhook channels")
+	}
+
+	events := make([]string, 0, len(ch.Events))
+	for _, e := range ch.Events {
+		if e = strings.TrimSpace(e); e == "" {
+			continue
+		}
+		if !validEventType(e) {
+			return grpcerr.BadRequest("unknown event type: " + e)
+		}
+		events = append(events, e)
+	}
+	ch.Events = events
+	return nil
+}
+
+func (s *Server) getChannel(ctx context.Context, id, userID int64) (*models.NotificationChannel, error) {
+	ch := new(models.NotificationChannel)
+	err := s.db.NewSelect().Model(ch).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Notification channel not found")
+	}
+	return ch, nil
+}
+
+func (s *Server) CreateNotificationChannel(ctx context.Context, req *sacv1.CreateNotificationChannelRequest) (*sacv1.NotificationChannel, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	count, err := s.db.NewSelect().Model((*models.NotificationChannel)(nil)).
+		Where("user_id = ?", userID).
+		Count(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to count notification channels", err)
+	}
+	if count >= maxChannelsPerUser {
+		return nil, grpcerr.BadRequest("too many notification channels")
+	}
+
+	ch := &models.NotificationChannel{
+		UserID:  userID,
+		Name:    strings.TrimSpace(req.Name),
+		Type:    req.Type,
+		Target:  req.Target,
+		Secret:  req.Secret,
+		Events:  req.Events,
+		Enabled: true,
+	}
+	if err := validate(ch, s.notifier.privateTargetsAllowed()); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.NewInsert().Model(ch).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create notification channel", err)
+	}
+
+	return convert.NotificationChannelToProto(ch), nil
+}
+
+func (s *Server) ListNotificationChannels(ctx context.Context, _ *sacv1.Empty) (*sacv1.NotificationChannelListResponse, error) {
+	var channels []models.NotificationChannel
+	err := s.db.NewSelect().Model(&channels).
+		Where("user_id = ?", ctxkeys.UserID(ctx)).
+		OrderExpr("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list notification channels", err)
+	}
+
+	return &sacv1.NotificationChannelListResponse{
+		Channels:   convert.NotificationChannelsToProto(channels),
+		EventTypes: EventTypes,
+	}, nil
+}
+
+func (s *Server) UpdateNotificationChannel(ctx context.Context, req *sacv1.UpdateNotificationChannelRequest) (*sacv1.NotificationChannel, error) {
+	ch, err := s.getChannel(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Dynamic columns: only update fields that were actually provided
+	columns := []string{"updated_at"}
+
+	if req.Name != nil {
+		ch.Name = strings.TrimSpace(*req.Name)
+		columns = append(columns, "name")
+	}
+	if req.Target != nil {
+		ch.Target = *req.Target
+		columns = append(columns, "target")
+	}
+	if req.Secret != nil {
+		ch.Secret = *req.Secret
+		columns = append(columns, "secret")
+	}
+	if req.Events != nil {
+		ch.Events = req.Events
+		columns = append(columns, "events")
+	}
+	if req.Enabled != nil {
+		ch.Enabled = *req.Enabled
+		columns = append(columns, "enabled")
+	}
+
+	if err := validate(ch, s.notifier.privateTargetsAllowed()); err != nil {
+		return nil, err
+	}
+	ch.UpdatedAt = time.Now()
+
+	_, err = s.db.NewUpdate().
+		Model(ch).
+		Column(columns...).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update notification channel", err)
+	}
+
+	return convert.NotificationChannelToProto(ch), nil
+}
+
+func (s *Server) DeleteNotificationChannel(ctx context.Context, req *sacv1.NotificationChannelByIdRequest) (*sacv1.SuccessMessage, error) {
+	res, err := s.db.NewDelete().
+		Model((*models.NotificationChannel)(nil)).
+		Where("id = ?", req.Id).
+		Where("user_id = ?", ctxkeys.UserID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to delete notification channel", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Notification channel not found")
+	}
+
+	return &sacv1.SuccessMessage{Message: "Notification channel deleted"}, nil
+}
+
+// TestNotificationChannel sends a test event to one channel, regardless of
+// its enabled flag and event filter, and reports the delivery error.
+func (s *Server) TestNotificationChannel(ctx context.Context, req *sacv1.NotificationChannelByIdRequest) (*sacv1.SuccessMessage, error) {
+	ch, err := s.getChannel(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	ev := Event{
+		Type:    EventTest,
+		UserID:  ch.UserID,
+		Title:   "Test notification",
+		Message: "This is a test notification for channel \"" + ch.Name + "\".",
+		Time:    time.Now(),
+	}
+	if err := s.notifier.sendAndRecord(sendCtx, ch, ev); err != nil {
+		return nil, grpcerr.BadRequest("Delivery failed: " + err.Error())
+	}
+
+	return &sacv1.SuccessMessage{Message: "Test notification sent"}, nil
+}
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const idleReaperInterval = time.Minute
+
+// StartIdleReaper periodically stops sessions that had no terminal input for
+// longer than their owner's idle timeout. last_active is kept fresh by the
+// ws-proxy on input. Safe to run on every gateway replica: each session is
+// claimed with a conditional update before it is stopped.
+func (s *Server) StartIdleReaper(ctx context.Context) {
+	ticker := time.NewTicker(idleReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.ReapIdleSessions(ctx)
+		}
+	}
+}
+
+// ReapIdleSessions runs one reaper pass and returns the number of sessions
+// this replica marked idle.
+func (s *Server) ReapIdleSessions(ctx context.Context) int {
+	now := time.Now()
+
+	var sessions []models.Session
+	err := s.db.NewSelect().
+		Model(&sessions).
+		Where("status = ?", models.SessionStatusRunning).
+		Where("last_active < ?", now.Add(-idleReaperInterval)).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("idle-reaper: failed to list sessions")
+		return 0
+	}
+
+	reaped := 0
+	configs := make(map[int64]admin.IdleConfig)
+	for i := range sessions {
+		sess := &sessions[i]
+
+		cfg, ok := configs[sess.UserID]
+		if !ok {
+			cfg = s.settingsService.GetIdleConfig(ctx, sess.UserID)
+			configs[sess.UserID] = cfg
+		}
+		if cfg.Timeout == 0 || now.Sub(sess.LastActive) < cfg.Timeout {
+			continue
+		}
+
+		// Headless tasks run claude in the same pod without terminal input.
+		busy, _ := s.db.NewSelect().
+			Model((*models.AgentTask)(nil)).
+			Where("user_id = ? AND agent_id = ?", sess.UserID, sess.AgentID).
+			Where("status IN (?)", bun.In([]models.TaskStatus{models.TaskStatusPending, models.TaskStatusRunning})).
+			Exists(ctx)
+		if busy {
+			continue
+		}
+
+		res, err := s.db.NewUpdate().
+			Model((*models.Session)(nil)).
+			Set("status = ?", models.SessionStatusIdle).
+			Set("updated_at = ?", now).
+			Where("id = ?", sess.ID).
+			Where("status = ?", models.SessionStatusRunning).
+			Where("last_active = ?", sess.LastActive).
+			Exec(ctx)
+		if err != nil {
+			log.Warn().Err(err).Str("session_id", sess.SessionID).Msg("idle-reaper: failed to mark session idle")
+			continue
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue // activity in the meantime, or another replica took it
+		}
+		reaped++
+
+		log.Info().Str("session_id", sess.SessionID).Int64("agent_id", sess.AgentID).
+			Dur("idle", now.Sub(sess.LastActive)).Str("action", cfg.Action).Msg("idle-reaper: session idle")
+		if err := s.stopIdleSession(ctx, sess, cfg.Action); err != nil {
+			log.Warn().Err(err).Str("session_id", sess.SessionID).Msg("idle-reaper: failed to stop session")
+		}
+	}
+	return reaped
+}
+
+func (s *Server) stopIdleSession(ctx context.Context, sess *models.Session, action string) error {
+	userIDStr := fmt.Sprintf("%d", sess.UserID)
+	switch action {
+	case admin.IdleActionStopProcess:
+		return s.containerManager.StopClaudeCodeProcess(ctx, fmt.Sprintf("claude-code-%s-%d-0", userIDStr, sess.AgentID))
+	case admin.IdleActionScaleDown:
+		return s.containerManager.ScaleStatefulSet(ctx, userIDStr, sess.AgentID, 0)
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// schedulerInterval is how often the in-process scheduler looks for due jobs.
+const schedulerInterval = 30 * time.Second
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// nextJobRun returns the first fire time of a cron schedule after the given
+// instant, evaluated in the job's timezone.
+func nextJobRun(schedule, timezone string, after time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone %q", timezone)
+	}
+	sched, err := cronParser.Parse(schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule: %w", err)
+	}
+	return sched.Next(after.In(loc)), nil
+}
+
+func (s *Server) CreateJob(ctx context.Context, req *sacv1.CreateJobRequest) (*sacv1.AgentJob, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	job := &models.AgentJob{
+		UserID:         userID,
+		AgentID:        req.AgentId,
+		Name:           strings.TrimSpace(req.Name),
+		Schedule:       strings.TrimSpace(req.Schedule),
+		Timezone:       req.Timezone,
+		Prompt:         strings.TrimSpace(req.Prompt),
+		SkillID:        req.SkillId,
+		SkillArgs:      req.SkillArgs,
+		AllowedTools:   models.TrimAllowedTools(req.AllowedTools),
+		TimeoutSeconds: int(req.TimeoutSeconds),
+		Enabled:        req.Enabled,
+	}
+	if job.Name == "" {
+		return nil, grpcerr.BadRequest("name is required")
+	}
+	if job.Timezone == "" {
+		job.Timezone = "UTC"
+	}
+	if job.SkillID != nil && *job.SkillID <= 0 {
+		job.SkillID = nil
+	}
+	if err := s.validateJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.NewInsert().Model(job).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create job", err)
+	}
+
+	return convert.AgentJobToProto(job), nil
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *sacv1.ListJobsRequest) (*sacv1.JobListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	query := s.db.NewSelect().
+		Model((*models.AgentJob)(nil)).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC")
+	if req.AgentId > 0 {
+		query = query.Where("agent_id = ?", req.AgentId)
+	}
+
+	var jobs []models.AgentJob
+	if err := query.Scan(ctx, &jobs); err != nil {
+		return nil, grpcerr.Internal("Failed to list jobs", err)
+	}
+
+	return &sacv1.JobListResponse{Jobs: convert.AgentJobsToProto(jobs)}, nil
+}
+
+func (s *Server) GetJob(ctx context.Context, req *sacv1.JobByIdRequest) (*sacv1.AgentJob, error) {
+	job, err := s.getJob(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return convert.AgentJobToProto(job), nil
+}
+
+func (s *Server) UpdateJob(ctx context.Context, req *sacv1.UpdateJobRequest) (*sacv1.AgentJob, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	job, err := s.getJob(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dynamic columns: only update fields that were actually provided
+	columns := []string{"updated_at", "next_run_at"}
+
+	if req.Name != nil {
+		job.Name = strings.TrimSpace(*req.Name)
+		if job.Name == "" {
+			return nil, grpcerr.BadRequest("name must not be empty")
+		}
+		columns = append(columns, "name")
+	}
+	if req.Schedule != nil {
+		job.Schedule = strings.TrimSpace(*req.Schedule)
+		columns = append(columns, "schedule")
+	}
+	if req.Timezone != nil {
+		job.Timezone = *req.Timezone
+		if job.Timezone == "" {
+			job.Timezone = "UTC"
+		}
+		columns = append(columns, "timezone")
+	}
+	if req.Prompt != nil {
+		job.Prompt = strings.TrimSpace(*req.Prompt)
+		columns = append(columns, "prompt")
+	}
+	if req.SkillId != nil {
+		job.SkillID = req.SkillId
+		if *req.SkillId <= 0 {
+			job.SkillID = nil
+		}
+		columns = append(columns, "skill_id")
+	}
+	if req.SkillArgs != nil {
+		job.SkillArgs = *req.SkillArgs
+		columns = append(columns, "skill_args")
+	}
+	if req.AllowedTools != nil {
+		job.AllowedTools = models.TrimAllowedTools(req.AllowedTools)
+		columns = append(columns, "allowed_tools")
+	}
+	if req.TimeoutSeconds != nil {
+		job.TimeoutSeconds = int(*req.TimeoutSeconds)
+		columns = append(columns, "timeout_seconds")
+	}
+	if req.Enabled != nil {
+		job.Enabled = *req.Enabled
+		columns = append(columns, "enabled")
+	}
+
+	if err := s.validateJob(ctx, job); err != nil {
+		return nil, err
+	}
+	job.UpdatedAt = time.Now()
+
+	_, err = s.db.NewUpdate().
+		Model(job).
+		Column(columns...).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update job", err)
+	}
+
+	return convert.AgentJobToProto(job), nil
+}
+
+func (s *Server) DeleteJob(ctx context.Context, req *sacv1.JobByIdRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	res, err := s.db.NewDelete().
+		Model((*models.AgentJob)(nil)).
+		Where("id = ?", req.Id).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to delete job", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Job not found")
+	}
+
+	return &sacv1.SuccessMessage{Message: "Job deleted"}, nil
+}
+
+// RunJobNow dispatches a job immediately without touching its schedule.
+func (s *Server) RunJobNow(ctx context.Context, req *sacv1.JobByIdRequest) (*sacv1.AgentTask, error) {
+	job, err := s.getJob(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.dispatchJob(ctx, job)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to run job", err)
+	}
+
+	_, _ = s.db.NewUpdate().
+		Model((*models.AgentJob)(nil)).
+		Set("last_run_at = ?", time.Now()).
+		Where("id = ?", job.ID).
+		Exec(ctx)
+
+	return convert.AgentTaskToProto(task), nil
+}
+
+func (s *Server) ListJobRuns(ctx context.Context, req *sacv1.ListJobRunsRequest) (*sacv1.TaskListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	if _, err := s.getJob(ctx, req.Id, userID); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	var tasks []models.AgentTask
+	err := s.db.NewSelect().
+		Model(&tasks).
+		Where("job_id = ?", req.Id).
+		OrderExpr("created_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list job runs", err)
+	}
+
+	return &sacv1.TaskListResponse{Tasks: convert.AgentTasksToProto(tasks)}, nil
+}
+
+func (s *Server) getJob(ctx context.Context, id, userID int64) (*models.AgentJob, error) {
+	var job models.AgentJob
+	err := s.db.NewSelect().
+		Model(&job).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Job not found", err)
+	}
+	return &job, nil
+}
+
+// validateJob checks a job's target and schedule and recomputes NextRunAt.
+func (s *Server) validateJob(ctx context.Context, job *models.AgentJob) error {
+	exists, err := s.db.NewSelect().
+		Model((*models.Agent)(nil)).
+		Where("id = ?", job.AgentID).
+		Where("created_by = ?", job.UserID).
+		Exists(ctx)
+	if err != nil {
+		return grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return grpcerr.NotFound("Agent not found")
+	}
+
+	if job.SkillID == nil && job.Prompt == "" {
+		return grpcerr.BadRequest("prompt or skill_id is required")
+	}
+	if job.SkillID != nil {
+		installed, err := s.db.NewSelect().
+			Model((*models.AgentSkill)(nil)).
+			Where("agent_id = ?", job.AgentID).
+			Where("skill_id = ?", *job.SkillID).
+			Exists(ctx)
+		if err != nil {
+			return grpcerr.Internal("Failed to check installed skills", err)
+		}
+		if !installed {
+			return grpcerr.BadRequest("skill is not installed on this agent")
+		}
+	}
+
+	if job.TimeoutSeconds == 0 {
+		job.TimeoutSeconds = defaultTaskTimeout
+	}
+	if job.TimeoutSeconds < 0 || job.TimeoutSeconds > maxTaskTimeout {
+		return grpcerr.BadRequest(fmt.Sprintf("timeout_seconds must be between 1 and %d", maxTaskTimeout))
+	}
+
+	next, err := nextJobRun(job.Schedule, job.Timezone, time.Now())
+	if err != nil {
+		return grpcerr.BadRequest(err.Error())
+	}
+	if job.Enabled {
+		job.NextRunAt = &next
+	} else {
+		job.NextRunAt = nil
+	}
+	return nil
+}
+
+// jobPrompt resolves what a job sends to Claude Code: either its prompt, or
+// the slash command of its skill followed by the configured arguments.
+func (s *Server) jobPrompt(ctx context.Context, job *models.AgentJob) (string, error) {
+	if job.SkillID == nil {
+		return job.Prompt, nil
+	}
+
+	var sk models.Skill
+	err := s.db.NewSelect().
+		Model(&sk).
+		Column("command_name").
+		Where("id = ?", *job.SkillID).
+		Scan(ctx)
+	if err != nil {
+		return "", fmt.Errorf("skill %d not found: %w", *job.SkillID, err)
+	}
+	return strings.TrimSpace("/" + sk.CommandName + " " + job.SkillArgs), nil
+}
+
+// dispatchJob starts one run of a job.
+func (s *Server) dispatchJob(ctx context.Context, job *models.AgentJob) (*models.AgentTask, error) {
+	task := &models.AgentTask{
+		UserID:         job.UserID,
+		AgentID:        job.AgentID,
+		JobID:          &job.ID,
+		AllowedTools:   job.AllowedTools,
+		TimeoutSeconds: job.TimeoutSeconds,
+	}
+
+	prompt, err := s.jobPrompt(ctx, job)
+	if err != nil {
+		task.Prompt = job.Prompt
+		return s.recordFailedTask(ctx, task, err.Error())
+	}
+	task.Prompt = prompt
+
+	return s.DispatchTask(ctx, task)
+}
+
+// StartScheduler runs the in-process job scheduler until ctx is cancelled.
+// Every gateway replica runs one; a due job is claimed with a conditional
+// update on next_run_at so exactly one replica dispatches each run.
+func (s *Server) StartScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunDueJobs(ctx)
+		}
+	}
+}
+
+// RunDueJobs claims and dispatches every job whose next run is due and
+// returns how many runs this replica dispatched.
+func (s *Server) RunDueJobs(ctx context.Context) int {
+	now := time.Now()
+	dispatched := 0
+
+	var jobs []models.AgentJob
+	err := s.db.NewSelect().
+		Model(&jobs).
+		Where("enabled = ?", true).
+		Where("next_run_at <= ?", now).
+		OrderExpr("next_run_at ASC").
+		Limit(100).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("scheduler: failed to list due jobs")
+		return 0
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+
+		next, err := nextJobRun(job.Schedule, job.Timezone, now)
+		if err != nil {
+			log.Warn().Err(err).Int64("job_id", job.ID).Msg("scheduler: disabling job with invalid schedule")
+			_, _ = s.db.NewUpdate().
+				Model((*models.AgentJob)(nil)).
+				Set("enabled = ?", false).
+				Set("next_run_at = NULL").
+				Where("id = ?", job.ID).
+				Exec(ctx)
+			continue
+		}
+
+		res, err := s.db.NewUpdate().
+			Model((*models.AgentJob)(nil)).
+			Set("next_run_at = ?", next).
+			Set("last_run_at = ?", now).
+			Where("id = ?", job.ID).
+			Where("next_run_at = ?", job.NextRunAt).
+			Exec(ctx)
+		if err != nil {
+			log.Warn().Err(err).Int64("job_id", job.ID).Msg("scheduler: failed to claim job")
+			continue
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue // another replica claimed it
+		}
+
+		task, err := s.dispatchJob(ctx, job)
+		if err != nil {
+			log.Error().Err(err).Int64("job_id", job.ID).Msg("scheduler: failed to dispatch job")
+			continue
+		}
+		dispatched++
+		log.Info().Int64("job_id", job.ID).Str("task_id", task.TaskID).Str("status", string(task.Status)).Msg("scheduler: job dispatched")
+	}
+	return dispatched
+}
@@ -10,6 +10,7 @@ import (
 
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/claudesettings"
 	"g.echo.tech/dev/sac/internal/container"
 	"g.echo.tech/dev/sac/internal/convert"
 	"g.echo.tech/dev/sac/internal/ctxkeys"
@@ -19,6 +20,7 @@ import (
 	"g.echo.tech/dev/sac/internal/storage"
 	"g.echo.tech/dev/sac/internal/workspace"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/uptrace/bun"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -30,18 +32,26 @@ type Server struct {
 	syncService      *skill.SyncService
 	settingsService  *admin.SettingsService
 	storageProvider  *storage.StorageProvider
+	taskHub          *TaskHub      // nil if Redis is not configured
+	rdb              *redis.Client // nil if Redis is not configured
 }
 
-func NewServer(db *bun.DB, containerManager *container.Manager, syncService *skill.SyncService, settingsService *admin.SettingsService, storageProvider *storage.StorageProvider) *Server {
+func NewServer(db *bun.DB, containerManager *container.Manager, syncService *skill.SyncService, settingsService *admin.SettingsService, storageProvider *storage.StorageProvider, taskHub *TaskHub) *Server {
 	return &Server{
 		db:               db,
 		containerManager: containerManager,
 		syncService:      syncService,
 		settingsService:  settingsService,
 		storageProvider:  storageProvider,
+		taskHub:          taskHub,
 	}
 }
 
+// SetRedis lets revoked share grants reach the WebSocket proxy right away.
+func (s *Server) SetRedis(rdb *redis.Client) {
+	s.rdb = rdb
+}
+
 func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequest) (*sacv1.CreateSessionResponse, error) {
 	userID := ctxkeys.UserID(ctx)
 	userIDStr := fmt.Sprintf("%d", userID)
@@ -79,6 +89,13 @@ func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequ
 
 			log.Info().Str("session_id", existing.SessionID).Int64("agent_id", req.AgentId).Str("pod_ip", podIP).Msg("reusing existing session")
 
+			// Claude Code was stopped by the idle reaper; let the loop resume it.
+			if existing.Status == models.SessionStatusIdle {
+				if err := s.containerManager.ResumeClaudeCodeProcess(ctx, fmt.Sprintf("claude-code-%s-%d-0", userIDStr, req.AgentId)); err != nil {
+					log.Warn().Err(err).Str("session_id", existing.SessionID).Msg("failed to resume idle session")
+				}
+			}
+
 			return &sacv1.CreateSessionResponse{
 				SessionId: existing.SessionID,
 				Status:    string(models.SessionStatusRunning),
@@ -129,6 +146,7 @@ func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequ
 
 	// Check if StatefulSet exists
 	isNewStatefulSet := false
+	freshPod := false // pod has no synced state yet (new or scaled up from zero)
 	sts, err := s.containerManager.GetStatefulSet(ctx, userIDStr, req.AgentId)
 	if err != nil {
 		isNewStatefulSet = true
@@ -163,8 +181,21 @@ func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequ
 		if err := s.containerManager.WaitForStatefulSetReady(ctx, userIDStr, req.AgentId, 60, 5*time.Second); err != nil {
 			log.Warn().Err(err).Msg("waiting for pod readiness")
 		}
+		freshPod = true
 	} else {
 		log.Info().Str("name", sts.Name).Msg("using existing StatefulSet")
+
+		// Scaled to zero by the idle reaper: bring the pod back. It starts
+		// with empty skills/output dirs, so restore it like a new one.
+		if sts.Spec.Replicas != nil && *sts.Spec.Replicas == 0 {
+			if err := s.containerManager.ScaleStatefulSet(ctx, userIDStr, req.AgentId, 1); err != nil {
+				return nil, grpcerr.Internal("Failed to start agent pod", err)
+			}
+			if err := s.containerManager.WaitForStatefulSetReady(ctx, userIDStr, req.AgentId, 60, 5*time.Second); err != nil {
+				log.Warn().Err(err).Msg("waiting for pod readiness")
+			}
+			freshPod = true
+		}
 	}
 
 	podIP, err := s.containerManager.GetStatefulSetPodIP(ctx, userIDStr, req.AgentId)
@@ -172,17 +203,23 @@ func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequ
 		return nil, grpcerr.Internal("Failed to get Pod IP, pod may not be ready", err)
 	}
 
-	if isNewStatefulSet {
+	if freshPod {
 		if err := s.syncService.SyncAllSkillsToAgent(ctx, userIDStr, req.AgentId); err != nil {
 			log.Warn().Err(err).Int64("agent_id", req.AgentId).Msg("failed to sync skills")
 		}
 		s.writeClaudeMD(ctx, userIDStr, req.AgentId, agent.Instructions)
+		if err := claudesettings.WriteToPod(ctx, s.db, s.containerManager, &agent); err != nil {
+			log.Warn().Err(err).Int64("agent_id", req.AgentId).Msg("failed to write claude settings")
+		}
 		if err := workspace.RestoreOutputFiles(ctx, s.db, s.storageProvider, s.containerManager, userID, req.AgentId); err != nil {
 			log.Warn().Err(err).Int64("agent_id", req.AgentId).Msg("failed to restore output files")
 		}
+		if err := workspace.RestoreInputFiles(ctx, s.db, s.storageProvider, s.containerManager, userID, req.AgentId); err != nil {
+			log.Warn().Err(err).Int64("agent_id", req.AgentId).Msg("failed to restore input files")
+		}
 	} else {
 		// Existing pod: only sync skills + CLAUDE.md in background.
-		// Skip RestoreOutputFiles — output files are already on the pod
+		// Skip RestoreOutputFiles/RestoreInputFiles — files are already on the pod
 		// (they originate from the pod and are uploaded to S3 by the sidecar).
 		go func() {
 			bgCtx := context.Background()
@@ -190,6 +227,9 @@ func (s *Server) CreateSession(ctx context.Context, req *sacv1.CreateSessionRequ
 				log.Warn().Err(err).Msg("background skill sync failed")
 			}
 			s.writeClaudeMD(bgCtx, userIDStr, req.AgentId, agent.Instructions)
+			if err := claudesettings.WriteToPod(bgCtx, s.db, s.containerManager, &agent); err != nil {
+				log.Warn().Err(err).Msg("background claude settings write failed")
+			}
 			log.Debug().Str("user_id", userIDStr).Int64("agent_id", req.AgentId).Msg("background sync completed")
 		}()
 	}
@@ -277,6 +317,12 @@ func (s *Server) DeleteSession(ctx context.Context, req *sacv1.GetSessionRequest
 		return nil, grpcerr.Internal("Failed to delete session", err)
 	}
 
+	// Shares are meaningless once the session is gone.
+	_, _ = s.db.NewDelete().
+		Model((*models.SessionShare)(nil)).
+		Where("session_id = ?", session.SessionID).
+		Exec(ctx)
+
 	return &sacv1.SuccessMessage{Message: "Session deleted successfully"}, nil
 }
 
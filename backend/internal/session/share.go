@@ -0,0 +1,234 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// getOwnedSession loads a non-deleted session owned by userID.
+func (s *Server) getOwnedSession(ctx context.Context, sessionID string, userID int64) (*models.Session, error) {
+	var session models.Session
+	err := s.db.NewSelect().
+		Model(&session).
+		Where("session_id = ?", sessionID).
+		Where("user_id = ?", userID).
+		Where("status != ?", models.SessionStatusDeleted).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Session not found", err)
+	}
+	return &session, nil
+}
+
+func (s *Server) CreateSessionShare(ctx context.Context, req *sacv1.CreateSessionShareRequest) (*sacv1.SessionShare, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	session, err := s.getOwnedSession(ctx, req.SessionId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := models.SessionShareMode(req.Mode)
+	if mode == "" {
+		mode = models.SessionShareReadOnly
+	}
+	if mode != models.SessionShareReadOnly && mode != models.SessionShareReadWrite {
+		return nil, grpcerr.BadRequest("mode must be read_only or read_write")
+	}
+	if req.ExpiresInSeconds < 0 {
+		return nil, grpcerr.BadRequest("expires_in_seconds must not be negative")
+	}
+
+	// Deduplicate recipients and drop the owner, who always has access.
+	seen := make(map[int64]bool)
+	userIDs := make([]int64, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		if id <= 0 || id == userID || seen[id] {
+			continue
+		}
+		seen[id] = true
+		userIDs = append(userIDs, id)
+	}
+	if len(userIDs) == 0 && req.GroupId == nil {
+		return nil, grpcerr.BadRequest("user_ids or group_id is required")
+	}
+
+	if len(userIDs) > 0 {
+		count, err := s.db.NewSelect().
+			Model((*models.User)(nil)).
+			Where("id IN (?)", bun.In(userIDs)).
+			Count(ctx)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to verify users", err)
+		}
+		if count != len(userIDs) {
+			return nil, grpcerr.BadRequest("one or more users not found")
+		}
+	}
+
+	if req.GroupId != nil {
+		isMember, err := s.db.NewSelect().
+			Model((*models.GroupMember)(nil)).
+			Where("group_id = ?", *req.GroupId).
+			Where("user_id = ?", userID).
+			Exists(ctx)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to verify group membership", err)
+		}
+		if !isMember {
+			return nil, grpcerr.Forbidden("Not a member of this group")
+		}
+	}
+
+	share := &models.SessionShare{
+		SessionID: session.SessionID,
+		OwnerID:   userID,
+		Mode:      mode,
+		UserIDs:   userIDs,
+		GroupID:   req.GroupId,
+	}
+	if req.ExpiresInSeconds > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.ExpiresInSeconds) * time.Second)
+		share.ExpiresAt = &expiresAt
+	}
+
+	if _, err := s.db.NewInsert().Model(share).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create session share", err)
+	}
+
+	return convert.SessionShareToProto(share), nil
+}
+
+func (s *Server) ListSessionShares(ctx context.Context, req *sacv1.GetSessionRequest) (*sacv1.SessionShareListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	if _, err := s.getOwnedSession(ctx, req.SessionId, userID); err != nil {
+		return nil, err
+	}
+
+	var shares []models.SessionShare
+	err := s.db.NewSelect().
+		Model(&shares).
+		Where("session_id = ?", req.SessionId).
+		Where("owner_id = ?", userID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list session shares", err)
+	}
+
+	return &sacv1.SessionShareListResponse{Shares: convert.SessionSharesToProto(shares)}, nil
+}
+
+func (s *Server) RevokeSessionShare(ctx context.Context, req *sacv1.RevokeSessionShareRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	res, err := s.db.NewDelete().
+		Model((*models.SessionShare)(nil)).
+		Where("id = ?", req.ShareId).
+		Where("session_id = ?", req.SessionId).
+		Where("owner_id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to revoke session share", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Share not found")
+	}
+
+	// Open terminals of the session drop the grant without waiting for the
+	// proxy's periodic access check.
+	if s.rdb != nil {
+		if err := s.rdb.Publish(ctx, websocket.ShareRevokedChannel, req.SessionId).Err(); err != nil {
+			log.Warn().Err(err).Str("session_id", req.SessionId).Msg("failed to publish share revocation")
+		}
+	}
+
+	return &sacv1.SuccessMessage{Message: "Share revoked"}, nil
+}
+
+// ListSharedSessions returns live sessions other users have shared with the
+// caller, either directly or through one of the caller's groups. When several
+// grants cover the same session the most permissive mode wins.
+func (s *Server) ListSharedSessions(ctx context.Context, _ *sacv1.Empty) (*sacv1.SharedSessionListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	var shares []models.SessionShare
+	err := s.db.NewSelect().
+		Model(&shares).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("? = ANY(user_ids)", userID).
+				WhereOr("group_id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID)
+		}).
+		Where("owner_id != ?", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list shared sessions", err)
+	}
+
+	best := make(map[string]*models.SessionShare)
+	for i := range shares {
+		sh := &shares[i]
+		cur, ok := best[sh.SessionID]
+		if !ok || (cur.Mode == models.SessionShareReadOnly && sh.Mode == models.SessionShareReadWrite) {
+			best[sh.SessionID] = sh
+		}
+	}
+	if len(best) == 0 {
+		return &sacv1.SharedSessionListResponse{Sessions: []*sacv1.SharedSession{}}, nil
+	}
+
+	sessionIDs := make([]string, 0, len(best))
+	for id := range best {
+		sessionIDs = append(sessionIDs, id)
+	}
+
+	var sessions []models.Session
+	err = s.db.NewSelect().
+		Model(&sessions).
+		Relation("User", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("id", "username", "display_name")
+		}).
+		Where("s.session_id IN (?)", bun.In(sessionIDs)).
+		Where("s.status IN (?)", bun.In([]models.SessionStatus{
+			models.SessionStatusRunning,
+			models.SessionStatusIdle,
+		})).
+		Order("s.last_active DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list shared sessions", err)
+	}
+
+	result := make([]*sacv1.SharedSession, 0, len(sessions))
+	for i := range sessions {
+		sess := &sessions[i]
+		share := best[sess.SessionID]
+		if share == nil || share.OwnerID != sess.UserID {
+			continue
+		}
+		item := &sacv1.SharedSession{
+			Session: convert.SessionToProto(sess),
+			Mode:    string(share.Mode),
+			Owner:   convert.UserBriefToProto(sess.User),
+		}
+		if share.ExpiresAt != nil {
+			item.ExpiresAt = timestamppb.New(*share.ExpiresAt)
+		}
+		result = append(result, item)
+	}
+
+	return &sacv1.SharedSessionListResponse{Sessions: result}, nil
+}
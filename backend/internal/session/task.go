@@ -0,0 +1,443 @@
+package session
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+const (
+	defaultTaskTimeout = 600  // seconds
+	maxTaskTimeout     = 3600 // seconds
+	taskOutputDir      = "/workspace/output"
+
+	// taskMaxLineSize bounds a single stream-json line from Claude Code.
+	taskMaxLineSize = 16 << 20
+	// taskStderrTail is how much trailing stderr is kept for error reporting.
+	taskStderrTail = 8 << 10
+	// taskStdoutTail is how much trailing stdout is stored with the task.
+	taskStdoutTail = 256 << 10
+)
+
+// RunTask starts a headless Claude Code run (`claude -p`) inside the agent's
+// pod and returns immediately with a pollable task. Progress is streamed over
+// SSE at /api/tasks/{task_id}/events.
+func (s *Server) RunTask(ctx context.Context, req *sacv1.RunTaskRequest) (*sacv1.AgentTask, error) {
+	userID := ctxkeys.UserID(ctx)
+	userIDStr := fmt.Sprintf("%d", userID)
+
+	if req.AgentId <= 0 {
+		return nil, grpcerr.BadRequest("agent_id is required")
+	}
+	prompt := strings.TrimSpace(req.Prompt)
+	if prompt == "" {
+		return nil, grpcerr.BadRequest("prompt is required")
+	}
+
+	timeout := int(req.TimeoutSeconds)
+	if timeout == 0 {
+		timeout = defaultTaskTimeout
+	}
+	if timeout < 0 || timeout > maxTaskTimeout {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("timeout_seconds must be between 1 and %d", maxTaskTimeout))
+	}
+
+	exists, err := s.db.NewSelect().
+		Model((*models.Agent)(nil)).
+		Where("id = ?", req.AgentId).
+		Where("created_by = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return nil, grpcerr.NotFound("Agent not found")
+	}
+
+	if _, err := s.containerManager.GetStatefulSetPodIP(ctx, userIDStr, req.AgentId); err != nil {
+		return nil, grpcerr.Unavailable("Agent pod is not running, create a session first")
+	}
+
+	task, err := s.startTask(ctx, &models.AgentTask{
+		UserID:         userID,
+		AgentID:        req.AgentId,
+		Prompt:         prompt,
+		AllowedTools:   models.TrimAllowedTools(req.AllowedTools),
+		TimeoutSeconds: timeout,
+	})
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to create task", err)
+	}
+
+	return convert.AgentTaskToProto(task), nil
+}
+
+// DispatchTask runs a task on behalf of a non-interactive trigger (scheduled
+// job, webhook). If the agent pod is not running the task is recorded as
+// failed instead, so the problem shows up in the trigger's history.
+func (s *Server) DispatchTask(ctx context.Context, task *models.AgentTask) (*models.AgentTask, error) {
+	if _, err := s.containerManager.GetStatefulSetPodIP(ctx, fmt.Sprintf("%d", task.UserID), task.AgentID); err != nil {
+		return s.recordFailedTask(ctx, task, "agent pod is not running")
+	}
+	return s.startTask(ctx, task)
+}
+
+// recordFailedTask stores a task that never started.
+func (s *Server) recordFailedTask(ctx context.Context, task *models.AgentTask, reason string) (*models.AgentTask, error) {
+	now := time.Now()
+	task.TaskID = uuid.New().String()
+	task.Status = models.TaskStatusFailed
+	task.Error = reason
+	task.FinishedAt = &now
+	if task.AllowedTools == nil {
+		task.AllowedTools = []string{}
+	}
+	task.OutputFiles = []string{}
+
+	if _, err := s.db.NewInsert().Model(task).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// startTask inserts a pending task and executes it in the background.
+func (s *Server) startTask(ctx context.Context, task *models.AgentTask) (*models.AgentTask, error) {
+	task.TaskID = uuid.New().String()
+	task.Status = models.TaskStatusPending
+	if task.AllowedTools == nil {
+		task.AllowedTools = []string{}
+	}
+	task.OutputFiles = []string{}
+
+	if _, err := s.db.NewInsert().Model(task).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	go s.executeTask(*task)
+	return task, nil
+}
+
+func (s *Server) GetTask(ctx context.Context, req *sacv1.GetTaskRequest) (*sacv1.AgentTask, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	task, err := s.getTask(ctx, req.TaskId, userID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.AgentTaskToProto(task), nil
+}
+
+func (s *Server) ListTasks(ctx context.Context, req *sacv1.ListTasksRequest) (*sacv1.TaskListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	query := s.db.NewSelect().
+		Model((*models.AgentTask)(nil)).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC").
+		Limit(limit)
+	if req.AgentId > 0 {
+		query = query.Where("agent_id = ?", req.AgentId)
+	}
+
+	var tasks []models.AgentTask
+	if err := query.Scan(ctx, &tasks); err != nil {
+		return nil, grpcerr.Internal("Failed to list tasks", err)
+	}
+
+	return &sacv1.TaskListResponse{Tasks: convert.AgentTasksToProto(tasks)}, nil
+}
+
+// CancelTask marks a pending or running task as cancelled and kills the
+// Claude Code process in the pod. Finished tasks are returned unchanged.
+func (s *Server) CancelTask(ctx context.Context, req *sacv1.GetTaskRequest) (*sacv1.AgentTask, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	task, err := s.getTask(ctx, req.TaskId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status.IsTerminal() {
+		return convert.AgentTaskToProto(task), nil
+	}
+
+	now := time.Now()
+	res, err := s.db.NewUpdate().
+		Model((*models.AgentTask)(nil)).
+		Set("status = ?", models.TaskStatusCancelled).
+		Set("error = ?", "cancelled by user").
+		Set("finished_at = ?", now).
+		Where("id = ?", task.ID).
+		Where("status IN (?)", bun.In([]models.TaskStatus{models.TaskStatusPending, models.TaskStatusRunning})).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to cancel task", err)
+	}
+
+	if n, _ := res.RowsAffected(); n > 0 {
+		if task.Status == models.TaskStatusRunning {
+			bgCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			s.killTaskProcess(bgCtx, task)
+		}
+		s.publishTask(task.TaskID, TaskEvent{Type: "done", Status: string(models.TaskStatusCancelled), Text: "cancelled by user"})
+	}
+
+	task, err = s.getTask(ctx, req.TaskId, userID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.AgentTaskToProto(task), nil
+}
+
+func (s *Server) getTask(ctx context.Context, taskID string, userID int64) (*models.AgentTask, error) {
+	var task models.AgentTask
+	err := s.db.NewSelect().
+		Model(&task).
+		Where("task_id = ?", taskID).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Task not found", err)
+	}
+	return &task, nil
+}
+
+func (s *Server) publishTask(taskID string, event TaskEvent) {
+	if s.taskHub == nil {
+		return
+	}
+	s.taskHub.Publish(context.Background(), taskID, event)
+}
+
+func taskPodName(task *models.AgentTask) string {
+	return fmt.Sprintf("claude-code-%d-%d-0", task.UserID, task.AgentID)
+}
+
+// taskMarker is the path prefix of the per-task marker files in the pod:
+// "<marker>.start" timestamps the run (used to find new output files) and
+// "<marker>.pid" holds the Claude Code PID for cancellation.
+func taskMarker(task *models.AgentTask) string {
+	return "/tmp/sac-task-" + task.TaskID
+}
+
+func (s *Server) killTaskProcess(ctx context.Context, task *models.AgentTask) {
+	marker := taskMarker(task)
+	cmd := []string{"sh", "-c", fmt.Sprintf("[ -f %[1]s.pid ] && kill $(cat %[1]s.pid) 2>/dev/null; true", marker)}
+	if _, stderr, err := s.containerManager.ExecInPod(ctx, taskPodName(task), cmd, nil); err != nil {
+		log.Warn().Err(err).Str("task_id", task.TaskID).Str("stderr", stderr).Msg("failed to kill task process")
+	}
+}
+
+// claudeResult is the final "result" event of `claude --output-format stream-json`.
+type claudeResult struct {
+	Type         string  `json:"type"`
+	Subtype      string  `json:"subtype"`
+	IsError      bool    `json:"is_error"`
+	Result       string  `json:"result"`
+	SessionID    string  `json:"session_id"`
+	NumTurns     int     `json:"num_turns"`
+	TotalCostUSD float64 `json:"total_cost_usd"`
+}
+
+// tailBuffer keeps the last max bytes written to it.
+type tailBuffer struct {
+	mu  sync.Mutex
+	buf []byte
+	max int
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > t.max {
+		t.buf = t.buf[len(t.buf)-t.max:]
+	}
+	return len(p), nil
+}
+
+func (t *tailBuffer) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return strings.TrimSpace(string(t.buf))
+}
+
+// executeTask runs a task to completion in the background. The final row
+// update is conditional on status=running so a concurrent CancelTask wins.
+func (s *Server) executeTask(task models.AgentTask) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(task.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	podName := taskPodName(&task)
+	marker := taskMarker(&task)
+
+	startedAt := time.Now()
+	res, err := s.db.NewUpdate().
+		Model((*models.AgentTask)(nil)).
+		Set("status = ?", models.TaskStatusRunning).
+		Set("started_at = ?", startedAt).
+		Where("id = ?", task.ID).
+		Where("status = ?", models.TaskStatusPending).
+		Exec(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("task_id", task.TaskID).Msg("failed to start task")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return // cancelled before it started
+	}
+	s.publishTask(task.TaskID, TaskEvent{Type: "status", Status: string(models.TaskStatusRunning)})
+	log.Info().Str("task_id", task.TaskID).Int64("agent_id", task.AgentID).Msg("task started")
+
+	// The wrapper records the start time and the PID (exec keeps the shell's
+	// PID for claude) before handing over, all from /workspace.
+	cmd := []string{
+		"sh", "-c", `marker=$1; shift; cd /workspace && touch "$marker.start" && echo $$ > "$marker.pid" && exec "$@"`,
+		"sh", marker,
+		"claude", "-p", task.Prompt, "--output-format", "stream-json", "--verbose",
+	}
+	if len(task.AllowedTools) > 0 {
+		cmd = append(cmd, "--allowedTools")
+		cmd = append(cmd, task.AllowedTools...)
+	}
+
+	pr, pw := io.Pipe()
+	stderr := &tailBuffer{max: taskStderrTail}
+	stdout := &tailBuffer{max: taskStdoutTail}
+
+	var result *claudeResult
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(pr)
+		scanner.Buffer(make([]byte, 64*1024), taskMaxLineSize)
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(line) == 0 {
+				continue
+			}
+			stdout.Write(line)
+			stdout.Write([]byte{'\n'})
+			if !json.Valid(line) {
+				s.publishTask(task.TaskID, TaskEvent{Type: "message", Text: string(line)})
+				continue
+			}
+			var r claudeResult
+			if json.Unmarshal(line, &r) == nil && r.Type == "result" {
+				result = &r
+			}
+			s.publishTask(task.TaskID, TaskEvent{Type: "message", Data: json.RawMessage(append([]byte(nil), line...))})
+		}
+		if err := scanner.Err(); err != nil {
+			log.Warn().Err(err).Str("task_id", task.TaskID).Msg("task output scan failed")
+		}
+		// Keep draining so the exec stream never blocks on a full pipe.
+		_, _ = io.Copy(io.Discard, pr)
+	}()
+
+	execErr := s.containerManager.ExecInPodStream(ctx, podName, cmd, nil, pw, stderr)
+	pw.Close()
+	<-done
+
+	// Post-run housekeeping runs on a fresh context: the task context may
+	// already be past its deadline.
+	bgCtx, bgCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer bgCancel()
+
+	status := models.TaskStatusCompleted
+	var errMsg string
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		status = models.TaskStatusTimeout
+		errMsg = fmt.Sprintf("task exceeded timeout of %ds", task.TimeoutSeconds)
+		s.killTaskProcess(bgCtx, &task)
+	case result != nil && result.IsError:
+		status = models.TaskStatusFailed
+		errMsg = result.Result
+		if errMsg == "" {
+			errMsg = result.Subtype
+		}
+	case result != nil:
+	case execErr != nil:
+		status = models.TaskStatusFailed
+		errMsg = fmt.Sprintf("%v: %s", execErr, stderr.String())
+	default:
+		status = models.TaskStatusFailed
+		errMsg = "claude exited without a result: " + stderr.String()
+	}
+
+	outputFiles := s.listTaskOutputFiles(bgCtx, podName, marker)
+
+	_, _, _ = s.containerManager.ExecInPod(bgCtx, podName, []string{"rm", "-f", marker + ".start", marker + ".pid"}, nil)
+
+	update := s.db.NewUpdate().
+		Model((*models.AgentTask)(nil)).
+		Set("status = ?", status).
+		Set("error = ?", errMsg).
+		Set("stdout = ?", stdout.String()).
+		Set("output_files = ?", pgdialect.Array(outputFiles)).
+		Set("finished_at = ?", time.Now()).
+		Where("id = ?", task.ID).
+		Where("status = ?", models.TaskStatusRunning)
+	if result != nil {
+		update = update.
+			Set("result = ?", result.Result).
+			Set("cost_usd = ?", result.TotalCostUSD).
+			Set("num_turns = ?", result.NumTurns).
+			Set("claude_session_id = ?", result.SessionID)
+	}
+	res, err = update.Exec(bgCtx)
+	if err != nil {
+		log.Error().Err(err).Str("task_id", task.TaskID).Msg("failed to store task result")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return // cancelled while running; CancelTask already announced it
+	}
+
+	s.publishTask(task.TaskID, TaskEvent{Type: "done", Status: string(status), Text: errMsg})
+	log.Info().Str("task_id", task.TaskID).Str("status", string(status)).Int("output_files", len(outputFiles)).Msg("task finished")
+}
+
+// listTaskOutputFiles returns files under /workspace/output modified since
+// the task started, relative to the output dir. The output-watcher sidecar
+// uploads them to object storage, so they are downloadable through the
+// regular output file endpoints.
+func (s *Server) listTaskOutputFiles(ctx context.Context, podName, marker string) []string {
+	cmd := []string{"sh", "-c", fmt.Sprintf("[ -f %s.start ] && find %s -type f -newer %s.start 2>/dev/null; true", marker, taskOutputDir, marker)}
+	stdout, _, err := s.containerManager.ExecInPod(ctx, podName, cmd, nil)
+	if err != nil {
+		log.Warn().Err(err).Str("pod", podName).Msg("failed to list task output files")
+		return []string{}
+	}
+
+	files := []string{}
+	for _, line := range strings.Split(stdout, "\n") {
+		path := strings.TrimSpace(line)
+		if rel := strings.TrimPrefix(path, taskOutputDir+"/"); path != "" && rel != path {
+			files = append(files, rel)
+		}
+	}
+	return files
+}
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+// TaskHandler serves the SSE event stream of headless agent tasks.
+type TaskHandler struct {
+	db  *bun.DB
+	hub *TaskHub // nil if Redis is not configured
+}
+
+func NewTaskHandler(db *bun.DB, hub *TaskHub) *TaskHandler {
+	return &TaskHandler{db: db, hub: hub}
+}
+
+// StreamTask streams task events as Server-Sent Events until the task
+// finishes. The first event is always a "status" snapshot, so clients that
+// connect late (or to a finished task) still learn the current state.
+// Without Redis the stream degrades to polling the task status.
+func (h *TaskHandler) StreamTask(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+	taskID := c.Param("task_id")
+
+	load := func() (*models.AgentTask, error) {
+		var task models.AgentTask
+		err := h.db.NewSelect().
+			Model(&task).
+			Column("status", "error").
+			Where("task_id = ?", taskID).
+			Where("user_id = ?", userID).
+			Scan(c.Request.Context())
+		return &task, err
+	}
+
+	task, err := load()
+	if err != nil {
+		response.NotFound(c, "Task not found")
+		return
+	}
+
+	// Subscribe before sending the snapshot so no event is lost in between.
+	var events <-chan TaskEvent
+	if h.hub != nil {
+		ch, unsub := h.hub.Subscribe(taskID)
+		defer unsub()
+		events = ch
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	send := func(event TaskEvent) {
+		c.SSEvent(event.Type, event)
+		c.Writer.Flush()
+	}
+
+	if task.Status.IsTerminal() {
+		send(TaskEvent{Type: "done", Status: string(task.Status), Text: task.Error})
+		return
+	}
+	send(TaskEvent{Type: "status", Status: string(task.Status)})
+
+	keepalive := time.NewTicker(15 * time.Second)
+	defer keepalive.Stop()
+	poll := time.NewTicker(2 * time.Second)
+	defer poll.Stop()
+
+	lastStatus := task.Status
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event := <-events:
+			send(event)
+			if event.Type == "done" {
+				return
+			}
+		case <-poll.C:
+			// Safety net for missed Pub/Sub messages and the no-Redis case.
+			task, err := load()
+			if err != nil {
+				return
+			}
+			if task.Status.IsTerminal() {
+				send(TaskEvent{Type: "done", Status: string(task.Status), Text: task.Error})
+				return
+			}
+			if task.Status != lastStatus {
+				lastStatus = task.Status
+				send(TaskEvent{Type: "status", Status: string(task.Status)})
+			}
+		case <-keepalive.C:
+			_, _ = c.Writer.WriteString(": ping\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// TaskEvent is a progress event of a headless agent task.
+type TaskEvent struct {
+	Type   string          `json:"type"`             // "status" | "message" | "stderr" | "done"
+	Status string          `json:"status,omitempty"` // task status for "status" / "done"
+	Data   json.RawMessage `json:"data,omitempty"`   // one Claude Code stream-json event for "message"
+	Text   string          `json:"text,omitempty"`   // raw line for "stderr", error text for "done"
+}
+
+type taskSubscriber struct {
+	ch     chan TaskEvent
+	taskID string
+}
+
+// TaskHub fans out task events over Redis Pub/Sub so any gateway replica can
+// serve the SSE stream of a task started on another replica.
+type TaskHub struct {
+	rdb  *redis.Client
+	mu   sync.RWMutex
+	subs map[*taskSubscriber]struct{}
+}
+
+// NewTaskHub creates a new TaskHub.
+func NewTaskHub(rdb *redis.Client) *TaskHub {
+	return &TaskHub{
+		rdb:  rdb,
+		subs: make(map[*taskSubscriber]struct{}),
+	}
+}
+
+// Start listens for Redis Pub/Sub messages and dispatches to subscribers.
+func (h *TaskHub) Start(ctx context.Context) {
+	pubsub := h.rdb.PSubscribe(ctx, "sac:task:*")
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			taskID := strings.TrimPrefix(msg.Channel, "sac:task:")
+
+			var event TaskEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				log.Warn().Err(err).Msg("TaskHub: bad event payload")
+				continue
+			}
+
+			h.mu.RLock()
+			for sub := range h.subs {
+				if sub.taskID == taskID {
+					select {
+					case sub.ch <- event:
+					default:
+					}
+				}
+			}
+			h.mu.RUnlock()
+		}
+	}
+}
+
+// Publish sends a task event to Redis.
+func (h *TaskHub) Publish(ctx context.Context, taskID string, event TaskEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Warn().Err(err).Msg("TaskHub: marshal error")
+		return
+	}
+	if err := h.rdb.Publish(ctx, fmt.Sprintf("sac:task:%s", taskID), data).Err(); err != nil {
+		log.Warn().Err(err).Msg("TaskHub: publish error")
+	}
+}
+
+// Subscribe registers a listener for a task.
+// Returns a channel for reading events and an unsubscribe function.
+func (h *TaskHub) Subscribe(taskID string) (<-chan TaskEvent, func()) {
+	sub := &taskSubscriber{
+		ch:     make(chan TaskEvent, 64),
+		taskID: taskID,
+	}
+
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub.ch, func() {
+		h.mu.Lock()
+		delete(h.subs, sub)
+		h.mu.Unlock()
+	}
+}
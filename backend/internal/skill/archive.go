@@ -0,0 +1,779 @@
+package skill
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/pkg/protobind"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"gopkg.in/yaml.v3"
+)
+
+// Limits for imported skill archives.
+const (
+	maxImportArchiveSize = 50 << 20  // 50MB compressed
+	maxImportTotalSize   = 200 << 20 // 200MB uncompressed
+	maxImportFiles       = 500
+	maxSkillMDSize       = 1 << 20
+
+	skillManifestName    = "sac-skill.json"
+	skillManifestVersion = 1
+)
+
+// skillManifest is written next to SKILL.md in exported archives. It keeps
+// the metadata SKILL.md has no place for; archives without it (e.g. a plain
+// ~/.claude/skills directory) are imported from SKILL.md alone.
+type skillManifest struct {
+	FormatVersion int                       `json:"format_version"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description,omitempty"`
+	Icon          string                    `json:"icon,omitempty"`
+	Category      string                    `json:"category,omitempty"`
+	Tags          []string                  `json:"tags,omitempty"`
+	CommandName   string                    `json:"command_name"`
+	Version       int                       `json:"version"`
+	Parameters    models.SkillParameters    `json:"parameters,omitempty"`
+	Frontmatter   models.SkillFrontmatter   `json:"frontmatter"`
+	Files         []models.SkillVersionFile `json:"files"`
+	ExportedAt    time.Time                 `json:"exported_at"`
+}
+
+// RegisterArchiveRoutes registers skill import/export routes (binary archives,
+// not suitable for gRPC-gateway).
+func (h *Handler) RegisterArchiveRoutes(router *gin.RouterGroup) {
+	router.GET("/skills/:id/export", h.ExportSkill)
+	router.POST("/skills/import", h.ImportSkill)
+}
+
+// --- Export ---
+
+// archiveWriter adds regular files to a zip or tar stream.
+type archiveWriter interface {
+	add(name string, size int64, r io.Reader) error
+	Close() error
+}
+
+type zipArchive struct{ zw *zip.Writer }
+
+func (a zipArchive) add(name string, _ int64, r io.Reader) error {
+	w, err := a.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (a zipArchive) Close() error { return a.zw.Close() }
+
+type tarArchive struct{ tw *tar.Writer }
+
+func (a tarArchive) add(name string, size int64, r io.Reader) error {
+	err := a.tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: size, Mode: 0o644, ModTime: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(a.tw, r, size)
+	return err
+}
+
+func (a tarArchive) Close() error { return a.tw.Close() }
+
+// ExportSkill streams a skill as a standard skill directory archive:
+// <command>/SKILL.md, the attached files and a manifest.
+func (h *Handler) ExportSkill(c *gin.Context) {
+	skillID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid skill ID", err)
+		return
+	}
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "tar" {
+		response.BadRequest(c, "format must be zip or tar")
+		return
+	}
+
+	ctx := c.Request.Context()
+	var sk models.Skill
+	err = h.db.NewSelect().Model(&sk).Relation("Files").Where("sk.id = ?", skillID).Scan(ctx)
+	userID := c.GetInt64("userID")
+	if err != nil || !h.canExport(ctx, &sk, userID, c.GetString("role")) {
+		response.NotFound(c, "Skill not found", err)
+		return
+	}
+
+	// Other users export the published revision from its stored bundle.
+	var rev *models.SkillVersion
+	latest := sk.Version
+	if c.GetString("role") != "admin" {
+		h.syncService.applyPublishedView(ctx, &sk, userID)
+		if sk.Version != latest {
+			rev = new(models.SkillVersion)
+			err := h.db.NewSelect().Model(rev).
+				Column("bundle_key", "files").
+				Where("skill_id = ? AND version = ?", sk.ID, sk.Version).
+				Scan(ctx)
+			if err != nil || rev.BundleKey == "" {
+				response.NotFound(c, "Published version is not available", err)
+				return
+			}
+		}
+	}
+
+	var backend storage.StorageBackend
+	if h.syncService.storage != nil {
+		backend = h.syncService.storage.GetClient(ctx)
+	}
+	if backend == nil && (len(sk.Files) > 0 || rev != nil) {
+		response.ServiceUnavailable(c, "Storage not configured")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, sk.CommandName, format))
+	var aw archiveWriter
+	if format == "zip" {
+		c.Header("Content-Type", "application/zip")
+		aw = zipArchive{zip.NewWriter(c.Writer)}
+	} else {
+		c.Header("Content-Type", "application/x-tar")
+		aw = tarArchive{tar.NewWriter(c.Writer)}
+	}
+
+	if err := writeSkillArchive(ctx, backend, aw, &sk, rev); err != nil {
+		// Headers are already sent; a truncated archive is all we can do.
+		log.Warn().Err(err).Int64("skill_id", skillID).Msg("skill export failed")
+		c.Abort()
+	}
+}
+
+// canExport mirrors the visibility rules of ListSkills.
+func (h *Handler) canExport(ctx context.Context, sk *models.Skill, userID int64, role string) bool {
+	if sk.CreatedBy == userID || role == "admin" {
+		return true
+	}
+	if sk.PublishedVersion == nil {
+		return false
+	}
+	if sk.IsOfficial || sk.IsPublic {
+		return true
+	}
+	if sk.GroupID == nil {
+		return false
+	}
+	member, _ := h.db.NewSelect().Model((*models.GroupMember)(nil)).
+		Where("group_id = ? AND user_id = ?", *sk.GroupID, userID).
+		Exists(ctx)
+	return member
+}
+
+// writeSkillArchive writes the skill's SKILL.md, files and manifest. The
+// files are read from their S3 objects, or from the bundle of rev when one
+// is given, in which case the manifest lists that revision's files.
+func writeSkillArchive(ctx context.Context, backend storage.StorageBackend, aw archiveWriter, sk *models.Skill, rev *models.SkillVersion) error {
+	root := sk.CommandName + "/"
+
+	skillMD := buildSkillMD(sk)
+	if err := aw.add(root+"SKILL.md", int64(len(skillMD)), strings.NewReader(skillMD)); err != nil {
+		return err
+	}
+
+	manifest := skillManifest{
+		FormatVersion: skillManifestVersion,
+		Name:          sk.Name,
+		Description:   sk.Description,
+		Icon:          sk.Icon,
+		Category:      sk.Category,
+		Tags:          sk.Tags,
+		CommandName:   sk.CommandName,
+		Version:       sk.Version,
+		Parameters:    sk.Parameters,
+		Frontmatter:   sk.Frontmatter,
+		Files:         make([]models.SkillVersionFile, 0, len(sk.Files)),
+		ExportedAt:    time.Now().UTC(),
+	}
+
+	if rev != nil {
+		manifest.Files = append(manifest.Files, rev.Files...)
+		if err := copyBundleFiles(ctx, backend, aw, root, rev.BundleKey); err != nil {
+			return err
+		}
+		sk.Files = nil
+	}
+
+	for _, f := range sk.Files {
+		body, err := backend.Download(ctx, f.S3Key)
+		if err != nil {
+			return fmt.Errorf("download %s: %w", f.Filepath, err)
+		}
+		err = aw.add(root+f.Filepath, f.Size, body)
+		body.Close()
+		if err != nil {
+			return fmt.Errorf("write %s: %w", f.Filepath, err)
+		}
+		manifest.Files = append(manifest.Files, models.SkillVersionFile{
+			Filepath:    f.Filepath,
+			Checksum:    f.Checksum,
+			Size:        f.Size,
+			ContentType: f.ContentType,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := aw.add(root+skillManifestName, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+// copyBundleFiles adds the attached files of a revision bundle.tar below
+// root, leaving out the bundle's SKILL.md and .checksum marker.
+func copyBundleFiles(ctx context.Context, backend storage.StorageBackend, aw archiveWriter, root, bundleKey string) error {
+	body, err := backend.Download(ctx, bundleKey)
+	if err != nil {
+		return fmt.Errorf("download bundle: %w", err)
+	}
+	defer body.Close()
+
+	tr := tar.NewReader(body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read bundle: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || hdr.Name == "SKILL.md" || hdr.Name == ".checksum" {
+			continue
+		}
+		if err := aw.add(root+hdr.Name, hdr.Size, tr); err != nil {
+			return fmt.Errorf("write %s: %w", hdr.Name, err)
+		}
+	}
+}
+
+// --- Import ---
+
+// archiveEntry is a regular file found in an uploaded archive.
+type archiveEntry struct {
+	name string // cleaned path inside the archive
+	size int64
+}
+
+// errArchiveTooLarge is returned once a walk has read more than
+// maxImportTotalSize bytes of entry content.
+var errArchiveTooLarge = fmt.Errorf("archive content exceeds %d MB", maxImportTotalSize>>20)
+
+// entryReader reads one archive entry within what is left of the walk's
+// budget. Entry headers are not trusted: reading past the budget fails
+// whatever size the header declared.
+type entryReader struct {
+	lr *io.LimitedReader
+}
+
+func newEntryReader(r io.Reader, remaining int64) *entryReader {
+	return &entryReader{lr: &io.LimitedReader{R: r, N: remaining + 1}}
+}
+
+func (e *entryReader) Read(p []byte) (int, error) {
+	n, err := e.lr.Read(p)
+	if e.lr.N <= 0 {
+		return n, errArchiveTooLarge
+	}
+	return n, err
+}
+
+// remaining returns the budget left after the entry was read.
+func (e *entryReader) remaining() int64 {
+	return e.lr.N - 1
+}
+
+// walkArchive calls fn for every regular file of a zip, tar or tar.gz
+// archive, in archive order. Unsafe paths abort the walk, and so does
+// reading more than maxImportTotalSize bytes of content in total.
+func walkArchive(f multipart.File, size int64, fn func(e archiveEntry, r io.Reader) error) error {
+	remaining := int64(maxImportTotalSize)
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	magic := make([]byte, 4)
+	n, _ := io.ReadFull(f, magic)
+	magic = magic[:n]
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if bytes.HasPrefix(magic, []byte("PK\x03\x04")) {
+		zr, err := zip.NewReader(f, size)
+		if err != nil {
+			return fmt.Errorf("invalid zip archive: %w", err)
+		}
+		for _, zf := range zr.File {
+			if zf.FileInfo().IsDir() || !zf.Mode().IsRegular() {
+				continue
+			}
+			name, err := cleanArchivePath(zf.Name)
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				continue
+			}
+			rc, err := zf.Open()
+			if err != nil {
+				return fmt.Errorf("read %s: %w", zf.Name, err)
+			}
+			er := newEntryReader(rc, remaining)
+			err = fn(archiveEntry{name: name, size: int64(zf.UncompressedSize64)}, er)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+			remaining = er.remaining()
+		}
+		return nil
+	}
+
+	var r io.Reader = f
+	if bytes.HasPrefix(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("invalid gzip archive: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, err := cleanArchivePath(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			continue
+		}
+		er := newEntryReader(tr, remaining)
+		if err := fn(archiveEntry{name: name, size: hdr.Size}, er); err != nil {
+			return err
+		}
+		remaining = er.remaining()
+	}
+}
+
+// cleanArchivePath normalises an entry name. Absolute and parent-relative
+// paths are rejected; OS metadata files are skipped by returning "".
+func cleanArchivePath(name string) (string, error) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if strings.HasPrefix(name, "/") {
+		return "", fmt.Errorf("unsafe path in archive: %s", name)
+	}
+	clean := path.Clean(name)
+	if clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("unsafe path in archive: %s", name)
+	}
+	if clean == "." || strings.HasPrefix(clean, "__MACOSX/") || path.Base(clean) == ".DS_Store" {
+		return "", nil
+	}
+	return clean, nil
+}
+
+// importedSkill is the content of a skill archive, before it is stored.
+type importedSkill struct {
+	root     string // directory holding SKILL.md, "." for the archive root
+	skillMD  string
+	manifest *skillManifest
+	files    []archiveEntry // paths relative to the archive, under root
+}
+
+// relPath returns an archive path relative to the skill directory.
+func (s *importedSkill) relPath(name string) (string, bool) {
+	if s.root == "." {
+		return name, true
+	}
+	rel, ok := strings.CutPrefix(name, s.root+"/")
+	return rel, ok
+}
+
+// scanSkillArchive locates the skill directory (the shallowest SKILL.md) and
+// reads SKILL.md and the manifest. Attached files are only listed.
+func scanSkillArchive(f multipart.File, size int64) (*importedSkill, error) {
+	var entries []archiveEntry
+	var total int64
+	err := walkArchive(f, size, func(e archiveEntry, _ io.Reader) error {
+		entries = append(entries, e)
+		total += e.size
+		if len(entries) > maxImportFiles {
+			return fmt.Errorf("archive has more than %d files", maxImportFiles)
+		}
+		if total > maxImportTotalSize {
+			return errArchiveTooLarge
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &importedSkill{}
+	depth := -1
+	for _, e := range entries {
+		if path.Base(e.name) != "SKILL.md" {
+			continue
+		}
+		d := strings.Count(e.name, "/")
+		switch {
+		case depth < 0 || d < depth:
+			depth, imp.root = d, path.Dir(e.name)
+		case d == depth:
+			return nil, errors.New("archive contains more than one skill")
+		}
+	}
+	if depth < 0 {
+		return nil, errors.New("archive does not contain a SKILL.md")
+	}
+
+	skillMDPath := path.Join(imp.root, "SKILL.md")
+	manifestPath := path.Join(imp.root, skillManifestName)
+	for _, e := range entries {
+		rel, ok := imp.relPath(e.name)
+		if !ok || e.name == skillMDPath || e.name == manifestPath || rel == ".checksum" {
+			continue
+		}
+		imp.files = append(imp.files, e)
+	}
+
+	err = walkArchive(f, size, func(e archiveEntry, r io.Reader) error {
+		switch e.name {
+		case skillMDPath:
+			data, err := io.ReadAll(io.LimitReader(r, maxSkillMDSize+1))
+			if err != nil {
+				return err
+			}
+			if len(data) > maxSkillMDSize {
+				return errors.New("SKILL.md is too large")
+			}
+			imp.skillMD = string(data)
+		case manifestPath:
+			var m skillManifest
+			if err := json.NewDecoder(io.LimitReader(r, maxSkillMDSize)).Decode(&m); err != nil {
+				return fmt.Errorf("invalid %s: %w", skillManifestName, err)
+			}
+			imp.manifest = &m
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// parseSkillMD splits SKILL.md into its YAML frontmatter and body.
+func parseSkillMD(content string) (map[string]any, string, error) {
+	first, rest, ok := strings.Cut(content, "\n")
+	if !ok || strings.TrimSpace(first) != "---" {
+		return nil, stripArgumentsSection(content), nil
+	}
+	var yamlLines []string
+	for {
+		var line string
+		line, rest, ok = strings.Cut(rest, "\n")
+		if strings.TrimSpace(line) == "---" {
+			break
+		}
+		if !ok {
+			return nil, "", errors.New("SKILL.md frontmatter is not terminated")
+		}
+		yamlLines = append(yamlLines, line)
+	}
+	if !ok {
+		rest = ""
+	}
+
+	meta := make(map[string]any)
+	if err := yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), &meta); err != nil {
+		return nil, "", fmt.Errorf("invalid SKILL.md frontmatter: %w", err)
+	}
+	// Claude Code uses kebab-case keys; buildSkillMD writes snake_case.
+	normalized := make(map[string]any, len(meta))
+	for k, v := range meta {
+		normalized[strings.ReplaceAll(strings.ToLower(k), "-", "_")] = v
+	}
+	return normalized, stripArgumentsSection(rest), nil
+}
+
+// frontmatterFromYAML maps parsed frontmatter keys onto SkillFrontmatter.
+// Unknown keys are ignored.
+func frontmatterFromYAML(meta map[string]any) models.SkillFrontmatter {
+	var fm models.SkillFrontmatter
+	switch v := meta["allowed_tools"].(type) {
+	case string:
+		fm.AllowedTools = strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		for _, t := range v {
+			if s, ok := t.(string); ok && s != "" {
+				fm.AllowedTools = append(fm.AllowedTools, s)
+			}
+		}
+	}
+	fm.Model, _ = meta["model"].(string)
+	fm.Context, _ = meta["context"].(string)
+	fm.Agent, _ = meta["agent"].(string)
+	fm.ArgumentHint, _ = meta["argument_hint"].(string)
+	fm.DisableModelInvocation, _ = meta["disable_model_invocation"].(bool)
+	// user_invocable defaults to true in buildSkillMD; only keep an opt-out.
+	if v, ok := meta["user_invocable"].(bool); ok && !v {
+		fm.UserInvocable = &v
+	}
+	return fm
+}
+
+// tagsFromYAML reads a "tags" frontmatter key, either a list or a
+// comma-separated string.
+func tagsFromYAML(meta map[string]any) []string {
+	var tags []string
+	switch v := meta["tags"].(type) {
+	case string:
+		tags = strings.Split(v, ",")
+	case []any:
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+	return tags
+}
+
+// availableCommandName returns base, or base-2, base-3, ... when taken.
+func availableCommandName(ctx context.Context, db bun.IDB, base string) (string, error) {
+	for i := 1; i <= 100; i++ {
+		name := base
+		if i > 1 {
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		taken, err := db.NewSelect().Model((*models.Skill)(nil)).
+			Where("command_name = ?", name).
+			Exists(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no free command name for '/%s'", base)
+}
+
+// ImportSkill creates a private skill from an uploaded skill directory
+// archive (zip, tar or tar.gz). Form fields: file, optional command_name,
+// and on_conflict=rename (default) or fail for command name collisions.
+func (h *Handler) ImportSkill(c *gin.Context) {
+	userID := c.GetInt64("userID")
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		response.BadRequest(c, "No file provided", err)
+		return
+	}
+	defer file.Close()
+	if header.Size > maxImportArchiveSize {
+		response.BadRequest(c, fmt.Sprintf("Archive exceeds %d MB", maxImportArchiveSize>>20))
+		return
+	}
+	onConflict := c.DefaultPostForm("on_conflict", "rename")
+	if onConflict != "rename" && onConflict != "fail" {
+		response.BadRequest(c, "on_conflict must be rename or fail")
+		return
+	}
+
+	if h.syncService.storage == nil {
+		response.ServiceUnavailable(c, "Storage not configured")
+		return
+	}
+	ctx := userContext(c)
+	backend := h.syncService.storage.GetClient(ctx)
+	if backend == nil {
+		response.ServiceUnavailable(c, "Storage not configured")
+		return
+	}
+
+	imp, err := scanSkillArchive(file, header.Size)
+	if err != nil {
+		response.BadRequest(c, "Invalid skill archive", err)
+		return
+	}
+	meta, body, err := parseSkillMD(imp.skillMD)
+	if err != nil {
+		response.BadRequest(c, "Invalid SKILL.md", err)
+		return
+	}
+
+	dirName := path.Base(imp.root)
+	if dirName == "." {
+		dirName = strings.TrimSuffix(header.Filename, path.Ext(header.Filename))
+		dirName = strings.TrimSuffix(dirName, ".tar")
+	}
+
+	sk := models.Skill{
+		Prompt:      body,
+		Frontmatter: frontmatterFromYAML(meta),
+		CreatedBy:   userID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	sk.Name, _ = meta["name"].(string)
+	sk.Description, _ = meta["description"].(string)
+	tags := tagsFromYAML(meta)
+	if m := imp.manifest; m != nil {
+		sk.Name, sk.Description = firstNonEmpty(m.Name, sk.Name), firstNonEmpty(m.Description, sk.Description)
+		sk.Icon, sk.Category, sk.Parameters = m.Icon, m.Category, m.Parameters
+		sk.CommandName = m.CommandName
+		if len(m.Tags) > 0 {
+			tags = m.Tags
+		}
+	}
+	if sk.Tags, err = normalizeTags(tags); err != nil {
+		response.BadRequest(c, "Invalid tags", err)
+		return
+	}
+	sk.Name = firstNonEmpty(sk.Name, dirName)
+	// A hint generated from the parameters is regenerated on export.
+	if len(sk.Parameters) > 0 && sk.Frontmatter.ArgumentHint == argumentHint(sk.Parameters) {
+		sk.Frontmatter.ArgumentHint = ""
+	}
+
+	base := SanitizeCommandName(firstNonEmpty(c.PostForm("command_name"), sk.CommandName, sk.Name, dirName))
+	if base == "" {
+		response.BadRequest(c, "Cannot derive a valid command name from the archive")
+		return
+	}
+	if onConflict == "fail" {
+		sk.CommandName = base
+		taken, err := h.db.NewSelect().Model((*models.Skill)(nil)).Where("command_name = ?", base).Exists(ctx)
+		if err != nil {
+			response.InternalError(c, "Failed to check command name", err)
+			return
+		}
+		if taken {
+			response.Conflict(c, fmt.Sprintf("Command name '/%s' is already taken", base))
+			return
+		}
+	} else if sk.CommandName, err = availableCommandName(ctx, h.db, base); err != nil {
+		response.Conflict(c, err.Error())
+		return
+	}
+
+	cfg := loadLintConfig(ctx, h.db)
+	files := make([]LintFile, 0, len(imp.files))
+	var filesTotal int64
+	for _, e := range imp.files {
+		rel, _ := imp.relPath(e.name)
+		files = append(files, LintFile{Path: rel, Size: e.size})
+		filesTotal += e.size
+	}
+	if errs := lintErrors(LintSkill(cfg, &sk, files)); len(errs) > 0 {
+		response.BadRequest(c, "Skill validation failed: "+lintSummary(errs))
+		return
+	}
+	if filesTotal > cfg.MaxSkillBytes {
+		response.BadRequest(c, fmt.Sprintf("Skill is too large: attached files total %d bytes, the limit per skill is %d", filesTotal, cfg.MaxSkillBytes))
+		return
+	}
+
+	if _, err := h.db.NewInsert().Model(&sk).Exec(ctx); err != nil {
+		response.InternalError(c, "Failed to create skill", err)
+		return
+	}
+
+	if err := h.storeImportedFiles(ctx, backend, file, header.Size, imp, sk.ID); err != nil {
+		h.discardImportedSkill(ctx, backend, sk.ID)
+		response.InternalError(c, "Failed to store skill files", err)
+		return
+	}
+
+	if err := h.syncService.RebuildSkillBundle(ctx, sk.ID, "Imported from "+header.Filename); err != nil {
+		log.Warn().Err(err).Int64("skill_id", sk.ID).Msg("failed to build skill bundle after import")
+	}
+
+	var created models.Skill
+	if err := h.db.NewSelect().Model(&created).Relation("Files").Where("sk.id = ?", sk.ID).Scan(ctx); err != nil {
+		response.InternalError(c, "Failed to reload imported skill", err)
+		return
+	}
+	log.Info().Int64("skill_id", sk.ID).Str("command", sk.CommandName).Int("files", len(imp.files)).Msg("imported skill")
+	protobind.Created(c, convert.SkillToProto(&created))
+}
+
+// storeImportedFiles streams the attached files of an archive into the
+// skill's storage through the regular skill_files path.
+func (h *Handler) storeImportedFiles(ctx context.Context, backend storage.StorageBackend, f multipart.File, size int64, imp *importedSkill, skillID int64) error {
+	if len(imp.files) == 0 {
+		return nil
+	}
+	wanted := make(map[string]bool, len(imp.files))
+	for _, e := range imp.files {
+		wanted[e.name] = true
+	}
+	return walkArchive(f, size, func(e archiveEntry, r io.Reader) error {
+		if !wanted[e.name] {
+			return nil
+		}
+		rel, _ := imp.relPath(e.name)
+		contentType := mime.TypeByExtension(path.Ext(rel))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		_, err := storeSkillFile(ctx, h.db, backend, skillID, rel, contentType, r, e.size)
+		return err
+	})
+}
+
+// discardImportedSkill removes a partially imported skill.
+func (h *Handler) discardImportedSkill(ctx context.Context, backend storage.StorageBackend, skillID int64) {
+	_ = backend.DeletePrefix(ctx, fmt.Sprintf("skills/%d/", skillID))
+	if _, err := h.db.NewDelete().Model((*models.Skill)(nil)).Where("id = ?", skillID).Exec(ctx); err != nil {
+		log.Warn().Err(err).Int64("skill_id", skillID).Msg("failed to remove partially imported skill")
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
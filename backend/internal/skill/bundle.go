@@ -0,0 +1,293 @@
+package skill
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	bundleChecksumFile = ".checksum"
+
+	// bundleHeaderAllowance covers tar headers and SKILL.md on top of the
+	// attached files when bounding a bundle read back from storage.
+	bundleHeaderAllowance = 1 << 20
+)
+
+var (
+	errBundleTooLarge = errors.New("skill bundle exceeds the size limit")
+	errUploadAborted  = errors.New("bundle upload aborted")
+)
+
+// newFileHash returns the hash behind skill_files.checksum. It stays MD5:
+// per-file sums are compared across skill_files, revision file lists,
+// exported manifests and forks, and existing rows cannot be rehashed without
+// downloading every file. They only detect changed or corrupted content;
+// bundles are identified and verified by their SHA-256 content checksum,
+// which covers every file byte.
+func newFileHash() hash.Hash {
+	return md5.New()
+}
+
+func bundleHeader(name string, size int64) *tar.Header {
+	return &tar.Header{Name: name, Mode: 0644, Size: size}
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct{ n int64 }
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// bundleTarSize returns the exact size of the archive writeBundle produces,
+// so the bundle can be uploaded as a stream of known length.
+func bundleTarSize(skillMD string, files []models.SkillFile) (int64, error) {
+	var total int64
+	add := func(name string, size int64) error {
+		var cw countingWriter
+		if err := tar.NewWriter(&cw).WriteHeader(bundleHeader(name, size)); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		total += cw.n + (size+511)/512*512
+		return nil
+	}
+	if err := add("SKILL.md", int64(len(skillMD))); err != nil {
+		return 0, err
+	}
+	for _, f := range files {
+		if err := add(f.Filepath, f.Size); err != nil {
+			return 0, err
+		}
+	}
+	if err := add(bundleChecksumFile, sha256.Size*2); err != nil {
+		return 0, err
+	}
+	return total + 1024, nil // two zero blocks end the archive
+}
+
+// filesSize sums the recorded sizes of attached files.
+func filesSize(files []models.SkillFile) int64 {
+	var n int64
+	for _, f := range files {
+		n += f.Size
+	}
+	return n
+}
+
+// checkSkillSize reports whether storing size bytes at filepath keeps the
+// skill's attached files within the configured limit.
+func checkSkillSize(ctx context.Context, db bun.IDB, cfg LintConfig, skillID int64, filepath string, size int64) error {
+	var others int64
+	err := db.NewSelect().Model((*models.SkillFile)(nil)).
+		ColumnExpr("COALESCE(SUM(size), 0)").
+		Where("skill_id = ?", skillID).
+		Where("filepath <> ?", filepath).
+		Scan(ctx, &others)
+	if err != nil {
+		return fmt.Errorf("failed to sum skill file sizes: %w", err)
+	}
+	if others+size > cfg.MaxSkillBytes {
+		return fmt.Errorf("attached files would total %d bytes, the limit per skill is %d", others+size, cfg.MaxSkillBytes)
+	}
+	return nil
+}
+
+// writeBundle streams the tar bundle of a skill to w: SKILL.md, the attached
+// files downloaded one at a time from storage, and .checksum. Every file
+// must match its recorded size and checksum, so a bundle is never written
+// with missing or truncated files. The returned content checksum is the
+// SHA-256 of SKILL.md and each file's path, size and content; marker, when
+// set, is written to .checksum instead.
+func writeBundle(ctx context.Context, w io.Writer, backend storage.StorageBackend, skillMD string, files []models.SkillFile, marker string) (string, error) {
+	tw := tar.NewWriter(w)
+	sum := sha256.New()
+
+	sum.Write([]byte(skillMD))
+	sum.Write([]byte{0x00})
+	if err := tw.WriteHeader(bundleHeader("SKILL.md", int64(len(skillMD)))); err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(tw, skillMD); err != nil {
+		return "", err
+	}
+
+	for _, f := range files {
+		if err := writeBundleFile(ctx, tw, sum, backend, f); err != nil {
+			return "", fmt.Errorf("%s: %w", f.Filepath, err)
+		}
+	}
+
+	checksum := hex.EncodeToString(sum.Sum(nil))
+	if marker == "" {
+		marker = checksum
+	}
+	if err := tw.WriteHeader(bundleHeader(bundleChecksumFile, int64(len(marker)))); err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(tw, marker); err != nil {
+		return "", err
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	return checksum, nil
+}
+
+func writeBundleFile(ctx context.Context, tw *tar.Writer, sum io.Writer, backend storage.StorageBackend, f models.SkillFile) error {
+	if backend == nil {
+		return errors.New("storage not configured")
+	}
+	body, err := backend.Download(ctx, f.S3Key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	io.WriteString(sum, f.Filepath+"\x00"+strconv.FormatInt(f.Size, 10)+"\x00")
+	if err := tw.WriteHeader(bundleHeader(f.Filepath, f.Size)); err != nil {
+		return err
+	}
+	fileSum := newFileHash()
+	n, err := io.CopyN(io.MultiWriter(tw, sum, fileSum), body, f.Size)
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("stored file has %d bytes, expected %d", n, f.Size)
+	}
+	if err != nil {
+		return err
+	}
+	if f.Checksum != "" && hex.EncodeToString(fileSum.Sum(nil)) != f.Checksum {
+		return errors.New("stored file does not match its checksum")
+	}
+	return nil
+}
+
+// uploadBundle streams the bundle of a skill into its live bundle key and
+// returns the content checksum. Storage only replaces the object once the
+// whole archive arrived, so a failed build keeps the previous bundle.
+func (s *SyncService) uploadBundle(ctx context.Context, backend storage.StorageBackend, sk *models.Skill, skillMD string, files []models.SkillFile) (string, error) {
+	size, err := bundleTarSize(skillMD, files)
+	if err != nil {
+		return "", err
+	}
+	s.preserveSeededBundle(ctx, backend, sk)
+
+	pr, pw := io.Pipe()
+	var checksum string
+	written := make(chan error, 1)
+	go func() {
+		var err error
+		checksum, err = writeBundle(ctx, pw, backend, skillMD, files, "")
+		pw.CloseWithError(err)
+		written <- err
+	}()
+
+	err = backend.Upload(ctx, bundleS3Key(sk.ID), pr, size, "application/x-tar")
+	// Unblock the writer if the upload ended before consuming the archive.
+	// A writer stopped that way is not what failed.
+	if err != nil {
+		pr.CloseWithError(errUploadAborted)
+	} else {
+		pr.Close()
+	}
+	if werr := <-written; werr != nil && !errors.Is(werr, errUploadAborted) {
+		return "", werr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to upload bundle: %w", err)
+	}
+	return checksum, nil
+}
+
+// buildBundle builds and uploads the bundle of a skill, returning its
+// content checksum and whether a bundle was stored. Without storage only
+// skills without attached files can be built; they are synced from SKILL.md.
+func (s *SyncService) buildBundle(ctx context.Context, sk *models.Skill, skillMD string, files []models.SkillFile) (string, bool, error) {
+	cfg := loadLintConfig(ctx, s.db)
+	if total := filesSize(files); total > cfg.MaxSkillBytes {
+		return "", false, fmt.Errorf("attached files total %d bytes, the limit per skill is %d", total, cfg.MaxSkillBytes)
+	}
+
+	var backend storage.StorageBackend
+	if s.storage != nil {
+		backend = s.storage.GetClient(ctx)
+	}
+	if backend == nil {
+		if len(files) > 0 {
+			return "", false, errors.New("storage not configured")
+		}
+		checksum, err := writeBundle(ctx, io.Discard, nil, skillMD, nil, "")
+		return checksum, false, err
+	}
+
+	checksum, err := s.uploadBundle(ctx, backend, sk, skillMD, files)
+	return checksum, err == nil, err
+}
+
+// markBundleError records why the bundle of a skill could not be built.
+func (s *SyncService) markBundleError(ctx context.Context, skillID int64, cause error) {
+	log.Warn().Err(cause).Int64("skill_id", skillID).Msg("skill bundle build failed")
+	_, err := s.db.NewUpdate().Model((*models.Skill)(nil)).
+		Set("bundle_error = ?", cause.Error()).
+		Where("id = ?", skillID).
+		Exec(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("skill_id", skillID).Msg("failed to record skill bundle error")
+	}
+}
+
+// streamBundleOnTheFly builds the bundle of a skill that has none in
+// storage (legacy skills) while it is being read. The skill's recorded
+// checksum, when set, goes into .checksum so the pod matches it.
+func (s *SyncService) streamBundleOnTheFly(ctx context.Context, sk *models.Skill) (io.ReadCloser, error) {
+	var files []models.SkillFile
+	if err := s.db.NewSelect().Model(&files).Where("skill_id = ?", sk.ID).Order("filepath ASC").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load skill files: %w", err)
+	}
+	var backend storage.StorageBackend
+	if s.storage != nil {
+		backend = s.storage.GetClient(ctx)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := writeBundle(ctx, pw, backend, buildSkillMD(sk), files, sk.ContentChecksum)
+		pw.CloseWithError(err)
+	}()
+	return pr, nil
+}
+
+// boundedReader fails once more than n bytes were read, so an oversized
+// bundle aborts extraction instead of filling the pod's disk.
+type boundedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (b *boundedReader) Read(p []byte) (int, error) {
+	if b.n < 0 {
+		return 0, errBundleTooLarge
+	}
+	if int64(len(p)) > b.n+1 {
+		p = p[:b.n+1]
+	}
+	k, err := b.r.Read(p)
+	b.n -= int64(k)
+	if b.n < 0 {
+		return k, errBundleTooLarge
+	}
+	return k, err
+}
@@ -0,0 +1,154 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+const (
+	maxSkillTags      = 10
+	maxSkillTagLength = 32
+
+	defaultCatalogLimit = 50
+	maxCatalogLimit     = 200
+)
+
+// catalogSorts maps ListPublicSkillsRequest.sort to ORDER BY expressions.
+// relevance is handled separately since it needs the query.
+var catalogSorts = map[string][]string{
+	"name":        {"sk.category ASC", "sk.name ASC"},
+	"newest":      {"sk.created_at DESC"},
+	"updated":     {"sk.updated_at DESC"},
+	"installs":    {"install_count DESC"},
+	"forks":       {"fork_count DESC"},
+	"invocations": {"invocation_count DESC"},
+}
+
+// normalizeTags lowercases, trims and de-duplicates tags, keeping their
+// order. Tags are words of letters, digits, '-', '_' and '.'.
+func normalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		if len([]rune(t)) > maxSkillTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", t, maxSkillTagLength)
+		}
+		for _, r := range t {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.", r) {
+				return nil, fmt.Errorf("tag %q may only contain letters, digits, '-', '_' and '.'", t)
+			}
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	if len(out) > maxSkillTags {
+		return nil, fmt.Errorf("a skill can have at most %d tags", maxSkillTags)
+	}
+	return out, nil
+}
+
+// withSkillStats selects the marketplace statistics of skills, and the
+// upstream revision forks can merge, next to their columns.
+func withSkillStats(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		ColumnExpr("?TableColumns").
+		ColumnExpr("(SELECT COUNT(*) FROM agent_skills WHERE agent_skills.skill_id = sk.id) AS install_count").
+		ColumnExpr("(SELECT COUNT(*) FROM skills AS fork WHERE fork.forked_from = sk.id) AS fork_count").
+		ColumnExpr("sk.invocation_count").
+		ColumnExpr(`(SELECT CASE WHEN up.created_by = sk.created_by THEN up.version ELSE up.published_version END
+			FROM skills AS up WHERE up.id = sk.forked_from) AS upstream_latest_version`)
+}
+
+func (s *Server) ListPublicSkills(ctx context.Context, req *sacv1.ListPublicSkillsRequest) (*sacv1.SkillListResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxCatalogLimit {
+		limit = defaultCatalogLimit
+	}
+	offset := max(int(req.Offset), 0)
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+	query := strings.TrimSpace(req.Query)
+
+	sort := req.Sort
+	if sort == "" {
+		sort = "name"
+		if query != "" {
+			sort = "relevance"
+		}
+	}
+	order, ok := catalogSorts[sort]
+	if !ok && !(sort == "relevance" && query != "") {
+		return nil, grpcerr.BadRequest("sort must be relevance (with a query), name, newest, updated, installs, forks or invocations")
+	}
+
+	var skills []models.Skill
+	q := s.db.NewSelect().
+		Model(&skills).
+		Apply(withSkillStats).
+		Relation("Files").
+		Relation("Creator").
+		Where("sk.is_public = ? OR sk.is_official = ?", true, true).
+		Where("sk.published_version IS NOT NULL")
+	if query != "" {
+		q = q.Where("sk.search_vector @@ websearch_to_tsquery('simple', ?) OR ? = ANY(sk.tags)", query, strings.ToLower(query))
+	}
+	if req.Category != "" {
+		q = q.Where("sk.category = ?", req.Category)
+	}
+	if len(tags) > 0 {
+		q = q.Where("sk.tags @> ?", pgdialect.Array(tags))
+	}
+	if sort == "relevance" {
+		q = q.OrderExpr("ts_rank(sk.search_vector, websearch_to_tsquery('simple', ?)) DESC", query)
+	} else {
+		q = q.Order(order...)
+	}
+
+	total, err := q.OrderExpr("sk.id ASC").Limit(limit).Offset(offset).ScanAndCount(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to retrieve public skills", err)
+	}
+	s.syncService.applyPublishedViews(ctx, skills, ctxkeys.UserID(ctx))
+
+	return &sacv1.SkillListResponse{Skills: convert.SkillsToProto(skills), Total: int32(total)}, nil
+}
+
+func (s *Server) ListPublicSkillTags(ctx context.Context, _ *sacv1.Empty) (*sacv1.SkillTagListResponse, error) {
+	var rows []struct {
+		Tag   string `bun:"tag"`
+		Count int32  `bun:"count"`
+	}
+	err := s.db.NewSelect().
+		TableExpr("skills AS sk, unnest(sk.tags) AS tag").
+		ColumnExpr("tag, COUNT(*) AS count").
+		Where("sk.is_public = ? OR sk.is_official = ?", true, true).
+		Where("sk.published_version IS NOT NULL").
+		GroupExpr("tag").
+		OrderExpr("count DESC, tag ASC").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to retrieve skill tags", err)
+	}
+
+	resp := &sacv1.SkillTagListResponse{Tags: make([]*sacv1.SkillTagCount, 0, len(rows))}
+	for _, r := range rows {
+		resp.Tags = append(resp.Tags, &sacv1.SkillTagCount{Tag: r.Tag, Count: r.Count})
+	}
+	return resp, nil
+}
@@ -0,0 +1,266 @@
+package skill
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	diffContext = 3
+	// maxDiffCells bounds the LCS table; larger inputs are shown as a full
+	// replacement of the differing region.
+	maxDiffCells = 4_000_000
+)
+
+// diffLine is one line of an edit script: ' ' kept, '-' removed, '+' added.
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff returns a unified diff of two texts, or "" if they are equal.
+func unifiedDiff(oldText, newText, oldName, newName string) string {
+	if oldText == newText {
+		return ""
+	}
+	lines := diffLines(splitLines(oldText), splitLines(newText))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+
+	// aPos/bPos[i] are the line numbers (0-based) before lines[i].
+	aPos := make([]int, len(lines)+1)
+	bPos := make([]int, len(lines)+1)
+	for i, l := range lines {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+		if l.op != '+' {
+			aPos[i+1]++
+		}
+		if l.op != '-' {
+			bPos[i+1]++
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		if lines[i].op == ' ' {
+			i++
+			continue
+		}
+		// Extend the hunk while changes are within 2*context of each other.
+		start := max(i-diffContext, 0)
+		end := i
+		for j := i; j < len(lines); j++ {
+			if lines[j].op != ' ' {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		end = min(end+diffContext, len(lines))
+
+		aCount, bCount := aPos[end]-aPos[start], bPos[end]-bPos[start]
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(aPos[start], aCount), hunkRange(bPos[start], bCount))
+		for _, l := range lines[start:end] {
+			b.WriteByte(l.op)
+			b.WriteString(l.text)
+			b.WriteByte('\n')
+		}
+		i = end
+	}
+	return b.String()
+}
+
+func hunkRange(pos, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", pos)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", pos+1)
+	}
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes a line edit script via LCS after trimming the common
+// prefix and suffix.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]diffLine, 0, len(a)+len(b))
+	for _, l := range a[:prefix] {
+		out = append(out, diffLine{' ', l})
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	n, m := len(ma), len(mb)
+	if n*m > maxDiffCells {
+		for _, l := range ma {
+			out = append(out, diffLine{'-', l})
+		}
+		for _, l := range mb {
+			out = append(out, diffLine{'+', l})
+		}
+	} else {
+		// lcs[i][j] is the LCS length of ma[i:] and mb[j:].
+		lcs := make([][]int, n+1)
+		for i := range lcs {
+			lcs[i] = make([]int, m+1)
+		}
+		for i := n - 1; i >= 0; i-- {
+			for j := m - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < n && j < m {
+			switch {
+			case ma[i] == mb[j]:
+				out = append(out, diffLine{' ', ma[i]})
+				i++
+				j++
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				out = append(out, diffLine{'-', ma[i]})
+				i++
+			default:
+				out = append(out, diffLine{'+', mb[j]})
+				j++
+			}
+		}
+		for ; i < n; i++ {
+			out = append(out, diffLine{'-', ma[i]})
+		}
+		for ; j < m; j++ {
+			out = append(out, diffLine{'+', mb[j]})
+		}
+	}
+
+	for _, l := range a[len(a)-suffix:] {
+		out = append(out, diffLine{' ', l})
+	}
+	return out
+}
+
+// Conflict markers written by ThreeWayMerge.
+const (
+	conflictOurs   = "<<<<<<< fork"
+	conflictSep    = "======="
+	conflictTheirs = ">>>>>>> "
+)
+
+// ThreeWayMerge merges the changes from base to theirs into ours, line by
+// line. Regions both sides changed differently are kept as conflict blocks
+// (ours first, then theirs labelled theirsLabel), and conflict is set.
+func ThreeWayMerge(base, ours, theirs, theirsLabel string) (merged string, conflict bool) {
+	switch {
+	case ours == theirs || theirs == base:
+		return ours, false
+	case ours == base:
+		return theirs, false
+	}
+
+	o, a, b := splitLines(base), splitLines(ours), splitLines(theirs)
+	matchA, matchB := baseMatches(o, a), baseMatches(o, b)
+
+	var out []string
+	oi, ai, bi := 0, 0, 0
+	for oi < len(o) || ai < len(a) || bi < len(b) {
+		// Stable run: base lines kept at the same offset on both sides.
+		n := 0
+		for oi+n < len(o) && matchA[oi+n] == ai+n && matchB[oi+n] == bi+n {
+			n++
+		}
+		if n > 0 {
+			out = append(out, o[oi:oi+n]...)
+			oi, ai, bi = oi+n, ai+n, bi+n
+			continue
+		}
+
+		// Unstable chunk up to the next base line both sides kept.
+		oj, aj, bj := len(o), len(a), len(b)
+		for j := oi; j < len(o); j++ {
+			if matchA[j] >= 0 && matchB[j] >= 0 {
+				oj, aj, bj = j, matchA[j], matchB[j]
+				break
+			}
+		}
+		oc, ac, bc := o[oi:oj], a[ai:aj], b[bi:bj]
+		switch {
+		case equalLines(ac, oc):
+			out = append(out, bc...)
+		case equalLines(bc, oc), equalLines(ac, bc):
+			out = append(out, ac...)
+		default:
+			conflict = true
+			out = append(out, conflictOurs)
+			out = append(out, ac...)
+			out = append(out, conflictSep)
+			out = append(out, bc...)
+			out = append(out, conflictTheirs+theirsLabel)
+		}
+		oi, ai, bi = oj, aj, bj
+	}
+
+	if len(out) == 0 {
+		return "", conflict
+	}
+	// The trailing newline follows ours unless only theirs changed it.
+	nl := strings.HasSuffix(ours, "\n")
+	if nl == strings.HasSuffix(base, "\n") {
+		nl = strings.HasSuffix(theirs, "\n")
+	}
+	merged = strings.Join(out, "\n")
+	if nl {
+		merged += "\n"
+	}
+	return merged, conflict
+}
+
+// baseMatches maps each line of base to its line in other when the edit
+// script keeps it, or -1.
+func baseMatches(base, other []string) []int {
+	m := make([]int, len(base))
+	oi, xi := 0, 0
+	for _, l := range diffLines(base, other) {
+		switch l.op {
+		case ' ':
+			m[oi] = xi
+			oi++
+			xi++
+		case '-':
+			m[oi] = -1
+			oi++
+		case '+':
+			xi++
+		}
+	}
+	return m
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,195 @@
+package skill
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/models"
+)
+
+// GitFetchOptions describes the branch of a repository to check out.
+type GitFetchOptions struct {
+	RepoURL    string
+	Branch     string
+	AuthType   string // models.SkillSourceAuth*
+	Credential string // token or SSH private key
+}
+
+// FetchGitSource shallow-clones the branch into dir, which must not exist,
+// and returns the checked-out commit. Credentials are passed through the
+// environment so they never appear on a command line.
+func FetchGitSource(ctx context.Context, opts GitFetchOptions, dir string) (string, error) {
+	if opts.Branch == "" || strings.HasPrefix(opts.Branch, "-") {
+		return "", fmt.Errorf("invalid branch %q", opts.Branch)
+	}
+
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ASKPASS=true", "SSH_ASKPASS=true")
+	switch opts.AuthType {
+	case models.SkillSourceAuthToken:
+		basic := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + opts.Credential))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+basic,
+		)
+	case models.SkillSourceAuthDeployKey:
+		keyDir, err := os.MkdirTemp("", "sac-deploy-key-*")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(keyDir)
+		keyFile := filepath.Join(keyDir, "id")
+		key := strings.TrimSpace(opts.Credential) + "\n"
+		if err := os.WriteFile(keyFile, []byte(key), 0o600); err != nil {
+			return "", err
+		}
+		// Host keys are accepted on first use; the known_hosts file lives
+		// only as long as this fetch.
+		env = append(env, fmt.Sprintf(
+			"GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes -o BatchMode=yes -o StrictHostKeyChecking=accept-new -o UserKnownHostsFile=%s",
+			keyFile, filepath.Join(keyDir, "known_hosts")))
+	}
+
+	if _, err := runGit(ctx, env, "", "clone", "--depth", "1", "--single-branch", "--no-tags",
+		"--branch", opts.Branch, "--", opts.RepoURL, dir); err != nil {
+		return "", err
+	}
+	commit, err := runGit(ctx, env, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(commit), nil
+}
+
+func runGit(ctx context.Context, env []string, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = env
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return stdout.String(), nil
+}
+
+// SourceSkill is a skill directory found in a source checkout.
+type SourceSkill struct {
+	Path    string       // directory name below the source subdir
+	Dir     string       // absolute directory in the checkout
+	SkillMD string       // SKILL.md content
+	Files   []SourceFile // attached files, SKILL.md excluded
+}
+
+// SourceFile is an attached file of a SourceSkill.
+type SourceFile struct {
+	Path string // relative to the skill directory, slash-separated
+	Size int64
+}
+
+// ScanSourceTree returns the skill directories (<subdir>/*/SKILL.md) of a
+// checkout, sorted by path. Symlinks below the subdir and dot-directories
+// are ignored, and a subdir resolving outside the checkout is rejected, so a
+// repository cannot pull in files from outside the checkout.
+func ScanSourceTree(checkout, subdir string) ([]SourceSkill, error) {
+	subdir = strings.Trim(path.Clean("/"+subdir), "/")
+
+	// The subdir may run through symlinks committed to the repository;
+	// resolve them and make sure it still lies inside the checkout.
+	base, err := filepath.EvalSymlinks(checkout)
+	if err != nil {
+		return nil, err
+	}
+	root, err := filepath.EvalSymlinks(filepath.Join(base, filepath.FromSlash(subdir)))
+	if err != nil {
+		return nil, fmt.Errorf("directory %q not found in repository", subdir)
+	}
+	if rel, err := filepath.Rel(base, root); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("directory %q is outside the repository", subdir)
+	}
+
+	info, err := os.Stat(root)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("directory %q not found in repository", subdir)
+	}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var skills []SourceSkill
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		dir := filepath.Join(root, e.Name())
+		mdInfo, err := os.Lstat(filepath.Join(dir, "SKILL.md"))
+		if err != nil || !mdInfo.Mode().IsRegular() {
+			continue
+		}
+		if mdInfo.Size() > maxSkillMDSize {
+			return nil, fmt.Errorf("%s/SKILL.md is too large", e.Name())
+		}
+		md, err := os.ReadFile(filepath.Join(dir, "SKILL.md"))
+		if err != nil {
+			return nil, err
+		}
+		files, err := scanSkillDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+		skills = append(skills, SourceSkill{Path: e.Name(), Dir: dir, SkillMD: string(md), Files: files})
+	}
+	sort.Slice(skills, func(i, j int) bool { return skills[i].Path < skills[j].Path })
+	return skills, nil
+}
+
+func scanSkillDir(dir string) ([]SourceFile, error) {
+	var files []SourceFile
+	var total int64
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, p)
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel != "." && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || rel == "SKILL.md" || d.Name() == ".DS_Store" {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, SourceFile{Path: rel, Size: info.Size()})
+		total += info.Size()
+		if len(files) > maxImportFiles {
+			return fmt.Errorf("more than %d files", maxImportFiles)
+		}
+		if total > maxImportTotalSize {
+			return errors.New("skill content is too large")
+		}
+		return nil
+	})
+	return files, err
+}
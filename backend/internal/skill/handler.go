@@ -3,7 +3,6 @@ package skill
 import (
 	"bytes"
 	"context"
-	"crypto/md5"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -15,6 +14,7 @@ import (
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/container"
 	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
 	"g.echo.tech/dev/sac/internal/models"
 	"g.echo.tech/dev/sac/internal/storage"
 	"g.echo.tech/dev/sac/pkg/protobind"
@@ -40,6 +40,12 @@ func (h *Handler) GetSyncService() *SyncService {
 	return h.syncService
 }
 
+// userContext returns a background context carrying the caller's user ID,
+// so revisions recorded by RebuildSkillBundle are attributed.
+func userContext(c *gin.Context) context.Context {
+	return context.WithValue(context.Background(), ctxkeys.UserIDKey, c.GetInt64("userID"))
+}
+
 // RegisterFileRoutes registers skill file management routes (multipart upload, not suitable for gRPC-gateway).
 func (h *Handler) RegisterFileRoutes(router *gin.RouterGroup) {
 	router.POST("/skills/:id/files", h.UploadSkillFile)
@@ -50,6 +56,37 @@ func (h *Handler) RegisterFileRoutes(router *gin.RouterGroup) {
 	router.GET("/skills/:id/files/content", h.GetSkillFileContent)
 }
 
+// storeSkillFile uploads a skill file to S3, computing its MD5 checksum on
+// the way, and upserts the skill_files record.
+func storeSkillFile(ctx context.Context, db bun.IDB, backend storage.StorageBackend, skillID int64, filepath, contentType string, r io.Reader, size int64) (*models.SkillFile, error) {
+	s3Key := fmt.Sprintf("skills/%d/%s", skillID, filepath)
+	hash := newFileHash()
+	if err := backend.Upload(ctx, s3Key, io.TeeReader(r, hash), size, contentType); err != nil {
+		return nil, err
+	}
+
+	sf := &models.SkillFile{
+		SkillID:     skillID,
+		Filepath:    filepath,
+		S3Key:       s3Key,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		Size:        size,
+		ContentType: contentType,
+		CreatedAt:   time.Now(),
+	}
+	_, err := db.NewInsert().Model(sf).
+		On("CONFLICT (skill_id, filepath) DO UPDATE").
+		Set("s3_key = EXCLUDED.s3_key").
+		Set("checksum = EXCLUDED.checksum").
+		Set("size = EXCLUDED.size").
+		Set("content_type = EXCLUDED.content_type").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save file record: %w", err)
+	}
+	return sf, nil
+}
+
 // UploadSkillFile handles multipart file upload for a skill.
 func (h *Handler) UploadSkillFile(c *gin.Context) {
 	skillID, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -57,6 +94,9 @@ func (h *Handler) UploadSkillFile(c *gin.Context) {
 		response.BadRequest(c, "Invalid skill ID", err)
 		return
 	}
+	if h.rejectSourceManaged(c, skillID) {
+		return
+	}
 
 	file, header, err := c.Request.FormFile("file")
 	if err != nil {
@@ -80,52 +120,42 @@ func (h *Handler) UploadSkillFile(c *gin.Context) {
 		return
 	}
 
-	s3Key := fmt.Sprintf("skills/%d/%s", skillID, filepath)
 	contentType := header.Header.Get("Content-Type")
 	if contentType == "" {
 		contentType = "application/octet-stream"
 	}
 
-	// Read file and compute MD5 checksum
-	ctx := context.Background()
-	data, err := io.ReadAll(file)
-	if err != nil {
-		response.InternalError(c, "Failed to read file", err)
-		return
+	ctx := userContext(c)
+	cfg := loadLintConfig(ctx, h.db)
+	lf := LintFile{Path: filepath, Size: header.Size}
+	var body io.Reader = file
+	if isTextContentType(contentType) && header.Size <= cfg.MaxFileBytes {
+		data, err := io.ReadAll(io.LimitReader(file, header.Size))
+		if err != nil {
+			response.BadRequest(c, "Failed to read file", err)
+			return
+		}
+		lf.Content, lf.Text = data, true
+		body = bytes.NewReader(data)
 	}
-	hash := md5.Sum(data)
-	checksum := hex.EncodeToString(hash[:])
-
-	if err := backend.Upload(ctx, s3Key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
-		response.InternalError(c, "Failed to upload file", err)
+	if errs := lintErrors(LintSkillFile(cfg, lf)); len(errs) > 0 {
+		response.BadRequest(c, "File validation failed: "+lintSummary(errs))
 		return
 	}
-
-	sf := &models.SkillFile{
-		SkillID:     skillID,
-		Filepath:    filepath,
-		S3Key:       s3Key,
-		Checksum:    checksum,
-		Size:        int64(len(data)),
-		ContentType: contentType,
-		CreatedAt:   time.Now(),
+	if err := checkSkillSize(ctx, h.db, cfg, skillID, filepath, header.Size); err != nil {
+		response.BadRequest(c, "Skill is too large: "+err.Error())
+		return
 	}
 
-	_, err = h.db.NewInsert().Model(sf).
-		On("CONFLICT (skill_id, filepath) DO UPDATE").
-		Set("s3_key = EXCLUDED.s3_key").
-		Set("checksum = EXCLUDED.checksum").
-		Set("size = EXCLUDED.size").
-		Set("content_type = EXCLUDED.content_type").
-		Exec(ctx)
+	sf, err := storeSkillFile(ctx, h.db, backend, skillID, filepath, contentType, body, header.Size)
 	if err != nil {
-		response.InternalError(c, "Failed to save file record", err)
+		response.InternalError(c, "Failed to upload file", err)
 		return
 	}
 
 	// Recompute content_checksum and bump version
-	if err := h.syncService.RebuildSkillBundle(ctx, skillID); err != nil {
-		response.InternalError(c, "Failed to recompute checksum", err)
+	if err := h.syncService.RebuildSkillBundle(ctx, skillID, "Uploaded "+filepath); err != nil {
+		response.InternalError(c, "Failed to rebuild skill bundle", err)
 		return
 	}
 
@@ -158,6 +188,9 @@ func (h *Handler) DeleteSkillFile(c *gin.Context) {
 		response.BadRequest(c, "Invalid skill ID", err)
 		return
 	}
+	if h.rejectSourceManaged(c, skillID) {
+		return
+	}
 
 	filepath := c.Query("path")
 	if filepath == "" {
@@ -165,7 +198,7 @@ func (h *Handler) DeleteSkillFile(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := userContext(c)
 
 	// Directory delete: path ends with "/"
 	if strings.HasSuffix(filepath, "/") {
@@ -206,8 +239,8 @@ func (h *Handler) DeleteSkillFile(c *gin.Context) {
 	}
 
 	// Recompute content_checksum and bump version
-	if err := h.syncService.RebuildSkillBundle(ctx, skillID); err != nil {
-		response.InternalError(c, "Failed to recompute checksum", err)
+	if err := h.syncService.RebuildSkillBundle(ctx, skillID, "Deleted "+filepath); err != nil {
+		response.InternalError(c, "Failed to rebuild skill bundle", err)
 		return
 	}
 
@@ -266,6 +299,9 @@ func (h *Handler) SaveSkillFileContent(c *gin.Context) {
 		response.BadRequest(c, "Invalid skill ID", err)
 		return
 	}
+	if h.rejectSourceManaged(c, skillID) {
+		return
+	}
 
 	req := &sacv1.SaveSkillFileContentRequest{}
 	if !protobind.Bind(c, req) {
@@ -287,45 +323,27 @@ func (h *Handler) SaveSkillFileContent(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	s3Key := fmt.Sprintf("skills/%d/%s", skillID, req.Filepath)
-	contentType := "text/plain"
-
-	// Compute MD5 checksum
-	contentBytes := []byte(req.Content)
-	hash := md5.Sum(contentBytes)
-	checksum := hex.EncodeToString(hash[:])
-
-	if err := backend.Upload(ctx, s3Key, strings.NewReader(req.Content), int64(len(req.Content)), contentType); err != nil {
-		response.InternalError(c, "Failed to upload file content", err)
+	ctx := userContext(c)
+	cfg := loadLintConfig(ctx, h.db)
+	lf := LintFile{Path: req.Filepath, Size: int64(len(req.Content)), Content: []byte(req.Content), Text: true}
+	if errs := lintErrors(LintSkillFile(cfg, lf)); len(errs) > 0 {
+		response.BadRequest(c, "File validation failed: "+lintSummary(errs))
 		return
 	}
-
-	sf := &models.SkillFile{
-		SkillID:     skillID,
-		Filepath:    req.Filepath,
-		S3Key:       s3Key,
-		Checksum:    checksum,
-		Size:        int64(len(req.Content)),
-		ContentType: contentType,
-		CreatedAt:   time.Now(),
+	if err := checkSkillSize(ctx, h.db, cfg, skillID, req.Filepath, lf.Size); err != nil {
+		response.BadRequest(c, "Skill is too large: "+err.Error())
+		return
 	}
 
-	_, err = h.db.NewInsert().Model(sf).
-		On("CONFLICT (skill_id, filepath) DO UPDATE").
-		Set("s3_key = EXCLUDED.s3_key").
-		Set("checksum = EXCLUDED.checksum").
-		Set("size = EXCLUDED.size").
-		Set("content_type = EXCLUDED.content_type").
-		Exec(ctx)
+	sf, err := storeSkillFile(ctx, h.db, backend, skillID, req.Filepath, "text/plain", strings.NewReader(req.Content), int64(len(req.Content)))
 	if err != nil {
-		response.InternalError(c, "Failed to save file record", err)
+		response.InternalError(c, "Failed to upload file content", err)
 		return
 	}
 
 	// Recompute content_checksum and bump version
-	if err := h.syncService.RebuildSkillBundle(ctx, skillID); err != nil {
-		response.InternalError(c, "Failed to recompute checksum", err)
+	if err := h.syncService.RebuildSkillBundle(ctx, skillID, "Edited "+req.Filepath); err != nil {
+		response.InternalError(c, "Failed to rebuild skill bundle", err)
 		return
 	}
 
@@ -0,0 +1,283 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// Parameter types.
+const (
+	ParamText   = "text"
+	ParamSelect = "select"
+	ParamDate   = "date"
+	ParamNumber = "number"
+)
+
+// paramDateLayout is the format of date parameter values.
+const paramDateLayout = "2006-01-02"
+
+// Markers around the generated arguments section of SKILL.md, so importing
+// an exported SKILL.md does not duplicate it into the prompt.
+const (
+	argumentsBegin = "<!-- sac:arguments -->"
+	argumentsEnd   = "<!-- /sac:arguments -->"
+)
+
+// parameterErrors explains what is wrong with a skill's parameter
+// definitions, in order.
+func parameterErrors(params models.SkillParameters) []string {
+	var errs []string
+	seen := make(map[string]bool, len(params))
+	for i, p := range params {
+		name := p.Name
+		if name == "" {
+			errs = append(errs, fmt.Sprintf("parameter %d has no name", i+1))
+			continue
+		}
+		if strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+			errs = append(errs, fmt.Sprintf("parameter %q: name must not contain spaces", name))
+		}
+		if seen[name] {
+			errs = append(errs, fmt.Sprintf("parameter %q is defined twice", name))
+		}
+		seen[name] = true
+		switch p.Type {
+		case ParamText, ParamDate, ParamNumber:
+		case ParamSelect:
+			if len(p.Options) == 0 {
+				errs = append(errs, fmt.Sprintf("parameter %q: select needs options", name))
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("parameter %q: unknown type %q", name, p.Type))
+			continue
+		}
+		if p.DefaultValue != "" {
+			if msg := parameterValueError(p, p.DefaultValue); msg != "" {
+				errs = append(errs, fmt.Sprintf("parameter %q: default %s", name, msg))
+			}
+		}
+	}
+	return errs
+}
+
+// parameterValueError explains why value is not acceptable for p, or
+// returns "".
+func parameterValueError(p models.SkillParameter, value string) string {
+	if strings.IndexFunc(value, unicode.IsControl) >= 0 {
+		return "must not contain control characters or line breaks"
+	}
+	switch p.Type {
+	case ParamSelect:
+		for _, o := range p.Options {
+			if o == value {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of: %s", strings.Join(p.Options, ", "))
+	case ParamDate:
+		if _, err := time.Parse(paramDateLayout, value); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	case ParamNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "must be a number"
+		}
+	}
+	return ""
+}
+
+// argumentHint derives the argument_hint shown by Claude Code's command
+// menu, e.g. "<branch> [base] [mode:fast|full]".
+func argumentHint(params models.SkillParameters) string {
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		text := p.Name
+		if p.Type == ParamSelect && len(p.Options) > 0 {
+			text += ":" + strings.Join(p.Options, "|")
+		}
+		if p.Required {
+			parts = append(parts, "<"+text+">")
+		} else {
+			parts = append(parts, "["+text+"]")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// argumentsSection documents the positional arguments at the end of
+// SKILL.md, so Claude knows which of $1, $2, ... holds which parameter.
+func argumentsSection(params models.SkillParameters) string {
+	var b strings.Builder
+	b.WriteString(argumentsBegin + "\n## Arguments\n\n")
+	b.WriteString("The command is invoked with these positional arguments ($ARGUMENTS holds all of them):\n\n")
+	for i, p := range params {
+		fmt.Fprintf(&b, "- `$%d` %s", i+1, p.Name)
+		if p.Label != "" && p.Label != p.Name {
+			fmt.Fprintf(&b, " (%s)", p.Label)
+		}
+		var notes []string
+		if p.Required {
+			notes = append(notes, "required")
+		} else {
+			notes = append(notes, "optional")
+		}
+		switch p.Type {
+		case ParamSelect:
+			notes = append(notes, "one of: "+strings.Join(p.Options, ", "))
+		case ParamDate:
+			notes = append(notes, "date, YYYY-MM-DD")
+		case ParamNumber:
+			notes = append(notes, "number")
+		}
+		if p.DefaultValue != "" {
+			notes = append(notes, "default: "+p.DefaultValue)
+		}
+		fmt.Fprintf(&b, ": %s\n", strings.Join(notes, "; "))
+	}
+	b.WriteString(argumentsEnd + "\n")
+	return b.String()
+}
+
+// stripArgumentsSection removes a generated arguments section from a
+// SKILL.md body.
+func stripArgumentsSection(body string) string {
+	start := strings.Index(body, argumentsBegin)
+	if start < 0 {
+		return body
+	}
+	end := strings.Index(body[start:], argumentsEnd)
+	if end < 0 {
+		return body
+	}
+	end += start + len(argumentsEnd)
+	return strings.TrimRight(body[:start], "\n") + strings.TrimPrefix(body[end:], "\n")
+}
+
+// RenderInvocation validates parameter values and builds the slash command
+// invoking the skill. Missing values fall back to the parameter default;
+// empty trailing optional arguments are left out.
+func RenderInvocation(sk *models.Skill, values map[string]string) (string, []string, error) {
+	known := make(map[string]bool, len(sk.Parameters))
+	for _, p := range sk.Parameters {
+		known[p.Name] = true
+	}
+	for name := range values {
+		if !known[name] {
+			return "", nil, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+
+	args := make([]string, len(sk.Parameters))
+	for i, p := range sk.Parameters {
+		v, ok := values[p.Name]
+		if !ok || v == "" {
+			v = p.DefaultValue
+		}
+		if v == "" {
+			if p.Required {
+				return "", nil, fmt.Errorf("%s is required", p.Name)
+			}
+			continue
+		}
+		if msg := parameterValueError(p, v); msg != "" {
+			return "", nil, fmt.Errorf("%s %s", p.Name, msg)
+		}
+		args[i] = v
+	}
+	for len(args) > 0 && args[len(args)-1] == "" {
+		args = args[:len(args)-1]
+	}
+
+	var b strings.Builder
+	b.WriteString("/" + sk.CommandName)
+	for _, a := range args {
+		b.WriteString(" " + quoteArgument(a))
+	}
+	return b.String(), args, nil
+}
+
+// quoteArgument double-quotes an argument that would otherwise not be a
+// single word.
+func quoteArgument(a string) string {
+	if a != "" && !strings.ContainsAny(a, " \t\"'\\") {
+		return a
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(a) + `"`
+}
+
+func (s *Server) RenderSkillInvocation(ctx context.Context, req *sacv1.RenderSkillInvocationRequest) (*sacv1.RenderSkillInvocationResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if !s.canViewSkill(ctx, &sk, userID) {
+		return nil, grpcerr.NotFound("Skill not found")
+	}
+
+	invocation, args, err := RenderInvocation(&sk, req.Values)
+	if err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+	resp := &sacv1.RenderSkillInvocationResponse{Invocation: invocation, Arguments: args}
+	if req.AgentId == nil {
+		return resp, nil
+	}
+
+	agentID := *req.AgentId
+	owned, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ?", agentID).
+		Where("created_by = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load agent", err)
+	}
+	if !owned {
+		return nil, grpcerr.NotFound("Agent not found")
+	}
+	installed, err := s.db.NewSelect().Model((*models.AgentSkill)(nil)).
+		Where("agent_id = ?", agentID).
+		Where("skill_id = ?", sk.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to check skill installation", err)
+	}
+	if !installed {
+		return nil, grpcerr.BadRequest("Skill is not installed on this agent")
+	}
+	running, err := s.db.NewSelect().Model((*models.Session)(nil)).
+		Where("user_id = ?", userID).
+		Where("agent_id = ?", agentID).
+		Where("status = ?", models.SessionStatusRunning).
+		Where("pod_ip != ''").
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load session", err)
+	}
+	if !running || s.syncService.containerManager == nil {
+		return nil, grpcerr.Unavailable("No running session for this agent")
+	}
+
+	input := invocation
+	if req.Submit {
+		input += "\r"
+	}
+	if err := s.syncService.containerManager.SendTerminalInput(ctx, s.syncService.podName(fmt.Sprint(userID), agentID), input); err != nil {
+		return nil, grpcerr.Internal("Failed to send the command to the terminal", err)
+	}
+	log.Info().Int64("skill_id", sk.ID).Int64("agent_id", agentID).Bool("submit", req.Submit).Msg("sent skill invocation to terminal")
+	resp.Sent = true
+	return resp, nil
+}
@@ -0,0 +1,428 @@
+package skill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lint severities. A rule set to LintOff is not checked.
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+	LintOff     = "off"
+)
+
+// Lint rules.
+const (
+	RuleEmptyPrompt         = "empty_prompt"
+	RuleInvalidCommandName  = "invalid_command_name"
+	RuleMissingDescription  = "missing_description"
+	RuleUnknownTool         = "unknown_tool"
+	RuleInvalidModel        = "invalid_model"
+	RuleInvalidContext      = "invalid_context"
+	RuleMissingArgumentHint = "missing_argument_hint"
+	RuleInvalidParameter    = "invalid_parameter"
+	RuleSkillMDTooLarge     = "skill_md_too_large"
+	RuleFileTooLarge        = "file_too_large"
+	RuleNonUTF8             = "non_utf8"
+	RuleUnsafePath          = "unsafe_path"
+)
+
+// lintSettingKey is the system setting holding the admin's LintConfig.
+const lintSettingKey = "skill_lint_rules"
+
+// defaultLintSeverity is the severity of each rule unless overridden.
+var defaultLintSeverity = map[string]string{
+	RuleEmptyPrompt:         LintError,
+	RuleInvalidCommandName:  LintError,
+	RuleMissingDescription:  LintWarning,
+	RuleUnknownTool:         LintWarning,
+	RuleInvalidModel:        LintError,
+	RuleInvalidContext:      LintWarning,
+	RuleMissingArgumentHint: LintWarning,
+	RuleInvalidParameter:    LintError,
+	RuleSkillMDTooLarge:     LintError,
+	RuleFileTooLarge:        LintError,
+	RuleNonUTF8:             LintError,
+	RuleUnsafePath:          LintError,
+}
+
+// knownTools are the Claude Code tools allowed_tools may name. MCP tools
+// (mcp__server or mcp__server__tool) are always accepted.
+var knownTools = []string{
+	"Agent", "AskUserQuestion", "Bash", "BashOutput", "Edit", "ExitPlanMode",
+	"Glob", "Grep", "KillShell", "LS", "MultiEdit", "NotebookEdit",
+	"NotebookRead", "Read", "Skill", "SlashCommand", "Task", "TodoRead",
+	"TodoWrite", "WebFetch", "WebSearch", "Write",
+}
+
+// modelPattern accepts Claude Code model aliases and full model names.
+var modelPattern = regexp.MustCompile(`^(sonnet|opus|haiku|opusplan|inherit|claude-[a-z0-9.-]+)(\[1m\])?$`)
+
+// argumentPattern finds argument placeholders in a prompt.
+var argumentPattern = regexp.MustCompile(`\$(ARGUMENTS|[0-9])`)
+
+// LintConfig is the admin-configurable rule set, stored as JSON in the
+// skill_lint_rules system setting.
+type LintConfig struct {
+	// Rules overrides the severity of a rule: error, warning or off.
+	Rules map[string]string `json:"rules,omitempty"`
+	// ExtraTools are accepted in allowed_tools besides the built-in tools.
+	ExtraTools []string `json:"extra_tools,omitempty"`
+	// AllowedModels, when set, is the exhaustive list of accepted models.
+	AllowedModels   []string `json:"allowed_models,omitempty"`
+	MaxSkillMDBytes int64    `json:"max_skill_md_bytes,omitempty"`
+	MaxFileBytes    int64    `json:"max_file_bytes,omitempty"`
+	// MaxSkillBytes bounds the attached files of a skill together.
+	MaxSkillBytes int64 `json:"max_skill_bytes,omitempty"`
+}
+
+// DefaultLintConfig returns the configuration used when the setting is
+// missing or invalid.
+func DefaultLintConfig() LintConfig {
+	return LintConfig{
+		MaxSkillMDBytes: 100 << 10,
+		MaxFileBytes:    10 << 20,
+		MaxSkillBytes:   50 << 20,
+	}
+}
+
+// ParseLintConfig decodes a skill_lint_rules setting value on top of the
+// defaults and rejects unknown rules and severities.
+func ParseLintConfig(data []byte) (LintConfig, error) {
+	cfg := DefaultLintConfig()
+	if len(data) == 0 || string(data) == "null" {
+		return cfg, nil
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return DefaultLintConfig(), fmt.Errorf("invalid %s: %w", lintSettingKey, err)
+	}
+	for rule, sev := range cfg.Rules {
+		if _, ok := defaultLintSeverity[rule]; !ok {
+			return DefaultLintConfig(), fmt.Errorf("unknown lint rule %q", rule)
+		}
+		if sev != LintError && sev != LintWarning && sev != LintOff {
+			return DefaultLintConfig(), fmt.Errorf("rule %s: severity must be error, warning or off", rule)
+		}
+	}
+	if cfg.MaxSkillMDBytes <= 0 {
+		cfg.MaxSkillMDBytes = DefaultLintConfig().MaxSkillMDBytes
+	}
+	if cfg.MaxFileBytes <= 0 {
+		cfg.MaxFileBytes = DefaultLintConfig().MaxFileBytes
+	}
+	if cfg.MaxSkillBytes <= 0 {
+		cfg.MaxSkillBytes = DefaultLintConfig().MaxSkillBytes
+	}
+	return cfg, nil
+}
+
+func (c *LintConfig) severity(rule string) string {
+	if sev, ok := c.Rules[rule]; ok {
+		return sev
+	}
+	return defaultLintSeverity[rule]
+}
+
+// loadLintConfig reads the admin's rule set, falling back to the defaults.
+func loadLintConfig(ctx context.Context, db bun.IDB) LintConfig {
+	var value models.SettingValue
+	err := db.NewSelect().Model((*models.SystemSetting)(nil)).
+		Column("value").
+		Where("key = ?", lintSettingKey).
+		Scan(ctx, &value)
+	if err != nil {
+		return DefaultLintConfig()
+	}
+	cfg, err := ParseLintConfig(value)
+	if err != nil {
+		log.Warn().Err(err).Msg("ignoring invalid skill lint configuration")
+	}
+	return cfg
+}
+
+// LintIssue is a problem found in a skill.
+type LintIssue struct {
+	Rule     string
+	Severity string
+	Field    string // e.g. "frontmatter.model" or "files/a.txt"
+	Message  string
+}
+
+// LintFile describes an attached file to validate. Content is only checked
+// for UTF-8 when Text is set.
+type LintFile struct {
+	Path    string
+	Size    int64
+	Content []byte
+	Text    bool
+}
+
+// linter collects issues, dropping rules that are switched off.
+type linter struct {
+	cfg    *LintConfig
+	issues []LintIssue
+}
+
+func (l *linter) add(rule, field, format string, args ...any) {
+	sev := l.cfg.severity(rule)
+	if sev == LintOff {
+		return
+	}
+	l.issues = append(l.issues, LintIssue{Rule: rule, Severity: sev, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// LintSkill validates a skill's metadata, SKILL.md and attached files.
+func LintSkill(cfg LintConfig, sk *models.Skill, files []LintFile) []LintIssue {
+	l := &linter{cfg: &cfg}
+
+	if strings.TrimSpace(sk.Prompt) == "" {
+		l.add(RuleEmptyPrompt, "prompt", "prompt is empty")
+	}
+	if sk.CommandName == "" || SanitizeCommandName(sk.CommandName) != sk.CommandName || len(sk.CommandName) > 64 {
+		l.add(RuleInvalidCommandName, "command_name",
+			"command name %q must be 1-64 lowercase letters, digits and hyphens", sk.CommandName)
+	}
+	if strings.TrimSpace(sk.Description) == "" {
+		l.add(RuleMissingDescription, "description", "description is empty; Claude uses it to decide when to invoke the skill")
+	}
+	for _, f := range []struct{ field, text string }{
+		{"name", sk.Name}, {"description", sk.Description}, {"prompt", sk.Prompt},
+	} {
+		if !utf8.ValidString(f.text) {
+			l.add(RuleNonUTF8, f.field, "%s is not valid UTF-8", f.field)
+		}
+	}
+
+	fm := &sk.Frontmatter
+	for _, tool := range fm.AllowedTools {
+		if !cfg.knownTool(tool) {
+			l.add(RuleUnknownTool, "frontmatter.allowed_tools", "unknown tool %q in allowed_tools", tool)
+		}
+	}
+	if fm.Model != "" && !cfg.validModel(fm.Model) {
+		l.add(RuleInvalidModel, "frontmatter.model", "model %q is not supported", fm.Model)
+	}
+	if fm.Context != "" && fm.Context != "fork" {
+		l.add(RuleInvalidContext, "frontmatter.context", "context must be empty or \"fork\", got %q", fm.Context)
+	}
+	if fm.Agent != "" && fm.Context != "fork" {
+		l.add(RuleInvalidContext, "frontmatter.agent", "agent is only used with context: fork")
+	}
+	// With parameters, buildSkillMD generates the hint.
+	if fm.ArgumentHint == "" && len(sk.Parameters) == 0 && argumentPattern.MatchString(sk.Prompt) {
+		l.add(RuleMissingArgumentHint, "frontmatter.argument_hint", "the skill takes arguments but has no argument_hint")
+	}
+
+	for _, msg := range parameterErrors(sk.Parameters) {
+		l.add(RuleInvalidParameter, "parameters", "%s", msg)
+	}
+
+	if size := int64(len(buildSkillMD(sk))); size > cfg.MaxSkillMDBytes {
+		l.add(RuleSkillMDTooLarge, "prompt", "SKILL.md is %d bytes, the limit is %d", size, cfg.MaxSkillMDBytes)
+	}
+
+	for _, f := range files {
+		l.lintFile(f)
+	}
+	return l.issues
+}
+
+// LintSkillFile validates a single attached file.
+func LintSkillFile(cfg LintConfig, f LintFile) []LintIssue {
+	l := &linter{cfg: &cfg}
+	l.lintFile(f)
+	return l.issues
+}
+
+func (l *linter) lintFile(f LintFile) {
+	field := "files/" + f.Path
+	if msg := unsafePathReason(f.Path); msg != "" {
+		l.add(RuleUnsafePath, field, "file path %q %s", f.Path, msg)
+	}
+	if f.Size > l.cfg.MaxFileBytes {
+		l.add(RuleFileTooLarge, field, "%s is %d bytes, the limit is %d", f.Path, f.Size, l.cfg.MaxFileBytes)
+	}
+	if f.Text && !utf8.Valid(f.Content) {
+		l.add(RuleNonUTF8, field, "%s is not valid UTF-8", f.Path)
+	}
+}
+
+// unsafePathReason explains why a skill file path cannot be written inside
+// the skill directory, or returns "".
+func unsafePathReason(p string) string {
+	switch {
+	case p == "":
+		return "is empty"
+	case strings.ContainsRune(p, 0) || strings.Contains(p, "\\"):
+		return "contains a NUL byte or backslash"
+	case strings.HasPrefix(p, "/"):
+		return "is absolute"
+	case path.Clean(p) != p || p == "." || strings.HasPrefix(p, "../") || p == "..":
+		return "is not a clean relative path"
+	case p == "SKILL.md" || p == ".checksum":
+		return "is reserved"
+	}
+	return ""
+}
+
+func (c *LintConfig) knownTool(spec string) bool {
+	name := spec
+	if i := strings.IndexByte(name, '('); i >= 0 && strings.HasSuffix(name, ")") {
+		name = name[:i]
+	}
+	name = strings.TrimSpace(name)
+	if strings.HasPrefix(name, "mcp__") && len(name) > len("mcp__") {
+		return true
+	}
+	for _, t := range knownTools {
+		if t == name {
+			return true
+		}
+	}
+	for _, t := range c.ExtraTools {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *LintConfig) validModel(model string) bool {
+	if len(c.AllowedModels) > 0 {
+		for _, m := range c.AllowedModels {
+			if m == model {
+				return true
+			}
+		}
+		return false
+	}
+	return modelPattern.MatchString(model)
+}
+
+// isTextContentType reports whether uploads of a content type are checked
+// for UTF-8.
+func isTextContentType(contentType string) bool {
+	mt, _, _ := mime.ParseMediaType(contentType)
+	switch mt {
+	case "application/json", "application/x-yaml", "application/yaml", "application/x-sh", "application/javascript":
+		return true
+	}
+	return strings.HasPrefix(mt, "text/")
+}
+
+// lintErrors returns the issues with error severity.
+func lintErrors(issues []LintIssue) []LintIssue {
+	var out []LintIssue
+	for _, is := range issues {
+		if is.Severity == LintError {
+			out = append(out, is)
+		}
+	}
+	return out
+}
+
+// lintSummary joins the messages of issues into one line.
+func lintSummary(issues []LintIssue) string {
+	msgs := make([]string, len(issues))
+	for i, is := range issues {
+		msgs[i] = is.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func lintResultToProto(issues []LintIssue) *sacv1.SkillValidationResult {
+	res := &sacv1.SkillValidationResult{Valid: len(lintErrors(issues)) == 0}
+	for _, is := range issues {
+		res.Issues = append(res.Issues, &sacv1.SkillLintIssue{
+			Rule:     is.Rule,
+			Severity: is.Severity,
+			Field:    is.Field,
+			Message:  is.Message,
+		})
+	}
+	return res
+}
+
+// lintStatusError returns an InvalidArgument error carrying the validation
+// result as a detail when issues contain errors, otherwise nil.
+func lintStatusError(issues []LintIssue) error {
+	errs := lintErrors(issues)
+	if len(errs) == 0 {
+		return nil
+	}
+	st := status.New(codes.InvalidArgument, "Skill validation failed: "+lintSummary(errs))
+	if withDetails, err := st.WithDetails(lintResultToProto(issues)); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// ValidateSkill runs the save-time checks without saving. With an id, the
+// skill's attached files are checked too.
+func (s *Server) ValidateSkill(ctx context.Context, req *sacv1.ValidateSkillRequest) (*sacv1.SkillValidationResult, error) {
+	if req.Skill == nil {
+		return nil, grpcerr.BadRequest("skill is required")
+	}
+	sk := models.Skill{
+		Name:        req.Skill.Name,
+		Description: req.Skill.Description,
+		Prompt:      req.Skill.Prompt,
+		CommandName: req.Skill.CommandName,
+		Parameters:  convert.SkillParametersFromProto(req.Skill.Parameters),
+		Frontmatter: convert.FrontmatterFromProto(req.Skill.Frontmatter),
+	}
+	if sk.CommandName == "" {
+		sk.CommandName = SanitizeCommandName(sk.Name)
+	}
+
+	var files []LintFile
+	var selfID int64
+	if req.Id != nil {
+		var existing models.Skill
+		if err := s.db.NewSelect().Model(&existing).Relation("Files").Where("sk.id = ?", *req.Id).Scan(ctx); err != nil {
+			return nil, grpcerr.NotFound("Skill not found", err)
+		}
+		if !canEditSkill(ctx, &existing) {
+			return nil, grpcerr.Forbidden("You don't have permission to update this skill")
+		}
+		selfID = existing.ID
+		for _, f := range existing.Files {
+			files = append(files, LintFile{Path: f.Filepath, Size: f.Size})
+		}
+	}
+
+	issues := LintSkill(loadLintConfig(ctx, s.db), &sk, files)
+	if sk.CommandName != "" {
+		taken, err := s.db.NewSelect().Model((*models.Skill)(nil)).
+			Where("command_name = ? AND id != ?", sk.CommandName, selfID).
+			Exists(ctx)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to check command name", err)
+		}
+		if taken {
+			issues = append(issues, LintIssue{
+				Rule:     "command_name_taken",
+				Severity: LintError,
+				Field:    "command_name",
+				Message:  fmt.Sprintf("command name '/%s' is already taken", sk.CommandName),
+			})
+		}
+	}
+	return lintResultToProto(issues), nil
+}
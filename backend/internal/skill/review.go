@@ -0,0 +1,540 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const maxReviewCommentLen = 4000
+
+// --- Published view ---
+
+// overlayRevision replaces a skill's content with that of a revision.
+func overlayRevision(sk *models.Skill, rev *models.SkillVersion) {
+	sk.Prompt = rev.Prompt
+	sk.Frontmatter = rev.Frontmatter
+	sk.Version = rev.Version
+	sk.ContentChecksum = rev.ContentChecksum
+	sk.Files = make([]models.SkillFile, 0, len(rev.Files))
+	for _, f := range rev.Files {
+		sk.Files = append(sk.Files, models.SkillFile{
+			SkillID:     sk.ID,
+			Filepath:    f.Filepath,
+			Checksum:    f.Checksum,
+			Size:        f.Size,
+			ContentType: f.ContentType,
+		})
+	}
+}
+
+// applyPublishedViews shows the published revision of skills owned by other
+// users instead of their unreviewed latest content.
+func (s *SyncService) applyPublishedViews(ctx context.Context, skills []models.Skill, viewerID int64) {
+	idx := make(map[int64]int)
+	for i := range skills {
+		sk := &skills[i]
+		if sk.CreatedBy == viewerID || sk.PublishedVersion == nil || *sk.PublishedVersion >= sk.Version {
+			continue
+		}
+		idx[sk.ID] = i
+	}
+	if len(idx) == 0 {
+		return
+	}
+
+	var revs []models.SkillVersion
+	err := s.db.NewSelect().Model(&revs).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for id, i := range idx {
+				q = q.WhereOr("skill_id = ? AND version = ?", id, *skills[i].PublishedVersion)
+			}
+			return q
+		}).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to load published skill revisions")
+		return
+	}
+	for i := range revs {
+		overlayRevision(&skills[idx[revs[i].SkillID]], &revs[i])
+	}
+}
+
+// applyPublishedView is applyPublishedViews for a single skill.
+func (s *SyncService) applyPublishedView(ctx context.Context, sk *models.Skill, viewerID int64) {
+	one := []models.Skill{*sk}
+	s.applyPublishedViews(ctx, one, viewerID)
+	*sk = one[0]
+}
+
+// --- Audit and notifications ---
+
+func (s *SyncService) recordAudit(ctx context.Context, db bun.IDB, ev *models.SkillAuditEvent) {
+	if ev.ActorID == nil {
+		if uid := ctxkeys.UserID(ctx); uid > 0 {
+			ev.ActorID = &uid
+		}
+	}
+	ev.CreatedAt = time.Now()
+	if _, err := db.NewInsert().Model(ev).Exec(ctx); err != nil {
+		log.Warn().Err(err).Int64("skill_id", ev.SkillID).Str("action", ev.Action).Msg("failed to record skill audit event")
+	}
+	log.Info().Int64("skill_id", ev.SkillID).Str("action", ev.Action).Int("version", ev.Version).Msg("skill audit")
+}
+
+// reviewerIDs returns the users who can decide a review.
+func (s *SyncService) reviewerIDs(ctx context.Context, rv *models.SkillReview) []int64 {
+	var ids []int64
+	q := s.db.NewSelect().Model((*models.User)(nil)).Column("id").Where("role = 'admin'")
+	if rv.Target == models.SkillReviewGroup && rv.GroupID != nil {
+		q = q.WhereOr("id IN (SELECT user_id FROM group_members WHERE group_id = ? AND role = 'admin')", *rv.GroupID)
+	}
+	if err := q.Scan(ctx, &ids); err != nil {
+		log.Warn().Err(err).Int64("review_id", rv.ID).Msg("failed to list skill reviewers")
+	}
+	return ids
+}
+
+func (s *SyncService) notifyReview(ctx context.Context, rv *models.SkillReview, sk *models.Skill) {
+	if rv.Status == models.SkillReviewPending {
+		for _, uid := range s.reviewerIDs(ctx, rv) {
+			if uid == rv.SubmittedBy {
+				continue
+			}
+			s.notifier.Notify(notify.Event{
+				Type:    notify.EventSkillReviewRequest,
+				UserID:  uid,
+				Title:   "Skill review requested",
+				Message: fmt.Sprintf("/%s v%d was submitted for %s publication", sk.CommandName, rv.Version, rv.Target),
+				Data:    map[string]any{"skill_id": sk.ID, "review_id": rv.ID, "version": rv.Version, "target": rv.Target},
+			})
+		}
+		return
+	}
+	if rv.ReviewedBy != nil && *rv.ReviewedBy == rv.SubmittedBy {
+		return
+	}
+	msg := fmt.Sprintf("/%s v%d was %s", sk.CommandName, rv.Version, rv.Status)
+	if rv.ReviewComment != "" {
+		msg += ": " + rv.ReviewComment
+	}
+	s.notifier.Notify(notify.Event{
+		Type:    notify.EventSkillReviewDecided,
+		UserID:  rv.SubmittedBy,
+		Title:   "Skill review " + rv.Status,
+		Message: msg,
+		Data:    map[string]any{"skill_id": sk.ID, "review_id": rv.ID, "version": rv.Version, "status": rv.Status},
+	})
+}
+
+// unpublishSkill withdraws a skill's pending review and hides it from other
+// users after its audience changed.
+func (s *SyncService) unpublishSkill(ctx context.Context, sk *models.Skill, reason string) error {
+	_, err := s.db.NewUpdate().Model((*models.SkillReview)(nil)).
+		Set("status = ?", models.SkillReviewWithdrawn).
+		Set("review_comment = ?", reason).
+		Where("skill_id = ? AND status = ?", sk.ID, models.SkillReviewPending).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if sk.PublishedVersion == nil {
+		return nil
+	}
+
+	_, err = s.db.NewUpdate().Model((*models.Skill)(nil)).
+		Set("published_version = NULL").
+		Where("id = ?", sk.ID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	s.recordAudit(ctx, s.db, &models.SkillAuditEvent{
+		SkillID: sk.ID,
+		Action:  models.SkillAuditUnpublished,
+		Version: *sk.PublishedVersion,
+		Comment: reason,
+	})
+	sk.PublishedVersion = nil
+	return nil
+}
+
+// --- Permissions ---
+
+func (s *Server) isGroupAdmin(ctx context.Context, groupID, userID int64) bool {
+	exists, _ := s.db.NewSelect().Model((*models.GroupMember)(nil)).
+		Where("group_id = ? AND user_id = ? AND role = 'admin'", groupID, userID).
+		Exists(ctx)
+	return exists
+}
+
+// canReview reports whether the caller may approve or reject rv: platform
+// admins for every target, group admins for their group's submissions.
+func (s *Server) canReview(ctx context.Context, rv *models.SkillReview) bool {
+	if ctxkeys.Role(ctx) == "admin" {
+		return true
+	}
+	return rv.Target == models.SkillReviewGroup && rv.GroupID != nil &&
+		s.isGroupAdmin(ctx, *rv.GroupID, ctxkeys.UserID(ctx))
+}
+
+// canEditSkill mirrors the permission checks of UpdateSkill.
+func canEditSkill(ctx context.Context, sk *models.Skill) bool {
+	if sk.IsOfficial {
+		return ctxkeys.Role(ctx) == "admin"
+	}
+	return sk.CreatedBy == ctxkeys.UserID(ctx)
+}
+
+// canAuditSkill reports whether the caller may read a skill's review history:
+// its owner, platform admins and admins of its group.
+func (s *Server) canAuditSkill(ctx context.Context, sk *models.Skill) bool {
+	if sk.CreatedBy == ctxkeys.UserID(ctx) || ctxkeys.Role(ctx) == "admin" {
+		return true
+	}
+	return sk.GroupID != nil && s.isGroupAdmin(ctx, *sk.GroupID, ctxkeys.UserID(ctx))
+}
+
+// --- RPCs ---
+
+// submitReview files a pending review of the skill's latest version.
+func (s *Server) submitReview(ctx context.Context, sk *models.Skill, target string, groupID *int64, comment string) (*models.SkillReview, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	switch target {
+	case models.SkillReviewPublic, models.SkillReviewOfficial:
+		groupID = nil
+	case models.SkillReviewGroup:
+		if groupID == nil || *groupID <= 0 {
+			return nil, grpcerr.BadRequest("group_id is required for group reviews")
+		}
+		if !s.isGroupMember(ctx, *groupID, userID) {
+			return nil, grpcerr.Forbidden("You are not a member of this group")
+		}
+	default:
+		return nil, grpcerr.BadRequest("target must be public, group or official")
+	}
+	if len(comment) > maxReviewCommentLen {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("comment must be at most %d characters", maxReviewCommentLen))
+	}
+	if sk.BundleError != "" {
+		return nil, grpcerr.BadRequest("The skill's bundle failed to build; fix it before submitting: " + sk.BundleError)
+	}
+	if sk.PublishedVersion != nil && *sk.PublishedVersion == sk.Version && publishedTo(sk, target, groupID) {
+		return nil, grpcerr.Conflict(fmt.Sprintf("Version %d is already published", sk.Version))
+	}
+
+	pending, err := s.db.NewSelect().Model((*models.SkillReview)(nil)).
+		Where("skill_id = ? AND status = ?", sk.ID, models.SkillReviewPending).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to check pending reviews", err)
+	}
+	if pending {
+		return nil, grpcerr.Conflict("A review is already pending for this skill; withdraw it first")
+	}
+
+	rv := &models.SkillReview{
+		SkillID:     sk.ID,
+		Version:     sk.Version,
+		Target:      target,
+		GroupID:     groupID,
+		Status:      models.SkillReviewPending,
+		Comment:     comment,
+		SubmittedBy: userID,
+		CreatedAt:   time.Now(),
+	}
+	if _, err := s.db.NewInsert().Model(rv).Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to submit skill for review", err)
+	}
+
+	s.syncService.recordAudit(ctx, s.db, &models.SkillAuditEvent{
+		SkillID:  sk.ID,
+		ReviewID: &rv.ID,
+		Action:   models.SkillAuditSubmitted,
+		Version:  rv.Version,
+		Comment:  comment,
+	})
+	s.syncService.notifyReview(ctx, rv, sk)
+	return rv, nil
+}
+
+// publishedTo reports whether a skill is already shared with the audience
+// of a review target.
+func publishedTo(sk *models.Skill, target string, groupID *int64) bool {
+	switch target {
+	case models.SkillReviewPublic:
+		return sk.IsPublic
+	case models.SkillReviewOfficial:
+		return sk.IsOfficial
+	case models.SkillReviewGroup:
+		return equalInt64Ptr(sk.GroupID, groupID)
+	}
+	return false
+}
+
+func (s *Server) SubmitSkillReview(ctx context.Context, req *sacv1.SubmitSkillReviewRequest) (*sacv1.SkillReview, error) {
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if !canEditSkill(ctx, &sk) {
+		return nil, grpcerr.Forbidden("You don't have permission to submit this skill")
+	}
+
+	rv, err := s.submitReview(ctx, &sk, req.Target, req.GroupId, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewToProto(ctx, rv.ID)
+}
+
+func (s *Server) ListSkillReviews(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.SkillReviewListResponse, error) {
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if !s.canAuditSkill(ctx, &sk) {
+		return nil, grpcerr.Forbidden("You don't have permission to view this skill's reviews")
+	}
+
+	var reviews []models.SkillReview
+	err := s.db.NewSelect().Model(&reviews).
+		Relation("Skill").Relation("Submitter").Relation("Reviewer").
+		Where("sr.skill_id = ?", req.Id).
+		Order("sr.created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list skill reviews", err)
+	}
+	return &sacv1.SkillReviewListResponse{Reviews: convert.SkillReviewsToProto(reviews)}, nil
+}
+
+func (s *Server) ListSkillAuditEvents(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.SkillAuditEventListResponse, error) {
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if !s.canAuditSkill(ctx, &sk) {
+		return nil, grpcerr.Forbidden("You don't have permission to view this skill's audit log")
+	}
+
+	var events []models.SkillAuditEvent
+	err := s.db.NewSelect().Model(&events).
+		Relation("Actor").
+		Where("sae.skill_id = ?", req.Id).
+		Order("sae.created_at DESC", "sae.id DESC").
+		Limit(500).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list skill audit events", err)
+	}
+	resp := &sacv1.SkillAuditEventListResponse{}
+	for i := range events {
+		resp.Events = append(resp.Events, convert.SkillAuditEventToProto(&events[i]))
+	}
+	return resp, nil
+}
+
+// ListSkillReviewQueue lists the reviews the caller can decide: all of them
+// for platform admins, their groups' submissions for group admins.
+func (s *Server) ListSkillReviewQueue(ctx context.Context, req *sacv1.ListSkillReviewQueueRequest) (*sacv1.SkillReviewListResponse, error) {
+	status := req.Status
+	switch status {
+	case "":
+		status = models.SkillReviewPending
+	case models.SkillReviewPending, models.SkillReviewApproved, models.SkillReviewRejected, models.SkillReviewWithdrawn:
+	default:
+		return nil, grpcerr.BadRequest("status must be pending, approved, rejected or withdrawn")
+	}
+
+	var reviews []models.SkillReview
+	q := s.db.NewSelect().Model(&reviews).
+		Relation("Skill").Relation("Submitter").Relation("Reviewer").
+		Where("sr.status = ?", status)
+	if ctxkeys.Role(ctx) != "admin" {
+		q = q.Where("sr.target = ?", models.SkillReviewGroup).
+			Where("sr.group_id IN (SELECT group_id FROM group_members WHERE user_id = ? AND role = 'admin')", ctxkeys.UserID(ctx))
+	}
+	if status == models.SkillReviewPending {
+		q = q.Order("sr.created_at ASC")
+	} else {
+		q = q.Order("sr.created_at DESC").Limit(200)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to list skill reviews", err)
+	}
+	return &sacv1.SkillReviewListResponse{Reviews: convert.SkillReviewsToProto(reviews)}, nil
+}
+
+func (s *Server) loadPendingReview(ctx context.Context, id int64) (*models.SkillReview, error) {
+	var rv models.SkillReview
+	if err := s.db.NewSelect().Model(&rv).Relation("Skill").Where("sr.id = ?", id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Review not found", err)
+	}
+	if rv.Skill == nil {
+		return nil, grpcerr.NotFound("Skill not found")
+	}
+	if rv.Status != models.SkillReviewPending {
+		return nil, grpcerr.Conflict(fmt.Sprintf("Review is already %s", rv.Status))
+	}
+	return &rv, nil
+}
+
+// decideReview moves a pending review to its final status. The status
+// condition makes concurrent decisions fail instead of overwriting each other.
+func (s *Server) decideReview(ctx context.Context, db bun.IDB, rv *models.SkillReview, status, comment string) error {
+	now := time.Now()
+	userID := ctxkeys.UserID(ctx)
+	res, err := db.NewUpdate().Model((*models.SkillReview)(nil)).
+		Set("status = ?", status).
+		Set("reviewed_by = ?", userID).
+		Set("review_comment = ?", comment).
+		Set("reviewed_at = ?", now).
+		Where("id = ? AND status = ?", rv.ID, models.SkillReviewPending).
+		Exec(ctx)
+	if err != nil {
+		return grpcerr.Internal("Failed to update review", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return grpcerr.Conflict("Review was already decided")
+	}
+	rv.Status, rv.ReviewedBy, rv.ReviewComment, rv.ReviewedAt = status, &userID, comment, &now
+	return nil
+}
+
+func (s *Server) ApproveSkillReview(ctx context.Context, req *sacv1.SkillReviewDecisionRequest) (*sacv1.SkillReview, error) {
+	rv, err := s.loadPendingReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canReview(ctx, rv) {
+		return nil, grpcerr.Forbidden("You cannot review this skill")
+	}
+	if len(req.Comment) > maxReviewCommentLen {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("comment must be at most %d characters", maxReviewCommentLen))
+	}
+	sk := rv.Skill
+
+	// Older revisions can only be delivered from their stored bundle.
+	if rv.Version != sk.Version {
+		available, err := s.db.NewSelect().Model((*models.SkillVersion)(nil)).
+			Where("skill_id = ? AND version = ?", sk.ID, rv.Version).
+			Where("bundle_key <> ''").
+			Exists(ctx)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to load skill version", err)
+		}
+		if !available {
+			return nil, grpcerr.Conflict(fmt.Sprintf("Version %d is no longer available; ask for a new submission", rv.Version))
+		}
+	}
+
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := s.decideReview(ctx, tx, rv, models.SkillReviewApproved, req.Comment); err != nil {
+			return err
+		}
+		q := tx.NewUpdate().Model((*models.Skill)(nil)).
+			Set("published_version = ?", rv.Version).
+			Where("id = ?", sk.ID)
+		switch rv.Target {
+		case models.SkillReviewPublic:
+			q = q.Set("is_public = true").Set("group_id = NULL")
+		case models.SkillReviewGroup:
+			q = q.Set("is_public = false").Set("group_id = ?", rv.GroupID)
+		case models.SkillReviewOfficial:
+			q = q.Set("is_official = true")
+		}
+		if _, err := q.Exec(ctx); err != nil {
+			return grpcerr.Internal("Failed to publish skill", err)
+		}
+		s.syncService.recordAudit(ctx, tx, &models.SkillAuditEvent{
+			SkillID:  sk.ID,
+			ReviewID: &rv.ID,
+			Action:   models.SkillAuditApproved,
+			Version:  rv.Version,
+			Comment:  req.Comment,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.syncService.notifyReview(ctx, rv, sk)
+	go s.syncService.ResyncSkill(context.Background(), sk.ID)
+	return s.reviewToProto(ctx, rv.ID)
+}
+
+func (s *Server) RejectSkillReview(ctx context.Context, req *sacv1.SkillReviewDecisionRequest) (*sacv1.SkillReview, error) {
+	rv, err := s.loadPendingReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canReview(ctx, rv) {
+		return nil, grpcerr.Forbidden("You cannot review this skill")
+	}
+	if req.Comment == "" {
+		return nil, grpcerr.BadRequest("comment is required when rejecting")
+	}
+	if len(req.Comment) > maxReviewCommentLen {
+		return nil, grpcerr.BadRequest(fmt.Sprintf("comment must be at most %d characters", maxReviewCommentLen))
+	}
+
+	if err := s.decideReview(ctx, s.db, rv, models.SkillReviewRejected, req.Comment); err != nil {
+		return nil, err
+	}
+	s.syncService.recordAudit(ctx, s.db, &models.SkillAuditEvent{
+		SkillID:  rv.SkillID,
+		ReviewID: &rv.ID,
+		Action:   models.SkillAuditRejected,
+		Version:  rv.Version,
+		Comment:  req.Comment,
+	})
+	s.syncService.notifyReview(ctx, rv, rv.Skill)
+	return s.reviewToProto(ctx, rv.ID)
+}
+
+func (s *Server) WithdrawSkillReview(ctx context.Context, req *sacv1.SkillReviewDecisionRequest) (*sacv1.SkillReview, error) {
+	rv, err := s.loadPendingReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if rv.SubmittedBy != ctxkeys.UserID(ctx) && !canEditSkill(ctx, rv.Skill) {
+		return nil, grpcerr.Forbidden("You can only withdraw your own submissions")
+	}
+
+	if err := s.decideReview(ctx, s.db, rv, models.SkillReviewWithdrawn, req.Comment); err != nil {
+		return nil, err
+	}
+	s.syncService.recordAudit(ctx, s.db, &models.SkillAuditEvent{
+		SkillID:  rv.SkillID,
+		ReviewID: &rv.ID,
+		Action:   models.SkillAuditWithdrawn,
+		Version:  rv.Version,
+		Comment:  req.Comment,
+	})
+	return s.reviewToProto(ctx, rv.ID)
+}
+
+func (s *Server) reviewToProto(ctx context.Context, id int64) (*sacv1.SkillReview, error) {
+	var rv models.SkillReview
+	err := s.db.NewSelect().Model(&rv).
+		Relation("Skill").Relation("Submitter").Relation("Reviewer").
+		Where("sr.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to reload review", err)
+	}
+	return convert.SkillReviewToProto(&rv), nil
+}
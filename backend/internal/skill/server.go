@@ -24,31 +24,40 @@ func NewServer(db *bun.DB, syncService *SyncService) *Server {
 	return &Server{db: db, syncService: syncService}
 }
 
+// visibleSkillsWhere selects the skills a user sees: their own ones and the
+// published official, public and group skills of others.
+const visibleSkillsWhere = `(created_by = ? OR (published_version IS NOT NULL AND (is_official OR is_public
+	OR group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))))`
+
 func (s *Server) ListSkills(ctx context.Context, _ *sacv1.Empty) (*sacv1.SkillListResponse, error) {
 	userID := ctxkeys.UserID(ctx)
 
 	var skills []models.Skill
 	err := s.db.NewSelect().
 		Model(&skills).
+		Apply(withSkillStats).
 		Relation("Files").
-		Where(`is_official = ? OR created_by = ? OR is_public = ?
-			OR group_id IN (SELECT group_id FROM group_members WHERE user_id = ?)`,
-			true, userID, true, userID).
+		Where(visibleSkillsWhere, userID, userID).
 		Order("category ASC", "name ASC").
 		Scan(ctx)
 	if err != nil {
 		return nil, grpcerr.Internal("Failed to retrieve skills", err)
 	}
+	s.syncService.applyPublishedViews(ctx, skills, userID)
 
 	return &sacv1.SkillListResponse{Skills: convert.SkillsToProto(skills)}, nil
 }
 
 func (s *Server) GetSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.Skill, error) {
 	var skill models.Skill
-	err := s.db.NewSelect().Model(&skill).Relation("Files").Where("sk.id = ?", req.Id).Scan(ctx)
+	err := s.db.NewSelect().Model(&skill).Apply(withSkillStats).Relation("Files").Where("sk.id = ?", req.Id).Scan(ctx)
 	if err != nil {
 		return nil, grpcerr.NotFound("Skill not found", err)
 	}
+	if !s.canViewSkill(ctx, &skill, ctxkeys.UserID(ctx)) {
+		return nil, grpcerr.NotFound("Skill not found")
+	}
+	s.syncService.applyPublishedView(ctx, &skill, ctxkeys.UserID(ctx))
 	return convert.SkillToProto(&skill), nil
 }
 
@@ -58,12 +67,17 @@ func (s *Server) CreateSkill(ctx context.Context, req *sacv1.CreateSkillRequest)
 	if req.Name == "" {
 		return nil, grpcerr.BadRequest("name is required")
 	}
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
 
 	skill := models.Skill{
 		Name:        req.Name,
 		Description: req.Description,
 		Icon:        req.Icon,
 		Category:    req.Category,
+		Tags:        tags,
 		Prompt:      req.Prompt,
 		CommandName: req.CommandName,
 		Parameters:  convert.SkillParametersFromProto(req.Parameters),
@@ -89,6 +103,9 @@ func (s *Server) CreateSkill(ctx context.Context, req *sacv1.CreateSkillRequest)
 	if skill.CommandName == "" {
 		return nil, grpcerr.BadRequest("Cannot derive a valid command name from skill name")
 	}
+	if err := lintStatusError(LintSkill(loadLintConfig(ctx, s.db), &skill, nil)); err != nil {
+		return nil, err
+	}
 
 	exists, err := s.db.NewSelect().Model((*models.Skill)(nil)).
 		Where("command_name = ?", skill.CommandName).
@@ -105,6 +122,13 @@ func (s *Server) CreateSkill(ctx context.Context, req *sacv1.CreateSkillRequest)
 		return nil, grpcerr.Internal("Failed to create skill", err)
 	}
 
+	// Build the bundle up front so the first revision is in the history.
+	if err := s.syncService.RebuildSkillBundle(ctx, skill.ID, "Created"); err != nil {
+		log.Warn().Err(err).Int64("skill_id", skill.ID).Msg("failed to build skill bundle after create")
+	} else {
+		_ = s.db.NewSelect().Model(&skill).WherePK().Scan(ctx)
+	}
+
 	return convert.SkillToProto(&skill), nil
 }
 
@@ -125,6 +149,9 @@ func (s *Server) UpdateSkill(ctx context.Context, req *sacv1.UpdateSkillByIdRequ
 	if !existingSkill.IsOfficial && existingSkill.CreatedBy != userID {
 		return nil, grpcerr.Forbidden("You don't have permission to update this skill")
 	}
+	if existingSkill.SourceID != nil {
+		return nil, grpcerr.Forbidden(sourceManagedMsg)
+	}
 
 	var updateData models.Skill
 	updateData.ID = req.Id
@@ -150,6 +177,14 @@ func (s *Server) UpdateSkill(ctx context.Context, req *sacv1.UpdateSkillByIdRequ
 		updateData.Category = *req.Category
 		columns = append(columns, "category")
 	}
+	if len(req.Tags) > 0 || req.ClearTags {
+		tags, err := normalizeTags(req.Tags)
+		if err != nil {
+			return nil, grpcerr.BadRequest(err.Error())
+		}
+		updateData.Tags = tags
+		columns = append(columns, "tags")
+	}
 	if req.Prompt != nil {
 		updateData.Prompt = *req.Prompt
 		columns = append(columns, "prompt")
@@ -196,6 +231,31 @@ func (s *Server) UpdateSkill(ctx context.Context, req *sacv1.UpdateSkillByIdRequ
 		updateData.CommandName = existingSkill.CommandName
 	}
 
+	// Validate the skill as it will be saved when its content changes.
+	if req.Name != nil || req.Description != nil || req.Prompt != nil || req.CommandName != nil ||
+		req.Parameters != nil || req.Frontmatter != nil {
+		merged := existingSkill
+		merged.CommandName = updateData.CommandName
+		if req.Name != nil {
+			merged.Name = *req.Name
+		}
+		if req.Description != nil {
+			merged.Description = *req.Description
+		}
+		if req.Prompt != nil {
+			merged.Prompt = *req.Prompt
+		}
+		if req.Parameters != nil {
+			merged.Parameters = updateData.Parameters
+		}
+		if req.Frontmatter != nil {
+			merged.Frontmatter = updateData.Frontmatter
+		}
+		if err := lintStatusError(LintSkill(loadLintConfig(ctx, s.db), &merged, nil)); err != nil {
+			return nil, err
+		}
+	}
+
 	if updateData.CommandName != existingSkill.CommandName {
 		dup, dupErr := s.db.NewSelect().Model((*models.Skill)(nil)).
 			Where("command_name = ? AND id != ?", updateData.CommandName, req.Id).
@@ -217,9 +277,17 @@ func (s *Server) UpdateSkill(ctx context.Context, req *sacv1.UpdateSkillByIdRequ
 		return nil, grpcerr.Internal("Failed to update skill", err)
 	}
 
-	// Rebuild bundle.tar when content changed (prompt or frontmatter)
-	if req.Prompt != nil || req.Frontmatter != nil {
-		if err := s.syncService.RebuildSkillBundle(ctx, req.Id); err != nil {
+	// A new audience has to approve the skill before it sees it.
+	if audienceChanged(&existingSkill, req) {
+		if err := s.syncService.unpublishSkill(ctx, &existingSkill, "Visibility changed"); err != nil {
+			return nil, grpcerr.Internal("Failed to unpublish skill", err)
+		}
+	}
+
+	// Rebuild bundle.tar when SKILL.md changed (prompt, frontmatter or the
+	// parameters documented in it)
+	if req.Prompt != nil || req.Frontmatter != nil || req.Parameters != nil {
+		if err := s.syncService.RebuildSkillBundle(ctx, req.Id, "Updated SKILL.md"); err != nil {
 			log.Warn().Err(err).Int64("skill_id", req.Id).Msg("failed to rebuild skill bundle after update")
 		}
 	}
@@ -233,6 +301,22 @@ func (s *Server) UpdateSkill(ctx context.Context, req *sacv1.UpdateSkillByIdRequ
 	return convert.SkillToProto(&updatedSkill), nil
 }
 
+// audienceChanged reports whether an update moves a skill to another
+// audience (public, a group or private).
+func audienceChanged(sk *models.Skill, req *sacv1.UpdateSkillByIdRequest) bool {
+	if req.IsPublic != nil && *req.IsPublic != sk.IsPublic {
+		return true
+	}
+	if req.GroupId == nil {
+		return false
+	}
+	var old int64
+	if sk.GroupID != nil {
+		old = *sk.GroupID
+	}
+	return *req.GroupId != old
+}
+
 func (s *Server) DeleteSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.SuccessMessage, error) {
 	userID := ctxkeys.UserID(ctx)
 
@@ -248,6 +332,9 @@ func (s *Server) DeleteSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*
 	if skill.IsOfficial {
 		return nil, grpcerr.Forbidden("Cannot delete official skills")
 	}
+	if skill.SourceID != nil {
+		return nil, grpcerr.Forbidden(sourceManagedMsg)
+	}
 
 	// Remove agent_skills rows — agents can no longer see this skill.
 	// Pod file cleanup is handled by the periodic sync cronjob.
@@ -273,6 +360,10 @@ func (s *Server) ForkSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sa
 	if !originalSkill.IsPublic && !originalSkill.IsOfficial {
 		return nil, grpcerr.Forbidden("This skill is not public")
 	}
+	if originalSkill.PublishedVersion == nil && originalSkill.CreatedBy != userID {
+		return nil, grpcerr.Forbidden("This skill is not published")
+	}
+	s.syncService.applyPublishedView(ctx, &originalSkill, userID)
 
 	forkedName := originalSkill.Name + " (Fork)"
 	baseCmd := SanitizeCommandName(forkedName)
@@ -296,6 +387,7 @@ func (s *Server) ForkSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sa
 		Description: originalSkill.Description,
 		Icon:        originalSkill.Icon,
 		Category:    originalSkill.Category,
+		Tags:        originalSkill.Tags,
 		Prompt:      originalSkill.Prompt,
 		CommandName: cmdName,
 		Parameters:  originalSkill.Parameters,
@@ -304,8 +396,10 @@ func (s *Server) ForkSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sa
 		CreatedBy:   userID,
 		IsPublic:    false,
 		ForkedFrom:  &originalSkill.ID,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		// The published view above: the revision the fork copies.
+		UpstreamVersion: &originalSkill.Version,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	_, err = s.db.NewInsert().Model(&forkedSkill).Exec(ctx)
@@ -317,27 +411,13 @@ func (s *Server) ForkSkill(ctx context.Context, req *sacv1.GetSkillRequest) (*sa
 	fileCount, _ := s.db.NewSelect().Model((*models.SkillFile)(nil)).Where("skill_id = ?", req.Id).Count(ctx)
 	if fileCount > 0 {
 		go s.syncService.CopySkillFiles(context.Background(), originalSkill.ID, forkedSkill.ID)
+	} else if err := s.syncService.RebuildSkillBundle(ctx, forkedSkill.ID, fmt.Sprintf("Forked from skill %d", originalSkill.ID)); err != nil {
+		log.Warn().Err(err).Int64("skill_id", forkedSkill.ID).Msg("failed to build skill bundle after fork")
 	}
 
 	return convert.SkillToProto(&forkedSkill), nil
 }
 
-func (s *Server) ListPublicSkills(ctx context.Context, _ *sacv1.Empty) (*sacv1.SkillListResponse, error) {
-	var skills []models.Skill
-	err := s.db.NewSelect().
-		Model(&skills).
-		Relation("Files").
-		Where("is_public = ? OR is_official = ?", true, true).
-		Relation("Creator").
-		Order("category ASC", "name ASC").
-		Scan(ctx)
-	if err != nil {
-		return nil, grpcerr.Internal("Failed to retrieve public skills", err)
-	}
-
-	return &sacv1.SkillListResponse{Skills: convert.SkillsToProto(skills)}, nil
-}
-
 func (s *Server) ListGroupSkills(ctx context.Context, req *sacv1.ListGroupSkillsRequest) (*sacv1.SkillListResponse, error) {
 	userID := ctxkeys.UserID(ctx)
 
@@ -351,14 +431,17 @@ func (s *Server) ListGroupSkills(ctx context.Context, req *sacv1.ListGroupSkills
 	var skills []models.Skill
 	err := s.db.NewSelect().
 		Model(&skills).
+		Apply(withSkillStats).
 		Relation("Files").
 		Relation("Creator").
-		Where("group_id = ?", req.GroupId).
-		Order("name ASC").
+		Where("sk.group_id = ?", req.GroupId).
+		Where("sk.published_version IS NOT NULL OR sk.created_by = ?", userID).
+		Order("sk.name ASC").
 		Scan(ctx)
 	if err != nil {
 		return nil, grpcerr.Internal("Failed to retrieve group skills", err)
 	}
+	s.syncService.applyPublishedViews(ctx, skills, userID)
 
 	return &sacv1.SkillListResponse{Skills: convert.SkillsToProto(skills)}, nil
 }
@@ -379,25 +462,39 @@ func (s *Server) ShareSkillToGroup(ctx context.Context, req *sacv1.ShareSkillToG
 	if skill.CreatedBy != userID {
 		return nil, grpcerr.Forbidden("You can only share your own skills")
 	}
+	if skill.SourceID != nil {
+		return nil, grpcerr.Forbidden(sourceManagedMsg)
+	}
 
 	if !s.isGroupMember(ctx, req.GroupId, userID) {
 		return nil, grpcerr.Forbidden("Not a member of this group")
 	}
 
+	// Sharing moves the skill to the group's audience; the group admins
+	// have to approve it before members see it.
+	if skill.IsPublic || !equalInt64Ptr(skill.GroupID, &req.GroupId) {
+		if err := s.syncService.unpublishSkill(ctx, &skill, "Shared to another group"); err != nil {
+			return nil, grpcerr.Internal("Failed to unpublish skill", err)
+		}
+	}
+
 	groupID := req.GroupId
 	_, err = s.db.NewUpdate().
 		Model((*models.Skill)(nil)).
 		Set("group_id = ?", groupID).
 		Set("is_public = ?", false).
 		Set("updated_at = ?", time.Now()).
-		Set("version = version + 1").
 		Where("id = ?", req.Id).
 		Exec(ctx)
 	if err != nil {
 		return nil, grpcerr.Internal("Failed to share skill to group", err)
 	}
+	skill.GroupID, skill.IsPublic = &groupID, false
 
-	return &sacv1.SuccessMessage{Message: "Skill shared to group"}, nil
+	if _, err := s.submitReview(ctx, &skill, models.SkillReviewGroup, &groupID, ""); err != nil {
+		return nil, err
+	}
+	return &sacv1.SuccessMessage{Message: "Skill submitted for review by the group admins"}, nil
 }
 
 func (s *Server) isGroupMember(ctx context.Context, groupID, userID int64) bool {
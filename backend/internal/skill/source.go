@@ -0,0 +1,357 @@
+package skill
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/webhook"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	sourceManagedMsg          = "This skill is managed by a Git source and is read-only"
+	defaultSourceSyncInterval = 60
+	maxSourceSyncInterval     = 7 * 24 * 60
+	maxSourceWebhookPayload   = 1 << 20
+)
+
+// scpLikeURL matches user@host:path, the short form of SSH remotes.
+var scpLikeURL = regexp.MustCompile(`^[A-Za-z0-9._-]+@[A-Za-z0-9.-]+:[^/\s][^\s]*$`)
+
+// validateRepoURL accepts HTTPS and SSH remotes only, so a source cannot read
+// repositories from the server's own filesystem.
+func validateRepoURL(raw string) error {
+	if scpLikeURL.MatchString(raw) {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "ssh") {
+		return errors.New("repo_url must be an https:// or SSH URL")
+	}
+	return nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validateSource normalizes defaults and rejects invalid settings.
+func (s *Server) validateSource(ctx context.Context, src *models.SkillSource) error {
+	src.Name = strings.TrimSpace(src.Name)
+	if src.Name == "" {
+		return grpcerr.BadRequest("name is required")
+	}
+	src.RepoURL = strings.TrimSpace(src.RepoURL)
+	if err := validateRepoURL(src.RepoURL); err != nil {
+		return grpcerr.BadRequest(err.Error())
+	}
+	if src.Branch == "" {
+		src.Branch = "main"
+	}
+	if strings.HasPrefix(src.Branch, "-") || strings.ContainsAny(src.Branch, " \t\n~^:?*[\\") {
+		return grpcerr.BadRequest("invalid branch name")
+	}
+	src.Subdir = strings.Trim(path.Clean("/"+strings.TrimSpace(src.Subdir)), "/")
+
+	switch src.AuthType {
+	case "":
+		src.AuthType = models.SkillSourceAuthNone
+		src.Credential = ""
+	case models.SkillSourceAuthNone:
+		src.Credential = ""
+	case models.SkillSourceAuthToken, models.SkillSourceAuthDeployKey:
+		if src.Credential == "" {
+			return grpcerr.BadRequest("credential is required for auth_type " + src.AuthType)
+		}
+	default:
+		return grpcerr.BadRequest("auth_type must be one of none, token, deploy_key")
+	}
+	if src.AuthType == models.SkillSourceAuthDeployKey && strings.HasPrefix(src.RepoURL, "https://") {
+		return grpcerr.BadRequest("deploy_key requires an SSH repo_url")
+	}
+
+	if src.SyncIntervalMinutes < 0 || src.SyncIntervalMinutes > maxSourceSyncInterval {
+		return grpcerr.BadRequest(fmt.Sprintf("sync_interval_minutes must be between 0 and %d", maxSourceSyncInterval))
+	}
+	if src.GroupID != nil && *src.GroupID > 0 {
+		if !s.isGroupMember(ctx, *src.GroupID, src.CreatedBy) {
+			return grpcerr.Forbidden("You are not a member of this group")
+		}
+		src.IsPublic = false
+	} else {
+		src.GroupID = nil
+	}
+	return nil
+}
+
+// loadOwnedSource returns a source owned by the caller (any source for admins).
+func (s *Server) loadOwnedSource(ctx context.Context, id int64) (*models.SkillSource, error) {
+	var src models.SkillSource
+	if err := s.db.NewSelect().Model(&src).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill source not found", err)
+	}
+	if src.CreatedBy != ctxkeys.UserID(ctx) && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.NotFound("Skill source not found")
+	}
+	return &src, nil
+}
+
+// syncSourceAsync pulls a source in the background, e.g. after it was
+// created or its repository settings changed.
+func (s *Server) syncSourceAsync(id int64) {
+	go func() {
+		if _, err := s.syncService.SyncSource(context.Background(), id, true); err != nil && !errors.Is(err, errSourceSyncBusy) {
+			log.Debug().Err(err).Int64("source_id", id).Msg("background skill source sync failed")
+		}
+	}()
+}
+
+func (s *Server) ListSkillSources(ctx context.Context, _ *sacv1.Empty) (*sacv1.SkillSourceListResponse, error) {
+	q := s.db.NewSelect().Model((*models.SkillSource)(nil)).Order("name ASC")
+	if ctxkeys.Role(ctx) != "admin" {
+		q = q.Where("created_by = ?", ctxkeys.UserID(ctx))
+	}
+	var sources []models.SkillSource
+	if err := q.Scan(ctx, &sources); err != nil {
+		return nil, grpcerr.Internal("Failed to list skill sources", err)
+	}
+
+	out := make([]*sacv1.SkillSource, len(sources))
+	for i := range sources {
+		out[i] = convert.SkillSourceToProto(&sources[i])
+	}
+	return &sacv1.SkillSourceListResponse{Sources: out}, nil
+}
+
+func (s *Server) CreateSkillSource(ctx context.Context, req *sacv1.CreateSkillSourceRequest) (*sacv1.SkillSource, error) {
+	src := &models.SkillSource{
+		Name:                req.Name,
+		RepoURL:             req.RepoUrl,
+		Branch:              strings.TrimSpace(req.Branch),
+		Subdir:              req.Subdir,
+		AuthType:            req.AuthType,
+		Credential:          req.Credential,
+		WebhookToken:        randomHex(16),
+		WebhookSecret:       randomHex(24),
+		SyncIntervalMinutes: defaultSourceSyncInterval,
+		IsPublic:            req.IsPublic,
+		GroupID:             req.GroupId,
+		CreatedBy:           ctxkeys.UserID(ctx),
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	}
+	if req.SyncIntervalMinutes != nil {
+		src.SyncIntervalMinutes = int(*req.SyncIntervalMinutes)
+	}
+	if err := s.validateSource(ctx, src); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.NewInsert().Model(src).Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create skill source", err)
+	}
+	s.syncSourceAsync(src.ID)
+
+	pb := convert.SkillSourceToProto(src)
+	pb.WebhookSecret = src.WebhookSecret
+	return pb, nil
+}
+
+func (s *Server) UpdateSkillSource(ctx context.Context, req *sacv1.UpdateSkillSourceRequest) (*sacv1.SkillSource, error) {
+	src, err := s.loadOwnedSource(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *src
+	if req.Name != nil {
+		src.Name = *req.Name
+	}
+	if req.RepoUrl != nil {
+		src.RepoURL = *req.RepoUrl
+	}
+	if req.Branch != nil {
+		src.Branch = strings.TrimSpace(*req.Branch)
+	}
+	if req.Subdir != nil {
+		src.Subdir = *req.Subdir
+	}
+	if req.AuthType != nil {
+		src.AuthType = *req.AuthType
+	}
+	if req.Credential != nil {
+		src.Credential = *req.Credential
+	}
+	if req.SyncIntervalMinutes != nil {
+		src.SyncIntervalMinutes = int(*req.SyncIntervalMinutes)
+	}
+	if req.IsPublic != nil {
+		src.IsPublic = *req.IsPublic
+		if src.IsPublic {
+			src.GroupID = nil
+		}
+	}
+	if req.GroupId != nil {
+		src.GroupID = req.GroupId
+	}
+	if err := s.validateSource(ctx, src); err != nil {
+		return nil, err
+	}
+
+	// Repository changes invalidate the synced commit so the next pull
+	// re-applies every skill; visibility changes are applied by a pull too.
+	resync := src.RepoURL != before.RepoURL || src.Branch != before.Branch || src.Subdir != before.Subdir ||
+		src.AuthType != before.AuthType || src.Credential != before.Credential ||
+		src.IsPublic != before.IsPublic || !equalInt64Ptr(src.GroupID, before.GroupID)
+	if resync {
+		src.LastCommit = ""
+	}
+	src.UpdatedAt = time.Now()
+
+	_, err = s.db.NewUpdate().Model(src).
+		Column("name", "repo_url", "branch", "subdir", "auth_type", "credential", "sync_interval_minutes",
+			"is_public", "group_id", "last_commit", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update skill source", err)
+	}
+	if resync {
+		s.syncSourceAsync(src.ID)
+	}
+	return convert.SkillSourceToProto(src), nil
+}
+
+// DeleteSkillSource removes a source. Its skills are kept and become
+// regular, editable skills of the source owner.
+func (s *Server) DeleteSkillSource(ctx context.Context, req *sacv1.GetSkillSourceRequest) (*sacv1.SuccessMessage, error) {
+	src, err := s.loadOwnedSource(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = s.db.NewUpdate().Model((*models.Skill)(nil)).
+		Set("source_path = ''").
+		Set("source_commit = ''").
+		Where("source_id = ?", src.ID).
+		Exec(ctx)
+	if _, err := s.db.NewDelete().Model(src).WherePK().Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to delete skill source", err)
+	}
+	return &sacv1.SuccessMessage{Message: "Skill source deleted; its skills are now editable"}, nil
+}
+
+// SyncSkillSource pulls a source now, even if its branch head is unchanged.
+func (s *Server) SyncSkillSource(ctx context.Context, req *sacv1.GetSkillSourceRequest) (*sacv1.SkillSourceSyncResult, error) {
+	src, err := s.loadOwnedSource(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.syncService.SyncSource(ctx, src.ID, true)
+	if errors.Is(err, errSourceSyncBusy) {
+		return nil, grpcerr.Conflict("A sync of this source is already running")
+	}
+	if err != nil {
+		return nil, grpcerr.Unavailable("Sync failed: " + err.Error())
+	}
+	return &sacv1.SkillSourceSyncResult{
+		Commit:    res.Commit,
+		Created:   int32(res.Created),
+		Updated:   int32(res.Updated),
+		Deleted:   int32(res.Deleted),
+		Unchanged: int32(res.Unchanged),
+		Errors:    res.Errors,
+	}, nil
+}
+
+func equalInt64Ptr(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// --- HTTP ---
+
+// rejectSourceManaged writes 403 and returns true when the skill is pulled
+// from a Git source.
+func (h *Handler) rejectSourceManaged(c *gin.Context, skillID int64) bool {
+	managed, _ := h.db.NewSelect().Model((*models.Skill)(nil)).
+		Where("id = ? AND source_id IS NOT NULL", skillID).
+		Exists(c.Request.Context())
+	if managed {
+		response.Forbidden(c, sourceManagedMsg)
+	}
+	return managed
+}
+
+// SourceWebhook handles POST /api/skill-sources/hooks/:token. It is public:
+// the token selects the source and the signature (GitHub, GitLab or
+// X-Signature HMAC, using the source's webhook secret) authenticates it.
+// Pushes to other branches are ignored.
+func (h *Handler) SourceWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var src models.SkillSource
+	if err := h.db.NewSelect().Model(&src).Where("webhook_token = ?", c.Param("token")).Scan(ctx); err != nil {
+		response.NotFound(c, "Skill source not found")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxSourceWebhookPayload))
+	if err != nil {
+		response.Error(c, http.StatusRequestEntityTooLarge, "payload too large")
+		return
+	}
+
+	format := ""
+	switch {
+	case c.GetHeader("X-Hub-Signature-256") != "":
+		format = models.WebhookSignatureGitHub
+	case c.GetHeader("X-Gitlab-Token") != "":
+		format = models.WebhookSignatureGitLab
+	case c.GetHeader("X-Signature") != "":
+		format = models.WebhookSignatureHMACSHA256
+	}
+	if format == "" || webhook.VerifySignature(format, src.WebhookSecret, c.Request.Header, body) != nil {
+		response.Unauthorized(c, "invalid signature")
+		return
+	}
+
+	var payload struct {
+		Ref string `json:"ref"`
+	}
+	_ = json.Unmarshal(body, &payload)
+	if payload.Ref != "" && payload.Ref != "refs/heads/"+src.Branch {
+		c.JSON(http.StatusOK, gin.H{"status": "ignored", "reason": "branch " + payload.Ref + " is not tracked"})
+		return
+	}
+
+	go func() {
+		if _, err := h.syncService.SyncSource(context.Background(), src.ID, false); err != nil && !errors.Is(err, errSourceSyncBusy) {
+			log.Warn().Err(err).Int64("source_id", src.ID).Msg("webhook skill source sync failed")
+		}
+	}()
+	c.JSON(http.StatusAccepted, gin.H{"status": "accepted"})
+}
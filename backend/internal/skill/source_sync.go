@@ -0,0 +1,367 @@
+package skill
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	sourceFetchTimeout = 5 * time.Minute
+	// sourceSyncLease bounds how long a crashed sync blocks the next one.
+	sourceSyncLease = 15 * time.Minute
+)
+
+var errSourceSyncBusy = errors.New("source is already being synced")
+
+// SourceSyncResult summarises one pull of a skill source.
+type SourceSyncResult struct {
+	Commit    string
+	Created   int
+	Updated   int
+	Deleted   int
+	Unchanged int
+	Errors    []string
+}
+
+// SyncSource pulls a Git skill source and creates, updates or deletes its
+// skills to match the */SKILL.md directories of the branch. Unless force is
+// set, nothing is done when the branch head was already synced. Concurrent
+// syncs of the same source (other replicas, maintenance) are rejected.
+func (s *SyncService) SyncSource(ctx context.Context, sourceID int64, force bool) (*SourceSyncResult, error) {
+	var src models.SkillSource
+	res, err := s.db.NewUpdate().Model(&src).
+		Set("sync_started_at = ?", time.Now()).
+		Where("id = ?", sourceID).
+		Where("sync_started_at IS NULL OR sync_started_at < ?", time.Now().Add(-sourceSyncLease)).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, errSourceSyncBusy
+	}
+
+	result, syncErr := s.syncSource(ctx, &src, force)
+
+	now := time.Now()
+	q := s.db.NewUpdate().Model((*models.SkillSource)(nil)).
+		Set("sync_started_at = NULL").
+		Set("last_synced_at = ?", now).
+		Where("id = ?", src.ID)
+	switch {
+	case syncErr != nil:
+		q = q.Set("last_error = ?", syncErr.Error())
+	default:
+		q = q.Set("last_commit = ?", result.Commit).
+			Set("last_error = ?", strings.Join(result.Errors, "; "))
+	}
+	if _, err := q.Exec(ctx); err != nil {
+		log.Warn().Err(err).Int64("source_id", src.ID).Msg("failed to record skill source sync")
+	}
+
+	if syncErr != nil {
+		log.Warn().Err(syncErr).Int64("source_id", src.ID).Str("repo", src.RepoURL).Msg("skill source sync failed")
+		return nil, syncErr
+	}
+	log.Info().Int64("source_id", src.ID).Str("commit", result.Commit).
+		Int("created", result.Created).Int("updated", result.Updated).Int("deleted", result.Deleted).
+		Msg("synced skill source")
+	return result, nil
+}
+
+func (s *SyncService) syncSource(ctx context.Context, src *models.SkillSource, force bool) (*SourceSyncResult, error) {
+	tmp, err := os.MkdirTemp("", "sac-skill-source-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	checkout := filepath.Join(tmp, "repo")
+	fetchCtx, cancel := context.WithTimeout(ctx, sourceFetchTimeout)
+	commit, err := FetchGitSource(fetchCtx, GitFetchOptions{
+		RepoURL:    src.RepoURL,
+		Branch:     src.Branch,
+		AuthType:   src.AuthType,
+		Credential: src.Credential,
+	}, checkout)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SourceSyncResult{Commit: commit}
+	if !force && commit == src.LastCommit {
+		return result, nil
+	}
+
+	found, err := ScanSourceTree(checkout, src.Subdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []models.Skill
+	if err := s.db.NewSelect().Model(&existing).Relation("Files").Where("source_id = ?", src.ID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load source skills: %w", err)
+	}
+	byPath := make(map[string]*models.Skill, len(existing))
+	for i := range existing {
+		byPath[existing[i].SourcePath] = &existing[i]
+	}
+
+	var backend storage.StorageBackend
+	if s.storage != nil {
+		backend = s.storage.GetClient(ctx)
+	}
+
+	// Revisions are attributed to the source owner.
+	ctx = context.WithValue(ctx, ctxkeys.UserIDKey, src.CreatedBy)
+
+	seen := make(map[string]bool, len(found))
+	for i := range found {
+		fs := &found[i]
+		seen[fs.Path] = true
+		changed, err := s.applySourceSkill(ctx, backend, src, commit, fs, byPath[fs.Path])
+		switch {
+		case err != nil:
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", fs.Path, err))
+		case byPath[fs.Path] == nil:
+			result.Created++
+		case changed:
+			result.Updated++
+		default:
+			result.Unchanged++
+		}
+	}
+
+	for _, sk := range existing {
+		if seen[sk.SourcePath] {
+			continue
+		}
+		if err := s.deleteSourceSkill(ctx, backend, &sk); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", sk.SourcePath, err))
+			continue
+		}
+		result.Deleted++
+	}
+	return result, nil
+}
+
+// applySourceSkill creates or updates the skill for one source directory and
+// reports whether its content changed.
+func (s *SyncService) applySourceSkill(ctx context.Context, backend storage.StorageBackend, src *models.SkillSource, commit string, fs *SourceSkill, sk *models.Skill) (bool, error) {
+	meta, body, err := parseSkillMD(fs.SkillMD)
+	if err != nil {
+		return false, err
+	}
+	if len(fs.Files) > 0 && backend == nil {
+		return false, errors.New("storage not configured")
+	}
+
+	name, _ := meta["name"].(string)
+	description, _ := meta["description"].(string)
+	category, _ := meta["category"].(string)
+	tags, err := normalizeTags(tagsFromYAML(meta))
+	if err != nil {
+		return false, err
+	}
+	frontmatter := frontmatterFromYAML(meta)
+	name = firstNonEmpty(name, fs.Path)
+
+	candidate := models.Skill{Name: name, Description: description, Prompt: body, Frontmatter: frontmatter, CommandName: SanitizeCommandName(name)}
+	if sk != nil {
+		candidate.CommandName, candidate.Parameters = sk.CommandName, sk.Parameters
+	}
+	files := make([]LintFile, 0, len(fs.Files))
+	for _, f := range fs.Files {
+		files = append(files, LintFile{Path: f.Path, Size: f.Size})
+	}
+	if errs := lintErrors(LintSkill(loadLintConfig(ctx, s.db), &candidate, files)); len(errs) > 0 {
+		return false, errors.New(lintSummary(errs))
+	}
+
+	isNew := sk == nil
+	if isNew {
+		base := SanitizeCommandName(name)
+		if base == "" {
+			return false, errors.New("cannot derive a command name")
+		}
+		cmd, err := availableCommandName(ctx, s.db, base)
+		if err != nil {
+			return false, err
+		}
+		sk = &models.Skill{
+			Name:         name,
+			Description:  description,
+			Category:     category,
+			Tags:         tags,
+			Prompt:       body,
+			CommandName:  cmd,
+			Frontmatter:  frontmatter,
+			IsPublic:     src.IsPublic,
+			GroupID:      src.GroupID,
+			CreatedBy:    src.CreatedBy,
+			SourceID:     &src.ID,
+			SourcePath:   fs.Path,
+			SourceCommit: commit,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		}
+		if _, err := s.db.NewInsert().Model(sk).Exec(ctx); err != nil {
+			return false, fmt.Errorf("failed to create skill: %w", err)
+		}
+	}
+
+	contentChanged := isNew || sk.Prompt != body || !reflect.DeepEqual(sk.Frontmatter, frontmatter)
+	if !isNew {
+		// Like a manual visibility change, a new audience needs a new review.
+		if sk.IsPublic != src.IsPublic || !equalInt64Ptr(sk.GroupID, src.GroupID) {
+			if err := s.unpublishSkill(ctx, sk, "Source visibility changed"); err != nil {
+				return false, err
+			}
+		}
+		sk.Name, sk.Description, sk.Category, sk.Tags = name, description, category, tags
+		sk.Prompt, sk.Frontmatter = body, frontmatter
+		sk.IsPublic, sk.GroupID = src.IsPublic, src.GroupID
+		sk.SourceCommit = commit
+		sk.UpdatedAt = time.Now()
+		_, err := s.db.NewUpdate().Model(sk).
+			Column("name", "description", "category", "tags", "prompt", "frontmatter", "is_public", "group_id", "source_commit", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to update skill: %w", err)
+		}
+	}
+
+	filesChanged, err := s.syncSourceFiles(ctx, backend, sk, fs)
+	if err != nil {
+		return false, err
+	}
+
+	if contentChanged || filesChanged {
+		short := commit
+		if len(short) > 12 {
+			short = short[:12]
+		}
+		if err := s.RebuildSkillBundle(ctx, sk.ID, fmt.Sprintf("Synced from %s@%s", src.Name, short)); err != nil {
+			return false, err
+		}
+	}
+	return contentChanged || filesChanged, nil
+}
+
+// syncSourceFiles uploads new or modified files of a source directory and
+// removes files that are gone from it.
+func (s *SyncService) syncSourceFiles(ctx context.Context, backend storage.StorageBackend, sk *models.Skill, fs *SourceSkill) (bool, error) {
+	current := make(map[string]models.SkillFile, len(sk.Files))
+	for _, f := range sk.Files {
+		current[f.Filepath] = f
+	}
+
+	changed := false
+	for _, f := range fs.Files {
+		local := filepath.Join(fs.Dir, filepath.FromSlash(f.Path))
+		sum, err := fileChecksum(local)
+		if err != nil {
+			return changed, err
+		}
+		if cur, ok := current[f.Path]; ok && cur.Checksum == sum {
+			delete(current, f.Path)
+			continue
+		}
+		delete(current, f.Path)
+
+		contentType := mime.TypeByExtension(path.Ext(f.Path))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		file, err := os.Open(local)
+		if err != nil {
+			return changed, err
+		}
+		_, err = storeSkillFile(ctx, s.db, backend, sk.ID, f.Path, contentType, file, f.Size)
+		file.Close()
+		if err != nil {
+			return changed, fmt.Errorf("failed to store %s: %w", f.Path, err)
+		}
+		changed = true
+	}
+
+	for _, stale := range current {
+		if backend != nil {
+			_ = backend.Delete(ctx, stale.S3Key)
+		}
+		if _, err := s.db.NewDelete().Model((*models.SkillFile)(nil)).Where("id = ?", stale.ID).Exec(ctx); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// deleteSourceSkill removes a skill whose directory left the repository.
+// Pod directories are cleaned up by the next full sync.
+func (s *SyncService) deleteSourceSkill(ctx context.Context, backend storage.StorageBackend, sk *models.Skill) error {
+	_, _ = s.db.NewDelete().Model((*models.AgentSkill)(nil)).Where("skill_id = ?", sk.ID).Exec(ctx)
+	if _, err := s.db.NewDelete().Model((*models.Skill)(nil)).Where("id = ?", sk.ID).Exec(ctx); err != nil {
+		return err
+	}
+	if backend != nil {
+		_ = backend.DeletePrefix(ctx, fmt.Sprintf("skills/%d/", sk.ID))
+	}
+	return nil
+}
+
+// SyncDueSources pulls every source whose sync interval has elapsed.
+// Used by the maintenance job.
+func (s *SyncService) SyncDueSources(ctx context.Context) (synced, failed int) {
+	var ids []int64
+	err := s.db.NewSelect().Model((*models.SkillSource)(nil)).
+		Column("id").
+		Where("sync_interval_minutes > 0").
+		Where("last_synced_at IS NULL OR last_synced_at <= NOW() - INTERVAL '1 minute' * sync_interval_minutes").
+		Order("id ASC").
+		Scan(ctx, &ids)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list due skill sources")
+		return 0, 0
+	}
+	for _, id := range ids {
+		if _, err := s.SyncSource(ctx, id, false); err != nil {
+			failed++
+			continue
+		}
+		synced++
+	}
+	return synced, failed
+}
+
+// fileChecksum returns the skill_files checksum of a local file.
+func fileChecksum(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := newFileHash()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -1,11 +1,7 @@
 package skill
 
 import (
-	"archive/tar"
-	"bytes"
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
 	"io"
 	"strconv"
@@ -13,8 +9,11 @@ import (
 	"time"
 
 	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/mcp"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/subagent"
 	"github.com/rs/zerolog/log"
 	"github.com/uptrace/bun"
 	"gopkg.in/yaml.v3"
@@ -31,6 +30,9 @@ type SyncService struct {
 	containerManager *container.Manager
 	storage          *storage.StorageProvider
 	publisher        SyncProgressPublisher
+	notifier         *notify.Notifier
+	mcp              *mcp.Syncer
+	subagents        *subagent.Syncer
 }
 
 // NewSyncService creates a new SyncService.
@@ -39,6 +41,8 @@ func NewSyncService(db *bun.DB, containerManager *container.Manager, storageProv
 		db:               db,
 		containerManager: containerManager,
 		storage:          storageProvider,
+		mcp:              mcp.NewSyncer(db, containerManager),
+		subagents:        subagent.NewSyncer(db, containerManager),
 	}
 }
 
@@ -48,6 +52,11 @@ func (s *SyncService) SetPublisher(publisher SyncProgressPublisher) {
 	s.publisher = publisher
 }
 
+// SetNotifier enables skill sync result notifications to the agent owner.
+func (s *SyncService) SetNotifier(notifier *notify.Notifier) {
+	s.notifier = notifier
+}
+
 // publish is a nil-safe helper that sends a progress event.
 func (s *SyncService) publish(ctx context.Context, userID int64, agentID int64, event SkillSyncEvent) {
 	if s.publisher != nil {
@@ -60,21 +69,23 @@ func (s *SyncService) podName(userID string, agentID int64) string {
 	return fmt.Sprintf("claude-code-%s-%d-0", userID, agentID)
 }
 
-// md5Hex computes the MD5 hex digest of a string.
-func md5Hex(s string) string {
-	h := md5.Sum([]byte(s))
-	return hex.EncodeToString(h[:])
-}
-
 // bundleS3Key returns the S3 key for a skill's pre-built tar bundle.
 func bundleS3Key(skillID int64) string {
 	return fmt.Sprintf("skills/%d/bundle.tar", skillID)
 }
 
-// RebuildSkillBundle recomputes content_checksum, builds a tar bundle of
-// SKILL.md + attached files + .checksum, uploads to S3, and bumps version.
+// versionBundleS3Key returns the S3 key of the immutable bundle kept for a
+// skill revision.
+func versionBundleS3Key(skillID int64, version int) string {
+	return fmt.Sprintf("skills/%d/versions/%d/bundle.tar", skillID, version)
+}
+
+// RebuildSkillBundle recomputes content_checksum, streams a tar bundle of
+// SKILL.md + attached files + .checksum to S3, bumps version and records the
+// result as an immutable revision described by note. A failed build leaves
+// version and bundle untouched and is recorded in bundle_error.
 // Called on every skill content change (update, file upload/edit/delete).
-func (s *SyncService) RebuildSkillBundle(ctx context.Context, skillID int64) error {
+func (s *SyncService) RebuildSkillBundle(ctx context.Context, skillID int64, note string) error {
 	var sk models.Skill
 	if err := s.db.NewSelect().Model(&sk).Where("id = ?", skillID).Scan(ctx); err != nil {
 		return fmt.Errorf("failed to load skill %d: %w", skillID, err)
@@ -83,79 +94,37 @@ func (s *SyncService) RebuildSkillBundle(ctx context.Context, skillID int64) err
 	skillMD := buildSkillMD(&sk)
 
 	var files []models.SkillFile
-	_ = s.db.NewSelect().Model(&files).
+	err := s.db.NewSelect().Model(&files).
 		Where("skill_id = ?", skillID).
 		Order("filepath ASC").
 		Scan(ctx)
-
-	// Compute content_checksum: md5(SKILL.md + \x00 + sorted filepath:checksum lines)
-	ch := md5.New()
-	ch.Write([]byte(skillMD))
-	ch.Write([]byte{0x00})
-	for _, f := range files {
-		ch.Write([]byte(f.Filepath + ":" + f.Checksum + "\n"))
-	}
-	checksum := hex.EncodeToString(ch.Sum(nil))
-
-	// Build tar archive in memory
-	var tarBuf bytes.Buffer
-	tw := tar.NewWriter(&tarBuf)
-
-	// SKILL.md
-	skillMDBytes := []byte(skillMD)
-	_ = tw.WriteHeader(&tar.Header{Name: "SKILL.md", Mode: 0644, Size: int64(len(skillMDBytes))})
-	_, _ = tw.Write(skillMDBytes)
-
-	// Attached files from S3
-	if len(files) > 0 && s.storage != nil {
-		if backend := s.storage.GetClient(ctx); backend != nil {
-			for _, f := range files {
-				reader, err := backend.Download(ctx, f.S3Key)
-				if err != nil {
-					log.Warn().Err(err).Str("filepath", f.Filepath).Msg("RebuildSkillBundle: failed to download from S3")
-					continue
-				}
-				data, err := io.ReadAll(reader)
-				reader.Close()
-				if err != nil {
-					log.Warn().Err(err).Str("filepath", f.Filepath).Msg("RebuildSkillBundle: failed to read file")
-					continue
-				}
-				_ = tw.WriteHeader(&tar.Header{Name: f.Filepath, Mode: 0644, Size: int64(len(data))})
-				_, _ = tw.Write(data)
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("failed to load files of skill %d: %w", skillID, err)
 	}
 
-	// .checksum marker file
-	checksumBytes := []byte(checksum)
-	_ = tw.WriteHeader(&tar.Header{Name: ".checksum", Mode: 0644, Size: int64(len(checksumBytes))})
-	_, _ = tw.Write(checksumBytes)
-	_ = tw.Close()
-
-	// Upload bundle.tar to S3
-	if s.storage != nil {
-		if backend := s.storage.GetClient(ctx); backend != nil {
-			tarData := tarBuf.Bytes()
-			if err := backend.Upload(ctx, bundleS3Key(skillID), bytes.NewReader(tarData), int64(len(tarData)), "application/x-tar"); err != nil {
-				log.Warn().Err(err).Int64("skill_id", skillID).Msg("RebuildSkillBundle: failed to upload bundle.tar")
-				// Non-fatal: sync will fall back to building tar on the fly
-			}
-		}
+	checksum, uploaded, err := s.buildBundle(ctx, &sk, skillMD, files)
+	if err != nil {
+		s.markBundleError(ctx, skillID, err)
+		return fmt.Errorf("failed to build bundle for skill %d: %w", skillID, err)
 	}
 
 	// Update DB: content_checksum + version bump
-	_, err := s.db.NewUpdate().
+	var version int
+	_, err = s.db.NewUpdate().
 		Model((*models.Skill)(nil)).
 		Set("content_checksum = ?", checksum).
+		Set("bundle_error = ''").
 		Set("version = version + 1").
 		Set("updated_at = ?", time.Now()).
 		Where("id = ?", skillID).
-		Exec(ctx)
+		Returning("version").
+		Exec(ctx, &version)
 	if err != nil {
 		return fmt.Errorf("failed to update content_checksum for skill %d: %w", skillID, err)
 	}
 
+	s.recordVersion(ctx, &sk, version, skillMD, files, checksum, uploaded, note)
+
 	log.Debug().Int64("skill_id", skillID).Str("checksum", checksum).Msg("rebuilt skill bundle")
 	return nil
 }
@@ -194,6 +163,11 @@ func buildSkillMD(sk *models.Skill) string {
 		}
 	}
 
+	// Parameters document themselves unless the author wrote a hint.
+	if _, ok := fm["argument_hint"]; !ok && len(sk.Parameters) > 0 {
+		fm["argument_hint"] = argumentHint(sk.Parameters)
+	}
+
 	// Default: user_invocable = true so /command works
 	if _, ok := fm["user_invocable"]; !ok {
 		fm["user_invocable"] = true
@@ -207,6 +181,12 @@ func buildSkillMD(sk *models.Skill) string {
 	}
 
 	b.WriteString(sk.Prompt)
+	if len(sk.Parameters) > 0 {
+		if !strings.HasSuffix(sk.Prompt, "\n") {
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + argumentsSection(sk.Parameters))
+	}
 	return b.String()
 }
 
@@ -222,29 +202,93 @@ func (s *SyncService) readPodChecksum(ctx context.Context, pod, commandName stri
 	return strings.TrimSpace(stdout)
 }
 
-// SyncSkillToAgent syncs a single skill to an agent pod.
-// Compares content_checksum from DB with the .checksum file on the pod;
+// syncTarget is the revision of a skill an agent should run.
+type syncTarget struct {
+	version   int
+	checksum  string
+	bundleKey string
+}
+
+// deliveredVersion returns the version of a skill an agent of userID runs:
+// the pinned version when it is older than the latest one, capped at the
+// published version for skills owned by someone else.
+func deliveredVersion(sk *models.Skill, pinned *int, userID int64) int {
+	want := sk.Version
+	if pinned != nil && *pinned < want {
+		want = *pinned
+	}
+	if sk.CreatedBy != userID && sk.PublishedVersion != nil && *sk.PublishedVersion < want {
+		want = *sk.PublishedVersion
+	}
+	return want
+}
+
+// resolveSyncTarget returns the revision an agent runs: the pinned or
+// published revision's bundle when that is older than the latest version,
+// otherwise the skill's live bundle. Unpublished skills of other users are
+// never delivered.
+func (s *SyncService) resolveSyncTarget(ctx context.Context, userID, agentID int64, sk *models.Skill) (syncTarget, error) {
+	if sk.CreatedBy != userID && sk.PublishedVersion == nil {
+		return syncTarget{}, fmt.Errorf("skill %q is not published", sk.CommandName)
+	}
+
+	var pinned *int
+	_ = s.db.NewSelect().
+		Model((*models.AgentSkill)(nil)).
+		Column("pinned_version").
+		Where("agent_id = ?", agentID).
+		Where("skill_id = ?", sk.ID).
+		Scan(ctx, &pinned)
+	want := deliveredVersion(sk, pinned, userID)
+	if want == sk.Version {
+		return syncTarget{version: sk.Version, checksum: sk.ContentChecksum, bundleKey: bundleS3Key(sk.ID)}, nil
+	}
+
+	var rev models.SkillVersion
+	err := s.db.NewSelect().Model(&rev).
+		Where("skill_id = ? AND version = ?", sk.ID, want).
+		Scan(ctx)
+	if err != nil || rev.BundleKey == "" {
+		return syncTarget{}, fmt.Errorf("version %d of skill %q is not available", want, sk.CommandName)
+	}
+	return syncTarget{version: rev.Version, checksum: rev.ContentChecksum, bundleKey: rev.BundleKey}, nil
+}
+
+// SyncSkillToAgent syncs a single skill to an agent pod, at the version the
+// installation is pinned to, the published one or the latest one, and
+// records the outcome in the installation's sync status.
+// Compares the target content_checksum with the .checksum file on the pod;
 // if they match, the skill is skipped. Otherwise, downloads the pre-built
 // bundle.tar from S3 and extracts it in one ExecInPod call.
 func (s *SyncService) SyncSkillToAgent(ctx context.Context, userID string, agentID int64, sk *models.Skill) error {
+	version, err := s.syncSkill(ctx, userID, agentID, sk)
+	s.recordSyncResult(ctx, agentID, sk.ID, version, err)
+	return err
+}
+
+// syncSkill does the work of SyncSkillToAgent and returns the version the
+// pod now runs.
+func (s *SyncService) syncSkill(ctx context.Context, userID string, agentID int64, sk *models.Skill) (int, error) {
 	if sk.CommandName == "" {
-		return fmt.Errorf("skill %d has no command_name", sk.ID)
+		return 0, fmt.Errorf("skill %d has no command_name", sk.ID)
 	}
 
 	uid, _ := strconv.ParseInt(userID, 10, 64)
 	pod := s.podName(userID, agentID)
 
+	target, err := s.resolveSyncTarget(ctx, uid, agentID, sk)
+	if err != nil {
+		return 0, err
+	}
+	if missing, err := subagent.MissingForSkill(ctx, s.db, uid, agentID, sk); err == nil && missing != "" {
+		return 0, fmt.Errorf("skill %q runs in subagent %q, which is not installed on the agent", sk.CommandName, missing)
+	}
+
 	// Compare checksums — skip if unchanged
 	podChecksum := s.readPodChecksum(ctx, pod, sk.CommandName)
-	if sk.ContentChecksum != "" && podChecksum == sk.ContentChecksum {
+	if target.checksum != "" && podChecksum == target.checksum {
 		log.Debug().Str("command", sk.CommandName).Str("pod", pod).Msg("skill checksum matches, skipping")
-		_, _ = s.db.NewUpdate().
-			Model((*models.AgentSkill)(nil)).
-			Set("synced_version = ?", sk.Version).
-			Where("agent_id = ?", agentID).
-			Where("skill_id = ?", sk.ID).
-			Exec(ctx)
-		return nil
+		return target.version, nil
 	}
 
 	s.publish(ctx, uid, agentID, SkillSyncEvent{
@@ -253,98 +297,45 @@ func (s *SyncService) SyncSkillToAgent(ctx context.Context, userID string, agent
 		Step: "syncing_skill", Message: fmt.Sprintf("Syncing %s...", sk.Name),
 	})
 
-	// Download pre-built bundle.tar from S3
-	var tarReader io.Reader
+	// Stream the pre-built bundle.tar from S3 straight into the pod
+	var bundle io.ReadCloser
 	if s.storage != nil {
 		if backend := s.storage.GetClient(ctx); backend != nil {
-			reader, err := backend.Download(ctx, bundleS3Key(sk.ID))
-			if err == nil {
-				data, readErr := io.ReadAll(reader)
-				reader.Close()
-				if readErr == nil && len(data) > 0 {
-					tarReader = bytes.NewReader(data)
-				}
+			if r, err := backend.Download(ctx, target.bundleKey); err == nil {
+				bundle = r
 			}
 		}
 	}
 
-	// Fallback: build tar on the fly if bundle.tar not available (legacy skills)
-	if tarReader == nil {
+	if bundle == nil {
+		if target.version != sk.Version {
+			return 0, fmt.Errorf("bundle for version %d of skill %q not found", target.version, sk.CommandName)
+		}
+		// Fallback: build tar on the fly if bundle.tar not available (legacy skills)
 		log.Debug().Str("command", sk.CommandName).Msg("bundle.tar not found, building on the fly")
-		buf, err := s.buildTarOnTheFly(ctx, sk)
+		bundle, err = s.streamBundleOnTheFly(ctx, sk)
 		if err != nil {
-			return fmt.Errorf("failed to build tar for skill %q: %w", sk.CommandName, err)
+			return 0, fmt.Errorf("failed to build tar for skill %q: %w", sk.CommandName, err)
 		}
-		tarReader = buf
 	}
+	defer bundle.Close()
 
-	// Clear existing directory and extract tar in one go
+	// Extract next to the skill directory and swap it in, so a bundle that
+	// fails mid-stream leaves the previous version in place. .checksum is the
+	// last entry of a bundle, so it is only present in a complete one.
+	cfg := loadLintConfig(ctx, s.db)
+	limit := cfg.MaxSkillBytes + cfg.MaxSkillMDBytes + bundleHeaderAllowance
 	skillDir := fmt.Sprintf("%s/%s", skillsDir, sk.CommandName)
-	cmd := []string{"bash", "-c", fmt.Sprintf("rm -rf %s && mkdir -p %s && tar xf - -C %s", skillDir, skillDir, skillDir)}
-	_, stderr, err := s.containerManager.ExecInPod(ctx, pod, cmd, tarReader)
+	tmpDir := fmt.Sprintf("%s/.%s.partial", skillsDir, sk.CommandName)
+	cmd := []string{"bash", "-c", fmt.Sprintf("rm -rf %[2]s && mkdir -p %[2]s && tar xf - -C %[2]s && test -f %[2]s/.checksum && rm -rf %[1]s && mv %[2]s %[1]s", skillDir, tmpDir)}
+	_, stderr, err := s.containerManager.ExecInPod(ctx, pod, cmd, &boundedReader{r: bundle, n: limit})
 	if err != nil {
-		return fmt.Errorf("failed to extract tar for skill %q in pod %s: %w (stderr: %s)", sk.CommandName, pod, err, stderr)
-	}
-
-	// Update synced_version
-	_, _ = s.db.NewUpdate().
-		Model((*models.AgentSkill)(nil)).
-		Set("synced_version = ?", sk.Version).
-		Where("agent_id = ?", agentID).
-		Where("skill_id = ?", sk.ID).
-		Exec(ctx)
-
-	log.Info().Str("command", sk.CommandName).Int("version", sk.Version).Str("pod", pod).Msg("synced skill via tar")
-	return nil
-}
-
-// buildTarOnTheFly constructs a tar archive for a skill when no pre-built bundle exists.
-// Used as fallback for legacy skills that were created before bundle.tar was introduced.
-func (s *SyncService) buildTarOnTheFly(ctx context.Context, sk *models.Skill) (*bytes.Reader, error) {
-	skillMD := buildSkillMD(sk)
-	contentChecksum := sk.ContentChecksum
-	if contentChecksum == "" {
-		contentChecksum = md5Hex(skillMD)
-	}
-
-	var tarBuf bytes.Buffer
-	tw := tar.NewWriter(&tarBuf)
-
-	// SKILL.md
-	skillMDBytes := []byte(skillMD)
-	_ = tw.WriteHeader(&tar.Header{Name: "SKILL.md", Mode: 0644, Size: int64(len(skillMDBytes))})
-	_, _ = tw.Write(skillMDBytes)
-
-	// Attached files from S3
-	var files []models.SkillFile
-	_ = s.db.NewSelect().Model(&files).Where("skill_id = ?", sk.ID).Order("filepath ASC").Scan(ctx)
-
-	if len(files) > 0 && s.storage != nil {
-		if backend := s.storage.GetClient(ctx); backend != nil {
-			for _, f := range files {
-				reader, err := backend.Download(ctx, f.S3Key)
-				if err != nil {
-					log.Warn().Err(err).Str("filepath", f.Filepath).Msg("fallback tar: failed to download")
-					continue
-				}
-				data, err := io.ReadAll(reader)
-				reader.Close()
-				if err != nil {
-					continue
-				}
-				_ = tw.WriteHeader(&tar.Header{Name: f.Filepath, Mode: 0644, Size: int64(len(data))})
-				_, _ = tw.Write(data)
-			}
-		}
+		_, _, _ = s.containerManager.ExecInPod(ctx, pod, []string{"rm", "-rf", tmpDir}, nil)
+		return 0, fmt.Errorf("failed to extract tar for skill %q in pod %s: %w (stderr: %s)", sk.CommandName, pod, err, stderr)
 	}
 
-	// .checksum
-	checksumBytes := []byte(contentChecksum)
-	_ = tw.WriteHeader(&tar.Header{Name: ".checksum", Mode: 0644, Size: int64(len(checksumBytes))})
-	_, _ = tw.Write(checksumBytes)
-	_ = tw.Close()
-
-	return bytes.NewReader(tarBuf.Bytes()), nil
+	log.Info().Str("command", sk.CommandName).Int("version", target.version).Str("pod", pod).Msg("synced skill via tar")
+	return target.version, nil
 }
 
 // RemoveSkillFromAgent deletes a skill's directory from the agent pod.
@@ -371,24 +362,41 @@ func (s *SyncService) RemoveSkillFromAgent(ctx context.Context, userID string, a
 }
 
 // SyncAllSkillsToAgent syncs all installed skills for an agent to its pod.
+// Pinned installations stay on their pinned version.
 // Uses two-layer incremental strategy:
-//  1. Version skip: if synced_version == skill.version, skip the entire skill
+//  1. Version skip: if synced_version == the target version, skip the entire skill
 //  2. Content checksum comparison: for changed skills, compare DB checksum with pod checksum
+//
+// The agent's MCP server config and subagents are rewritten along the way.
+// Returns ErrPodUnavailable when the agent's pod cannot be reached.
 func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, agentID int64) error {
+	return s.syncAllSkills(ctx, userID, agentID, false)
+}
+
+// SyncDueSkillsToAgent is SyncAllSkillsToAgent for the maintenance job:
+// installations whose last sync failed are only retried once their backoff
+// has elapsed.
+func (s *SyncService) SyncDueSkillsToAgent(ctx context.Context, userID string, agentID int64) error {
+	return s.syncAllSkills(ctx, userID, agentID, true)
+}
+
+func (s *SyncService) syncAllSkills(ctx context.Context, userID string, agentID int64, backoff bool) error {
 	uid, _ := strconv.ParseInt(userID, 10, 64)
 
 	// Query skills with their agent_skills junction to get synced_version
 	type skillWithSync struct {
 		models.Skill
-		SyncedVersion int `bun:"synced_version"`
+		SyncedVersion   int        `bun:"synced_version"`
+		PinnedVersion   *int       `bun:"pinned_version"`
+		NextSyncRetryAt *time.Time `bun:"next_sync_retry_at"`
 	}
 
 	var skills []skillWithSync
 	err := s.db.NewSelect().
-		TableExpr("skills AS sk").
+		Model((*models.Skill)(nil)).
 		Join("JOIN agent_skills AS ags ON ags.skill_id = sk.id").
-		ColumnExpr("sk.*").
-		ColumnExpr("ags.synced_version").
+		ColumnExpr("?TableColumns").
+		ColumnExpr("ags.synced_version, ags.pinned_version, ags.next_sync_retry_at").
 		Where("ags.agent_id = ?", agentID).
 		Scan(ctx, &skills)
 
@@ -398,7 +406,8 @@ func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, a
 
 	pod := s.podName(userID, agentID)
 
-	// Lazy revocation: filter out skills the user can no longer access
+	// Lazy revocation: filter out skills the user can no longer access,
+	// including skills of other users that were unpublished.
 	var userGroupIDs []int64
 	_ = s.db.NewSelect().TableExpr("group_members").
 		Column("group_id").
@@ -412,7 +421,13 @@ func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, a
 	var visible []skillWithSync
 	for i := range skills {
 		sk := &skills[i].Skill
-		if sk.IsOfficial || sk.IsPublic || sk.CreatedBy == uid {
+		if sk.CreatedBy == uid {
+			visible = append(visible, skills[i])
+		} else if sk.PublishedVersion == nil {
+			_, _ = s.db.NewDelete().Model((*models.AgentSkill)(nil)).
+				Where("agent_id = ? AND skill_id = ?", agentID, sk.ID).Exec(ctx)
+			log.Info().Int64("skill_id", sk.ID).Str("command", sk.CommandName).Str("pod", pod).Msg("revoked unpublished skill")
+		} else if sk.IsOfficial || sk.IsPublic {
 			visible = append(visible, skills[i])
 		} else if sk.GroupID != nil && groupSet[*sk.GroupID] {
 			visible = append(visible, skills[i])
@@ -426,19 +441,24 @@ func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, a
 	skills = visible
 
 	// Detect if skills dir exists on pod. If not (pod restart, emptyDir wiped),
-	// force full sync by ignoring version skip.
-	forceSync := false
-	checkCmd := []string{"test", "-d", skillsDir}
-	_, _, testErr := s.containerManager.ExecInPod(ctx, pod, checkCmd, nil)
-	if testErr != nil {
-		forceSync = true
+	// force full sync by ignoring version skip. An exec error means the pod
+	// itself is unreachable; that is not recorded against the skills.
+	checkCmd := []string{"sh", "-c", fmt.Sprintf("test -d %s && echo present || echo missing", skillsDir)}
+	stdout, _, execErr := s.containerManager.ExecInPod(ctx, pod, checkCmd, nil)
+	if execErr != nil {
+		return fmt.Errorf("%w: %s: %v", ErrPodUnavailable, pod, execErr)
+	}
+	forceSync := strings.TrimSpace(stdout) != "present"
+	if forceSync {
 		log.Info().Str("pod", pod).Msg("skills dir missing on pod, forcing full sync")
 	}
 
 	// Build set of expected skill directory names
 	expectedDirs := make(map[string]bool)
 
-	var synced, skippedByVersion int
+	var synced, skippedByVersion, deferred int
+	var failed []string
+	now := time.Now()
 
 	for i := range skills {
 		sws := &skills[i]
@@ -448,15 +468,27 @@ func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, a
 		}
 		expectedDirs[sk.CommandName] = true
 
-		// Layer 1: Version skip — if synced_version matches AND pod has files, skip
-		if !forceSync && sws.SyncedVersion == sk.Version {
+		// Layer 1: Version skip — if synced_version matches the delivered
+		// version AND pod has files, skip
+		wantVersion := deliveredVersion(sk, sws.PinnedVersion, uid)
+		if !forceSync && sws.SyncedVersion == wantVersion {
 			skippedByVersion++
 			continue
 		}
+		if backoff && sws.NextSyncRetryAt != nil && now.Before(*sws.NextSyncRetryAt) {
+			deferred++
+			continue
+		}
 
 		// Needs sync — SyncSkillToAgent handles checksum comparison internally
 		if err := s.SyncSkillToAgent(ctx, userID, agentID, sk); err != nil {
 			log.Warn().Err(err).Str("command", sk.CommandName).Str("pod", pod).Msg("failed to sync skill")
+			failed = append(failed, sk.CommandName)
+			s.publish(ctx, uid, agentID, SkillSyncEvent{
+				Action: "error", SkillID: sk.ID, SkillName: sk.Name,
+				CommandName: sk.CommandName, AgentID: agentID,
+				Step: "syncing_skill", Message: fmt.Sprintf("Failed to sync %s: %v", sk.Name, err),
+			})
 			continue
 		}
 		synced++
@@ -510,18 +542,57 @@ func (s *SyncService) SyncAllSkillsToAgent(ctx context.Context, userID string, a
 		}
 	}
 
-	if synced > 0 {
+	// MCP servers and subagents ride along with skills: same triggers, same pod.
+	if _, err := s.mcp.SyncToAgent(ctx, uid, agentID); err != nil {
+		log.Warn().Err(err).Str("pod", pod).Msg("failed to sync mcp servers")
+	}
+	if _, err := s.subagents.SyncToAgent(ctx, uid, agentID); err != nil {
+		log.Warn().Err(err).Str("pod", pod).Msg("failed to sync subagents")
+	}
+
+	if synced > 0 || len(failed) > 0 {
+		msg := fmt.Sprintf("Sync complete: %d synced, %d up to date", synced, skippedByVersion)
+		if len(failed) > 0 {
+			msg += fmt.Sprintf(", %d failed", len(failed))
+		}
 		s.publish(ctx, uid, agentID, SkillSyncEvent{
 			Action: "complete", AgentID: agentID,
 			Step:    "done",
-			Message: fmt.Sprintf("Sync complete: %d synced, %d up to date", synced, skippedByVersion),
+			Message: msg,
 		})
 	}
 
-	log.Info().Int("synced", synced).Int("skipped", skippedByVersion).Int("total", len(skills)).Str("pod", pod).Msg("synced skills")
+	s.notifySyncResult(uid, agentID, synced, failed)
+
+	log.Info().Int("synced", synced).Int("skipped", skippedByVersion).Int("deferred", deferred).Int("failed", len(failed)).Int("total", len(skills)).Str("pod", pod).Msg("synced skills")
 	return nil
 }
 
+// notifySyncResult notifies the agent owner when a full sync changed
+// anything or failed. No-op syncs stay silent.
+func (s *SyncService) notifySyncResult(userID, agentID int64, synced int, failed []string) {
+	switch {
+	case len(failed) > 0:
+		s.notifier.Notify(notify.Event{
+			Type:    notify.EventSkillSyncFailed,
+			UserID:  userID,
+			AgentID: agentID,
+			Title:   "Skill sync failed",
+			Message: fmt.Sprintf("%d skill(s) failed to sync: /%s", len(failed), strings.Join(failed, ", /")),
+			Data:    map[string]any{"synced": synced, "failed": failed},
+		})
+	case synced > 0:
+		s.notifier.Notify(notify.Event{
+			Type:    notify.EventSkillSyncCompleted,
+			UserID:  userID,
+			AgentID: agentID,
+			Title:   "Skills synced",
+			Message: fmt.Sprintf("%d skill(s) synced to the agent.", synced),
+			Data:    map[string]any{"synced": synced},
+		})
+	}
+}
+
 // CopySkillFiles duplicates all attached files from one skill to another in S3 and DB.
 // Used during fork operations.
 func (s *SyncService) CopySkillFiles(ctx context.Context, srcSkillID, dstSkillID int64) {
@@ -560,7 +631,7 @@ func (s *SyncService) CopySkillFiles(ctx context.Context, srcSkillID, dstSkillID
 	}
 
 	// Recompute content_checksum for the destination (forked) skill
-	if err := s.RebuildSkillBundle(ctx, dstSkillID); err != nil {
+	if err := s.RebuildSkillBundle(ctx, dstSkillID, fmt.Sprintf("Forked from skill %d", srcSkillID)); err != nil {
 		log.Warn().Err(err).Int64("skill_id", dstSkillID).Msg("failed to recompute content_checksum after fork")
 	}
 }
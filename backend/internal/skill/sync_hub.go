@@ -23,6 +23,7 @@ type SkillSyncEvent struct {
 	Message     string `json:"message"`
 	Current     int    `json:"current,omitempty"`
 	Total       int    `json:"total,omitempty"`
+	Replay      bool   `json:"replay,omitempty"` // rebuilt from the stored sync status when a client connects
 }
 
 // SyncProgressPublisher is the interface used by SyncService to publish progress events
@@ -0,0 +1,112 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrPodUnavailable is returned by a full sync when the agent's pod cannot
+// be reached, e.g. because the agent is stopped.
+var ErrPodUnavailable = errors.New("agent pod unavailable")
+
+const (
+	syncRetryBase = time.Minute
+	syncRetryMax  = 6 * time.Hour
+)
+
+// SyncRetryDelay is how long the maintenance job waits before retrying an
+// installation after its attempts-th consecutive failure: one minute,
+// doubling up to six hours.
+func SyncRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	delay := syncRetryBase
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= syncRetryMax {
+			return syncRetryMax
+		}
+	}
+	return delay
+}
+
+// recordSyncResult stores the outcome of syncing a skill to an agent in the
+// agent_skills row. A success resets the failure count; a failure schedules
+// the next maintenance retry.
+func (s *SyncService) recordSyncResult(ctx context.Context, agentID, skillID int64, version int, syncErr error) {
+	now := time.Now()
+	q := s.db.NewUpdate().
+		Model((*models.AgentSkill)(nil)).
+		Set("last_sync_attempt_at = ?", now).
+		Where("agent_id = ?", agentID).
+		Where("skill_id = ?", skillID)
+
+	if syncErr == nil {
+		_, err := q.
+			Set("synced_version = ?", version).
+			Set("last_synced_at = ?", now).
+			Set("sync_error = ''").
+			Set("sync_attempts = 0").
+			Set("next_sync_retry_at = NULL").
+			Exec(ctx)
+		if err != nil {
+			log.Warn().Err(err).Int64("agent_id", agentID).Int64("skill_id", skillID).Msg("failed to record skill sync")
+		}
+		return
+	}
+
+	var attempts int
+	_, err := q.
+		Set("sync_error = ?", syncErr.Error()).
+		Set("sync_attempts = sync_attempts + 1").
+		Returning("sync_attempts").
+		Exec(ctx, &attempts)
+	if err != nil {
+		log.Warn().Err(err).Int64("agent_id", agentID).Int64("skill_id", skillID).Msg("failed to record skill sync failure")
+		return
+	}
+	_, _ = s.db.NewUpdate().
+		Model((*models.AgentSkill)(nil)).
+		Set("next_sync_retry_at = ?", now.Add(SyncRetryDelay(attempts))).
+		Where("agent_id = ?", agentID).
+		Where("skill_id = ?", skillID).
+		Exec(ctx)
+}
+
+// ReplaySyncEvents rebuilds, from the sync status stored on the agent's
+// installations, an error event for every skill whose last sync failed, so
+// a client connecting after a sync still sees what is broken. Agents of
+// other users have no events.
+func (s *SyncService) ReplaySyncEvents(ctx context.Context, userID, agentID int64) ([]SkillSyncEvent, error) {
+	var installed []models.AgentSkill
+	err := s.db.NewSelect().Model(&installed).
+		Relation("Skill").
+		Where("?TableAlias.agent_id = ?", agentID).
+		Where("?TableAlias.sync_error <> ''").
+		Where("?TableAlias.agent_id IN (SELECT id FROM agents WHERE created_by = ?)", userID).
+		Order("as.order ASC", "as.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]SkillSyncEvent, 0, len(installed))
+	for _, as := range installed {
+		if as.Skill == nil {
+			continue
+		}
+		events = append(events, SkillSyncEvent{
+			Type: "skill_sync", Action: "error", SkillID: as.SkillID, SkillName: as.Skill.Name,
+			CommandName: as.Skill.CommandName, AgentID: agentID,
+			Step: "syncing_skill", Message: fmt.Sprintf("Failed to sync %s: %s", as.Skill.Name, as.SyncError),
+			Replay: true,
+		})
+	}
+	return events, nil
+}
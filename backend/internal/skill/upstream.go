@@ -0,0 +1,465 @@
+package skill
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// maxMergeFileSize bounds attached files merged line by line; larger files
+// changed on both sides keep the fork's content.
+const maxMergeFileSize = 1 << 20
+
+// Resolutions of an attached file in an upstream merge.
+const (
+	resolveTakeUpstream = "take_upstream"
+	resolveKeepLocal    = "keep_local"
+	resolveMerge        = "merge"
+)
+
+// upstreamMerge is the three-way comparison of a fork with its upstream.
+type upstreamMerge struct {
+	upstream *models.Skill
+	base     *models.SkillVersion // nil when the fork point is unknown
+	theirs   *models.SkillVersion // newest upstream revision visible to the caller
+
+	prompt      string
+	frontmatter models.SkillFrontmatter
+	files       []*sacv1.SkillUpstreamFile
+	conflicts   []string
+}
+
+// fileChange describes how a file changed between two manifests.
+func fileChange(from, to map[string]string, p string) string {
+	a, inFrom := from[p]
+	b, inTo := to[p]
+	switch {
+	case !inFrom && inTo:
+		return "added"
+	case inFrom && !inTo:
+		return "removed"
+	case a != b:
+		return "changed"
+	}
+	return "unchanged"
+}
+
+func manifestChecksums(files []models.SkillVersionFile) map[string]string {
+	m := make(map[string]string, len(files))
+	for _, f := range files {
+		m[f.Filepath] = f.Checksum
+	}
+	return m
+}
+
+// mergeFrontmatter merges frontmatter field by field. Fields both sides set
+// to different values keep the fork's value and are reported.
+func mergeFrontmatter(base, ours, theirs models.SkillFrontmatter) (models.SkillFrontmatter, []string) {
+	fields := func(fm models.SkillFrontmatter) map[string]string {
+		raw, _ := json.Marshal(fm)
+		var m map[string]json.RawMessage
+		_ = json.Unmarshal(raw, &m)
+		out := make(map[string]string, len(m))
+		for k, v := range m {
+			out[k] = string(v)
+		}
+		return out
+	}
+	b, o, t := fields(base), fields(ours), fields(theirs)
+
+	keys := make(map[string]bool)
+	for _, m := range []map[string]string{b, o, t} {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+	merged := make(map[string]json.RawMessage)
+	var conflicts []string
+	for k := range keys {
+		bv, ov, tv := b[k], o[k], t[k]
+		v := ov
+		switch {
+		case ov == bv:
+			v = tv
+		case tv != bv && tv != ov:
+			conflicts = append(conflicts, "frontmatter."+k)
+		}
+		if v != "" {
+			merged[k] = json.RawMessage(v)
+		}
+	}
+	sort.Strings(conflicts)
+
+	var fm models.SkillFrontmatter
+	raw, _ := json.Marshal(merged)
+	_ = json.Unmarshal(raw, &fm)
+	return fm, conflicts
+}
+
+// planUpstreamMerge loads the revisions involved in merging upstream into
+// fork and merges SKILL.md. Attached files are only classified.
+func (s *Server) planUpstreamMerge(ctx context.Context, fork *models.Skill) (*upstreamMerge, error) {
+	if fork.ForkedFrom == nil {
+		return nil, grpcerr.BadRequest("Skill is not a fork")
+	}
+	var up models.Skill
+	if err := s.db.NewSelect().Model(&up).Where("id = ?", *fork.ForkedFrom).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Upstream skill no longer exists", err)
+	}
+	maxVersion := s.visibleVersion(ctx, &up)
+	if maxVersion == 0 {
+		return nil, grpcerr.NotFound("Upstream skill is not available")
+	}
+	theirs, err := s.loadVersion(ctx, up.ID, 0, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+	m := &upstreamMerge{upstream: &up, theirs: theirs}
+	if fork.UpstreamVersion != nil && *fork.UpstreamVersion > 0 {
+		// A base that is gone falls back to an unknown fork point.
+		m.base, _ = s.loadVersion(ctx, up.ID, int32(*fork.UpstreamVersion), maxVersion)
+	}
+
+	base := &models.SkillVersion{}
+	if m.base != nil {
+		base = m.base
+	}
+	label := fmt.Sprintf("upstream v%d", theirs.Version)
+	var conflict bool
+	m.prompt, conflict = ThreeWayMerge(base.Prompt, fork.Prompt, theirs.Prompt, label)
+	if conflict {
+		m.conflicts = append(m.conflicts, "prompt")
+	}
+	var fmConflicts []string
+	m.frontmatter, fmConflicts = mergeFrontmatter(base.Frontmatter, fork.Frontmatter, theirs.Frontmatter)
+	m.conflicts = append(m.conflicts, fmConflicts...)
+
+	var local []models.SkillFile
+	if err := s.db.NewSelect().Model(&local).Where("skill_id = ?", fork.ID).Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to load skill files", err)
+	}
+	localSums := make(map[string]string, len(local))
+	for _, f := range local {
+		localSums[f.Filepath] = f.Checksum
+	}
+	baseSums, theirSums := manifestChecksums(base.Files), manifestChecksums(theirs.Files)
+
+	paths := make(map[string]bool)
+	for _, sums := range []map[string]string{baseSums, theirSums, localSums} {
+		for p := range sums {
+			paths[p] = true
+		}
+	}
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+
+	for _, p := range sorted {
+		f := &sacv1.SkillUpstreamFile{
+			Filepath:       p,
+			UpstreamChange: fileChange(baseSums, theirSums, p),
+			LocalChange:    fileChange(baseSums, localSums, p),
+		}
+		_, inLocal := localSums[p]
+		_, inTheirs := theirSums[p]
+		switch {
+		case f.UpstreamChange == "unchanged" || (inLocal == inTheirs && localSums[p] == theirSums[p]):
+			f.Resolution = resolveKeepLocal
+		case f.LocalChange == "unchanged":
+			f.Resolution = resolveTakeUpstream
+		default:
+			f.Resolution = resolveMerge
+		}
+		if f.UpstreamChange != "unchanged" || f.LocalChange != "unchanged" {
+			m.files = append(m.files, f)
+		}
+	}
+	return m, nil
+}
+
+func (s *Server) loadForkForUpstream(ctx context.Context, id int64, edit bool) (*models.Skill, error) {
+	var fork models.Skill
+	if err := s.db.NewSelect().Model(&fork).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if edit {
+		if !canEditSkill(ctx, &fork) {
+			return nil, grpcerr.Forbidden("Only the skill owner can merge upstream changes")
+		}
+		if fork.SourceID != nil {
+			return nil, grpcerr.Forbidden(sourceManagedMsg)
+		}
+	} else if !s.canViewSkill(ctx, &fork, ctxkeys.UserID(ctx)) {
+		return nil, grpcerr.NotFound("Skill not found")
+	}
+	return &fork, nil
+}
+
+func (s *Server) GetSkillUpstream(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.SkillUpstreamStatus, error) {
+	fork, err := s.loadForkForUpstream(ctx, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.planUpstreamMerge(ctx, fork)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &sacv1.SkillUpstreamStatus{
+		UpstreamId:      m.upstream.ID,
+		UpstreamVersion: int32(m.theirs.Version),
+		UpdateAvailable: m.base == nil || m.theirs.Version > m.base.Version,
+		Files:           m.files,
+		Conflicts:       m.conflicts,
+	}
+	baseMD, baseName := "", "fork-point/SKILL.md"
+	if m.base != nil {
+		v := int32(m.base.Version)
+		status.BaseVersion = &v
+		baseMD, baseName = renderedSkillMD(m.base), fmt.Sprintf("upstream-v%d/SKILL.md", m.base.Version)
+	}
+	status.UpstreamDiff = unifiedDiff(baseMD, renderedSkillMD(m.theirs), baseName, fmt.Sprintf("upstream-v%d/SKILL.md", m.theirs.Version))
+	status.LocalDiff = unifiedDiff(baseMD, buildSkillMD(fork), baseName, "fork/SKILL.md")
+	return status, nil
+}
+
+func (s *Server) MergeUpstream(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.MergeUpstreamResponse, error) {
+	fork, err := s.loadForkForUpstream(ctx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.planUpstreamMerge(ctx, fork)
+	if err != nil {
+		return nil, err
+	}
+	if m.base != nil && m.theirs.Version <= m.base.Version {
+		return nil, grpcerr.Conflict("The fork is already up to date with its upstream")
+	}
+
+	conflicts := m.conflicts
+	if len(m.files) > 0 {
+		var backend storage.StorageBackend
+		if s.syncService.storage != nil {
+			backend = s.syncService.storage.GetClient(ctx)
+		}
+		if backend == nil {
+			return nil, grpcerr.Unavailable("Storage not configured")
+		}
+		fileConflicts, err := s.mergeUpstreamFiles(ctx, backend, fork, m)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to merge upstream files", err)
+		}
+		conflicts = append(conflicts, fileConflicts...)
+	}
+
+	_, err = s.db.NewUpdate().Model((*models.Skill)(nil)).
+		Set("prompt = ?", m.prompt).
+		Set("frontmatter = ?", m.frontmatter).
+		Set("upstream_version = ?", m.theirs.Version).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", fork.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update skill", err)
+	}
+	note := fmt.Sprintf("Merged upstream version %d", m.theirs.Version)
+	if len(conflicts) > 0 {
+		note += " with conflicts"
+	}
+	if err := s.syncService.RebuildSkillBundle(ctx, fork.ID, note); err != nil {
+		log.Warn().Err(err).Int64("skill_id", fork.ID).Msg("failed to rebuild skill bundle after upstream merge")
+	}
+
+	var merged models.Skill
+	if err := s.db.NewSelect().Model(&merged).Apply(withSkillStats).Relation("Files").Where("sk.id = ?", fork.ID).Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to reload skill after merge", err)
+	}
+	log.Info().Int64("skill_id", fork.ID).Int64("upstream_id", m.upstream.ID).Int("upstream_version", m.theirs.Version).
+		Int("conflicts", len(conflicts)).Msg("merged upstream skill changes")
+	return &sacv1.MergeUpstreamResponse{
+		Skill:         convert.SkillToProto(&merged),
+		MergedVersion: int32(m.theirs.Version),
+		Conflicts:     conflicts,
+	}, nil
+}
+
+// mergeUpstreamFiles applies the attached file changes of a merge and
+// returns the files left in conflict.
+func (s *Server) mergeUpstreamFiles(ctx context.Context, backend storage.StorageBackend, fork *models.Skill, m *upstreamMerge) ([]string, error) {
+	theirFiles := make(map[string]models.SkillVersionFile, len(m.theirs.Files))
+	for _, f := range m.theirs.Files {
+		theirFiles[f.Filepath] = f
+	}
+
+	take := make(map[string]models.SkillVersionFile)
+	var remove []string
+	var merge []*sacv1.SkillUpstreamFile
+	for _, f := range m.files {
+		switch f.Resolution {
+		case resolveTakeUpstream:
+			if tf, ok := theirFiles[f.Filepath]; ok {
+				take[f.Filepath] = tf
+			} else {
+				remove = append(remove, f.Filepath)
+			}
+		case resolveMerge:
+			merge = append(merge, f)
+		}
+	}
+
+	if len(take) > 0 {
+		if m.theirs.BundleKey == "" {
+			return nil, errVersionNotRestorable
+		}
+		if err := restoreFilesFromBundle(ctx, backend, fmt.Sprintf("skills/%d/", fork.ID), m.theirs.BundleKey, take); err != nil {
+			return nil, err
+		}
+		for _, f := range take {
+			if err := upsertSkillFile(ctx, s.db, fork.ID, f); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, p := range remove {
+		_, err := s.db.NewDelete().Model((*models.SkillFile)(nil)).
+			Where("skill_id = ? AND filepath = ?", fork.ID, p).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		_ = backend.Delete(ctx, fmt.Sprintf("skills/%d/%s", fork.ID, p))
+	}
+
+	var conflicts []string
+	for _, f := range merge {
+		clean, err := s.mergeUpstreamFile(ctx, backend, fork, m, f, theirFiles)
+		if err != nil {
+			return nil, err
+		}
+		if !clean {
+			conflicts = append(conflicts, "files/"+f.Filepath)
+		}
+	}
+	return conflicts, nil
+}
+
+// mergeUpstreamFile merges one attached file both sides changed. Text files
+// are merged line by line; anything else keeps the fork's content.
+func (s *Server) mergeUpstreamFile(ctx context.Context, backend storage.StorageBackend, fork *models.Skill, m *upstreamMerge, f *sacv1.SkillUpstreamFile, theirFiles map[string]models.SkillVersionFile) (bool, error) {
+	if f.LocalChange == "removed" || f.UpstreamChange == "removed" {
+		return false, nil
+	}
+	theirs, err := readBundleFile(ctx, backend, m.theirs.BundleKey, f.Filepath)
+	if err != nil || theirs == nil {
+		return false, nil
+	}
+	var base []byte
+	if m.base != nil && f.UpstreamChange != "added" {
+		if base, err = readBundleFile(ctx, backend, m.base.BundleKey, f.Filepath); err != nil || base == nil {
+			return false, nil
+		}
+	}
+	ours, err := readStoredFile(ctx, backend, fmt.Sprintf("skills/%d/%s", fork.ID, f.Filepath))
+	if err != nil || ours == nil {
+		return false, nil
+	}
+	if !utf8.Valid(base) || !utf8.Valid(ours) || !utf8.Valid(theirs) {
+		return false, nil
+	}
+
+	merged, conflict := ThreeWayMerge(string(base), string(ours), string(theirs), fmt.Sprintf("upstream v%d", m.theirs.Version))
+	contentType := theirFiles[f.Filepath].ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(f.Filepath))
+	}
+	if _, err := storeSkillFile(ctx, s.db, backend, fork.ID, f.Filepath, contentType, bytes.NewReader([]byte(merged)), int64(len(merged))); err != nil {
+		return false, err
+	}
+	return !conflict, nil
+}
+
+// upsertSkillFile records a file restored to a skill's storage from a
+// revision manifest.
+func upsertSkillFile(ctx context.Context, db bun.IDB, skillID int64, f models.SkillVersionFile) error {
+	sf := &models.SkillFile{
+		SkillID:     skillID,
+		Filepath:    f.Filepath,
+		S3Key:       fmt.Sprintf("skills/%d/%s", skillID, f.Filepath),
+		Checksum:    f.Checksum,
+		Size:        f.Size,
+		ContentType: f.ContentType,
+		CreatedAt:   time.Now(),
+	}
+	_, err := db.NewInsert().Model(sf).
+		On("CONFLICT (skill_id, filepath) DO UPDATE").
+		Set("s3_key = EXCLUDED.s3_key").
+		Set("checksum = EXCLUDED.checksum").
+		Set("size = EXCLUDED.size").
+		Set("content_type = EXCLUDED.content_type").
+		Exec(ctx)
+	return err
+}
+
+// readBundleFile returns a file of a revision bundle, or nil when the
+// bundle does not hold it or it is too large to merge.
+func readBundleFile(ctx context.Context, backend storage.StorageBackend, bundleKey, name string) ([]byte, error) {
+	if bundleKey == "" {
+		return nil, nil
+	}
+	body, err := backend.Download(ctx, bundleKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	tr := tar.NewReader(body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name != name {
+			continue
+		}
+		if hdr.Size > maxMergeFileSize {
+			return nil, nil
+		}
+		return io.ReadAll(io.LimitReader(tr, maxMergeFileSize))
+	}
+}
+
+// readStoredFile reads a live skill file, or returns nil when it is too
+// large to merge.
+func readStoredFile(ctx context.Context, backend storage.StorageBackend, key string) ([]byte, error) {
+	body, err := backend.Download(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := io.ReadAll(io.LimitReader(body, maxMergeFileSize+1))
+	if err != nil || len(data) > maxMergeFileSize {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,449 @@
+package skill
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// recordVersion stores the revision produced by RebuildSkillBundle. The
+// freshly uploaded bundle is copied to a per-version key so later rebuilds
+// cannot overwrite it.
+func (s *SyncService) recordVersion(ctx context.Context, sk *models.Skill, version int, skillMD string, files []models.SkillFile, checksum string, uploaded bool, note string) {
+	bundleKey := ""
+	if uploaded {
+		if backend := s.storage.GetClient(ctx); backend != nil {
+			key := versionBundleS3Key(sk.ID, version)
+			if err := backend.Copy(ctx, bundleS3Key(sk.ID), key); err != nil {
+				log.Warn().Err(err).Int64("skill_id", sk.ID).Int("version", version).Msg("failed to keep versioned bundle")
+			} else {
+				bundleKey = key
+			}
+		}
+	}
+
+	manifest := make([]models.SkillVersionFile, 0, len(files))
+	for _, f := range files {
+		manifest = append(manifest, models.SkillVersionFile{
+			Filepath:    f.Filepath,
+			Checksum:    f.Checksum,
+			Size:        f.Size,
+			ContentType: f.ContentType,
+		})
+	}
+
+	rev := &models.SkillVersion{
+		SkillID:         sk.ID,
+		Version:         version,
+		SkillMD:         skillMD,
+		Prompt:          sk.Prompt,
+		Frontmatter:     sk.Frontmatter,
+		Files:           manifest,
+		BundleKey:       bundleKey,
+		ContentChecksum: checksum,
+		Note:            note,
+		CreatedAt:       time.Now(),
+	}
+	if uid := ctxkeys.UserID(ctx); uid > 0 {
+		rev.CreatedBy = &uid
+	}
+	if _, err := s.db.NewInsert().Model(rev).On("CONFLICT (skill_id, version) DO NOTHING").Exec(ctx); err != nil {
+		log.Warn().Err(err).Int64("skill_id", sk.ID).Int("version", version).Msg("failed to record skill version")
+	}
+}
+
+// preserveSeededBundle keeps the current bundle.tar for the latest revision
+// when it was seeded by migration (no SKILL.md, no bundle), before the
+// rebuild overwrites it. The live bundle still holds that revision's content.
+func (s *SyncService) preserveSeededBundle(ctx context.Context, backend storage.StorageBackend, sk *models.Skill) {
+	var rev models.SkillVersion
+	err := s.db.NewSelect().Model(&rev).
+		Where("skill_id = ?", sk.ID).
+		Order("version DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil || rev.SkillMD != "" || rev.BundleKey != "" {
+		return
+	}
+	key := versionBundleS3Key(sk.ID, rev.Version)
+	if err := backend.Copy(ctx, bundleS3Key(sk.ID), key); err != nil {
+		return // no bundle was ever built
+	}
+	_, _ = s.db.NewUpdate().Model(&rev).Set("bundle_key = ?", key).WherePK().Exec(ctx)
+}
+
+// RestoreSkillVersion makes a past revision the skill's current content:
+// prompt, frontmatter and attached files are restored from the revision's
+// bundle, and a new revision is recorded. Files are staged and only copied
+// over the live objects once the database restore committed, so a failed
+// restore keeps the current files. Installed agents are not synced here;
+// see ResyncSkill.
+func (s *SyncService) RestoreSkillVersion(ctx context.Context, skillID int64, rev *models.SkillVersion) error {
+	var current []models.SkillFile
+	if err := s.db.NewSelect().Model(&current).Where("skill_id = ?", skillID).Scan(ctx); err != nil {
+		return fmt.Errorf("failed to load skill files: %w", err)
+	}
+	currentByPath := make(map[string]models.SkillFile, len(current))
+	for _, f := range current {
+		currentByPath[f.Filepath] = f
+	}
+
+	// Files whose content differs from the current state must come from the
+	// revision's bundle.
+	wanted := make(map[string]models.SkillVersionFile)
+	for _, f := range rev.Files {
+		if cur, ok := currentByPath[f.Filepath]; !ok || cur.Checksum != f.Checksum {
+			wanted[f.Filepath] = f
+		}
+	}
+
+	var backend storage.StorageBackend
+	if s.storage != nil {
+		backend = s.storage.GetClient(ctx)
+	}
+	staging := restoreStagingPrefix(skillID, rev.Version)
+	if len(wanted) > 0 {
+		if backend == nil || rev.BundleKey == "" {
+			return errVersionNotRestorable
+		}
+		defer func() { _ = backend.DeletePrefix(ctx, staging) }()
+		if err := restoreFilesFromBundle(ctx, backend, staging, rev.BundleKey, wanted); err != nil {
+			return err
+		}
+	}
+
+	keep := make(map[string]bool, len(rev.Files))
+	for _, f := range rev.Files {
+		keep[f.Filepath] = true
+	}
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, f := range current {
+			if keep[f.Filepath] {
+				continue
+			}
+			if _, err := tx.NewDelete().Model((*models.SkillFile)(nil)).Where("id = ?", f.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		for _, f := range rev.Files {
+			if err := upsertSkillFile(ctx, tx, skillID, f); err != nil {
+				return err
+			}
+		}
+		_, err := tx.NewUpdate().Model((*models.Skill)(nil)).
+			Set("prompt = ?", rev.Prompt).
+			Set("frontmatter = ?", rev.Frontmatter).
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", skillID).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore skill %d to version %d: %w", skillID, rev.Version, err)
+	}
+
+	for path := range wanted {
+		if err := backend.Copy(ctx, staging+path, fmt.Sprintf("skills/%d/%s", skillID, path)); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", path, err)
+		}
+	}
+
+	// Remove objects of files that are no longer part of the skill.
+	if backend != nil {
+		for _, f := range current {
+			if !keep[f.Filepath] {
+				_ = backend.Delete(ctx, f.S3Key)
+			}
+		}
+	}
+
+	return s.RebuildSkillBundle(ctx, skillID, fmt.Sprintf("Rolled back to version %d", rev.Version))
+}
+
+// errVersionNotRestorable is returned when a revision's files are no longer
+// available (seeded revision whose bundle was never built).
+var errVersionNotRestorable = errors.New("files of this version are not available")
+
+// restoreStagingPrefix is where the files of a revision are staged while
+// the skill is restored to it.
+func restoreStagingPrefix(skillID int64, version int) string {
+	return fmt.Sprintf("skills/%d/versions/%d/restore/", skillID, version)
+}
+
+// restoreFilesFromBundle streams the wanted files out of a revision bundle
+// to their paths below prefix.
+func restoreFilesFromBundle(ctx context.Context, backend storage.StorageBackend, prefix, bundleKey string, wanted map[string]models.SkillVersionFile) error {
+	body, err := backend.Download(ctx, bundleKey)
+	if err != nil {
+		return errVersionNotRestorable
+	}
+	defer body.Close()
+
+	restored := 0
+	tr := tar.NewReader(body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read version bundle: %w", err)
+		}
+		f, ok := wanted[hdr.Name]
+		if !ok {
+			continue
+		}
+		if err := backend.Upload(ctx, prefix+f.Filepath, tr, hdr.Size, f.ContentType); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", f.Filepath, err)
+		}
+		restored++
+	}
+	if restored != len(wanted) {
+		return errVersionNotRestorable
+	}
+	return nil
+}
+
+// ResyncSkill pushes the current content of a skill to every agent that has
+// it installed; pinned installations keep their version. Agents whose pod is
+// not running pick it up on the next session start or maintenance sync.
+func (s *SyncService) ResyncSkill(ctx context.Context, skillID int64) {
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", skillID).Scan(ctx); err != nil {
+		return
+	}
+
+	var targets []struct {
+		AgentID int64 `bun:"agent_id"`
+		UserID  int64 `bun:"created_by"`
+	}
+	err := s.db.NewSelect().
+		TableExpr("agent_skills AS ags").
+		Join("JOIN agents AS a ON a.id = ags.agent_id").
+		ColumnExpr("ags.agent_id, a.created_by").
+		Where("ags.skill_id = ?", skillID).
+		Scan(ctx, &targets)
+	if err != nil {
+		log.Warn().Err(err).Int64("skill_id", skillID).Msg("failed to list agents for skill resync")
+		return
+	}
+
+	for _, t := range targets {
+		if err := s.SyncSkillToAgent(ctx, strconv.FormatInt(t.UserID, 10), t.AgentID, &sk); err != nil {
+			log.Debug().Err(err).Int64("skill_id", skillID).Int64("agent_id", t.AgentID).Msg("skill resync skipped")
+		}
+	}
+}
+
+// --- Version history RPCs ---
+
+// canViewSkill mirrors the visibility rules of ListSkills. Reviewers of a
+// pending submission may also see the skill.
+func (s *Server) canViewSkill(ctx context.Context, sk *models.Skill, userID int64) bool {
+	if sk.CreatedBy == userID || ctxkeys.Role(ctx) == "admin" {
+		return true
+	}
+	if sk.PublishedVersion != nil {
+		if sk.IsOfficial || sk.IsPublic {
+			return true
+		}
+		if sk.GroupID != nil && s.isGroupMember(ctx, *sk.GroupID, userID) {
+			return true
+		}
+	}
+	return s.reviewableVersion(ctx, sk) > 0
+}
+
+// visibleVersion returns the newest revision of sk the caller may see.
+// Unreviewed revisions are hidden from everyone but the owner, admins and
+// the reviewers of a pending submission.
+func (s *Server) visibleVersion(ctx context.Context, sk *models.Skill) int {
+	if sk.CreatedBy == ctxkeys.UserID(ctx) || ctxkeys.Role(ctx) == "admin" {
+		return sk.Version
+	}
+	v := 0
+	if sk.PublishedVersion != nil {
+		v = *sk.PublishedVersion
+	}
+	if rv := s.reviewableVersion(ctx, sk); rv > v {
+		v = rv
+	}
+	return v
+}
+
+// reviewableVersion returns the version of the skill's pending review when
+// the caller can decide it, otherwise 0.
+func (s *Server) reviewableVersion(ctx context.Context, sk *models.Skill) int {
+	var rv models.SkillReview
+	err := s.db.NewSelect().Model(&rv).
+		Where("skill_id = ? AND status = ?", sk.ID, models.SkillReviewPending).
+		Scan(ctx)
+	if err != nil || !s.canReview(ctx, &rv) {
+		return 0
+	}
+	return rv.Version
+}
+
+func (s *Server) loadViewableSkill(ctx context.Context, id int64) (*models.Skill, error) {
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if !s.canViewSkill(ctx, &sk, ctxkeys.UserID(ctx)) {
+		return nil, grpcerr.NotFound("Skill not found")
+	}
+	return &sk, nil
+}
+
+func (s *Server) ListSkillVersions(ctx context.Context, req *sacv1.GetSkillRequest) (*sacv1.SkillVersionListResponse, error) {
+	sk, err := s.loadViewableSkill(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []models.SkillVersion
+	err = s.db.NewSelect().Model(&versions).
+		Where("skill_id = ?", req.Id).
+		Where("version <= ?", s.visibleVersion(ctx, sk)).
+		Order("version DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list skill versions", err)
+	}
+
+	resp := &sacv1.SkillVersionListResponse{}
+	for i := range versions {
+		resp.Versions = append(resp.Versions, convert.SkillVersionToProto(&versions[i], renderedSkillMD(&versions[i])))
+	}
+	return resp, nil
+}
+
+// loadVersion returns a revision of a skill up to maxVersion; version 0 is
+// the newest one.
+func (s *Server) loadVersion(ctx context.Context, skillID int64, version int32, maxVersion int) (*models.SkillVersion, error) {
+	var rev models.SkillVersion
+	q := s.db.NewSelect().Model(&rev).Where("skill_id = ?", skillID).Where("version <= ?", maxVersion)
+	if version > 0 {
+		q = q.Where("version = ?", version)
+	} else {
+		q = q.Order("version DESC").Limit(1)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound(fmt.Sprintf("Version %d not found", version), err)
+	}
+	return &rev, nil
+}
+
+// renderedSkillMD returns the SKILL.md of a revision, rendering it for
+// seeded revisions that only stored prompt and frontmatter.
+func renderedSkillMD(rev *models.SkillVersion) string {
+	if rev.SkillMD != "" {
+		return rev.SkillMD
+	}
+	return buildSkillMD(&models.Skill{Prompt: rev.Prompt, Frontmatter: rev.Frontmatter})
+}
+
+func (s *Server) DiffSkillVersions(ctx context.Context, req *sacv1.DiffSkillVersionsRequest) (*sacv1.SkillVersionDiff, error) {
+	sk, err := s.loadViewableSkill(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if req.FromVersion <= 0 {
+		return nil, grpcerr.BadRequest("from_version is required")
+	}
+	maxVersion := s.visibleVersion(ctx, sk)
+	from, err := s.loadVersion(ctx, req.Id, req.FromVersion, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.loadVersion(ctx, req.Id, req.ToVersion, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	fromMD, toMD := renderedSkillMD(from), renderedSkillMD(to)
+	diff := &sacv1.SkillVersionDiff{
+		FromVersion:    int32(from.Version),
+		ToVersion:      int32(to.Version),
+		SkillMdChanged: fromMD != toMD,
+		SkillMdDiff: unifiedDiff(fromMD, toMD,
+			fmt.Sprintf("v%d/SKILL.md", from.Version), fmt.Sprintf("v%d/SKILL.md", to.Version)),
+	}
+
+	oldFiles := make(map[string]models.SkillVersionFile, len(from.Files))
+	for _, f := range from.Files {
+		oldFiles[f.Filepath] = f
+	}
+	for _, f := range to.Files {
+		old, ok := oldFiles[f.Filepath]
+		delete(oldFiles, f.Filepath)
+		switch {
+		case !ok:
+			diff.Files = append(diff.Files, &sacv1.SkillFileChange{Filepath: f.Filepath, Change: "added", NewSize: f.Size})
+		case old.Checksum != f.Checksum:
+			diff.Files = append(diff.Files, &sacv1.SkillFileChange{Filepath: f.Filepath, Change: "changed", OldSize: old.Size, NewSize: f.Size})
+		}
+	}
+	for _, f := range from.Files {
+		if _, removed := oldFiles[f.Filepath]; removed {
+			diff.Files = append(diff.Files, &sacv1.SkillFileChange{Filepath: f.Filepath, Change: "removed", OldSize: f.Size})
+		}
+	}
+
+	return diff, nil
+}
+
+func (s *Server) RollbackSkill(ctx context.Context, req *sacv1.RollbackSkillRequest) (*sacv1.Skill, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	var sk models.Skill
+	if err := s.db.NewSelect().Model(&sk).Where("id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Skill not found", err)
+	}
+	if sk.IsOfficial && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Only admins can edit official skills")
+	}
+	if !sk.IsOfficial && sk.CreatedBy != userID {
+		return nil, grpcerr.Forbidden("You don't have permission to update this skill")
+	}
+	if sk.SourceID != nil {
+		return nil, grpcerr.Forbidden(sourceManagedMsg)
+	}
+	if req.Version <= 0 {
+		return nil, grpcerr.BadRequest("version is required")
+	}
+
+	rev, err := s.loadVersion(ctx, req.Id, req.Version, sk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.syncService.RestoreSkillVersion(ctx, req.Id, rev); err != nil {
+		if err == errVersionNotRestorable {
+			return nil, grpcerr.Conflict(fmt.Sprintf("Version %d cannot be restored: its files are no longer available", rev.Version))
+		}
+		return nil, grpcerr.Internal("Failed to roll back skill", err)
+	}
+
+	go s.syncService.ResyncSkill(context.Background(), req.Id)
+
+	var updated models.Skill
+	if err := s.db.NewSelect().Model(&updated).Relation("Files").Where("sk.id = ?", req.Id).Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to reload skill after rollback", err)
+	}
+	return convert.SkillToProto(&updated), nil
+}
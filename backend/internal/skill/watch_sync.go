@@ -18,7 +18,7 @@ var syncWsUpgrader = websocket.Upgrader{
 
 // WatchSync is a WebSocket endpoint that pushes skill sync progress events to the client.
 // JWT is read from the "token" query parameter.
-func WatchSync(hub *SyncHub, jwtService *auth.JWTService) gin.HandlerFunc {
+func WatchSync(hub *SyncHub, syncService *SyncService, jwtService *auth.JWTService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if hub == nil {
 			c.JSON(503, gin.H{"error": "Skill sync watch not available (Redis not configured)"})
@@ -74,6 +74,20 @@ func WatchSync(hub *SyncHub, jwtService *auth.JWTService) gin.HandlerFunc {
 		ch, unsub := hub.Subscribe(userID, agentID)
 		defer unsub()
 
+		// Replay the stored failures so a client connecting after a sync
+		// still sees how it ended.
+		replay, err := syncService.ReplaySyncEvents(c.Request.Context(), userID, agentID)
+		if err != nil {
+			log.Warn().Err(err).Int64("agent_id", agentID).Msg("WatchSync: failed to load sync status")
+		}
+		for _, event := range replay {
+			if data, err := json.Marshal(event); err == nil {
+				if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+					return
+				}
+			}
+		}
+
 		ticker := time.NewTicker(pingInterval)
 		defer ticker.Stop()
 
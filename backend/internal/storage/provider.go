@@ -18,6 +18,8 @@ type StorageProvider struct {
 	cache StorageBackend
 	// fingerprint of the last config used to create the backend
 	configHash string
+	// static is served as is, without reading any settings
+	static StorageBackend
 }
 
 // NewStorageProvider creates a provider that reads storage config from system_settings.
@@ -25,6 +27,12 @@ func NewStorageProvider(db *bun.DB) *StorageProvider {
 	return &StorageProvider{db: db}
 }
 
+// NewStaticProvider creates a provider that always returns backend, for
+// callers with a fixed backend such as tests.
+func NewStaticProvider(backend StorageBackend) *StorageProvider {
+	return &StorageProvider{static: backend}
+}
+
 // storageConfig holds all settings needed to create any backend.
 type storageConfig struct {
 	Type StorageType
@@ -168,6 +176,9 @@ func createBackend(cfg *storageConfig) (StorageBackend, error) {
 // GetClient returns a cached StorageBackend, creating or refreshing it if config changed.
 // Returns nil if storage is not configured.
 func (p *StorageProvider) GetClient(ctx context.Context) StorageBackend {
+	if p.static != nil {
+		return p.static
+	}
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -201,6 +212,9 @@ func (p *StorageProvider) GetClient(ctx context.Context) StorageBackend {
 
 // IsConfigured returns true if the active storage backend settings are complete.
 func (p *StorageProvider) IsConfigured(ctx context.Context) bool {
+	if p.static != nil {
+		return true
+	}
 	cfg, err := p.readConfig(ctx)
 	if err != nil {
 		return false
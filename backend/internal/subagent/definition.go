@@ -0,0 +1,115 @@
+package subagent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const maxSystemPromptBytes = 64 << 10
+
+var (
+	// Claude Code subagent names: lowercase letters, digits and hyphens.
+	namePattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+	toolPattern  = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\(.*\))?$`)
+	modelPattern = regexp.MustCompile(`^(sonnet|opus|haiku|inherit|claude-[a-z0-9.-]+)$`)
+)
+
+// builtins are the subagents Claude Code ships with; skills may name them
+// without installing anything.
+var builtins = map[string]bool{
+	"general-purpose": true,
+	"Explore":         true,
+	"Plan":            true,
+}
+
+// IsBuiltin reports whether name is a subagent built into Claude Code.
+func IsBuiltin(name string) bool {
+	return builtins[name]
+}
+
+// Validate normalizes a subagent definition and rejects invalid ones.
+func Validate(sa *models.Subagent) error {
+	sa.Name = strings.TrimSpace(sa.Name)
+	sa.Description = strings.TrimSpace(sa.Description)
+	sa.Model = strings.TrimSpace(sa.Model)
+	if !namePattern.MatchString(sa.Name) {
+		return errors.New("name must be 1-64 lowercase letters, digits or '-'")
+	}
+	if IsBuiltin(sa.Name) {
+		return errors.New("name is reserved for a built-in subagent")
+	}
+	if sa.Description == "" {
+		return errors.New("description is required: Claude Code uses it to decide when to delegate")
+	}
+	if strings.TrimSpace(sa.SystemPrompt) == "" {
+		return errors.New("system_prompt is required")
+	}
+	if len(sa.SystemPrompt) > maxSystemPromptBytes {
+		return errors.New("system_prompt must be at most 64 KiB")
+	}
+	if sa.Model != "" && !modelPattern.MatchString(sa.Model) {
+		return errors.New("model must be sonnet, opus, haiku, inherit or a claude-* model name")
+	}
+
+	tools := make([]string, 0, len(sa.Tools))
+	seen := make(map[string]bool, len(sa.Tools))
+	for _, t := range sa.Tools {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		if strings.Contains(t, ",") || !toolPattern.MatchString(t) {
+			return errors.New("invalid tool name " + t)
+		}
+		seen[t] = true
+		tools = append(tools, t)
+	}
+	sa.Tools = tools
+	return nil
+}
+
+// Render returns the .claude/agents/<name>.md file for a subagent.
+func Render(sa *models.Subagent) string {
+	fm := yaml.Node{Kind: yaml.MappingNode}
+	add := func(k, v string) {
+		fm.Content = append(fm.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: k},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: v})
+	}
+	add("name", sa.Name)
+	add("description", sa.Description)
+	if len(sa.Tools) > 0 {
+		add("tools", strings.Join(sa.Tools, ", "))
+	}
+	if sa.Model != "" {
+		add("model", sa.Model)
+	}
+	header, _ := yaml.Marshal(&fm)
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	b.Write(header)
+	b.WriteString("---\n\n")
+	b.WriteString(strings.TrimRight(sa.SystemPrompt, "\n"))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// Checksum identifies a set of rendered subagent files, so unchanged pods
+// are left alone.
+func Checksum(files map[string]string, names []string) string {
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(files[name]))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
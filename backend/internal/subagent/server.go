@@ -0,0 +1,318 @@
+package subagent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// Server implements SubagentServiceServer: subagent definitions and their
+// installation on agents.
+type Server struct {
+	sacv1.UnimplementedSubagentServiceServer
+	db     *bun.DB
+	syncer *Syncer
+}
+
+func NewServer(db *bun.DB, syncer *Syncer) *Server {
+	return &Server{db: db, syncer: syncer}
+}
+
+func (s *Server) isGroupMember(ctx context.Context, groupID, userID int64) bool {
+	exists, _ := s.db.NewSelect().
+		TableExpr("group_members").
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Exists(ctx)
+	return exists
+}
+
+func (s *Server) getVisible(ctx context.Context, id, userID int64) (*models.Subagent, error) {
+	var sa models.Subagent
+	q := s.db.NewSelect().Model(&sa).
+		Relation("Creator").
+		Where("sa.id = ?", id)
+	if err := visibleTo(q, userID).Scan(ctx); err != nil {
+		return nil, grpcerr.NotFound("Subagent not found", err)
+	}
+	return &sa, nil
+}
+
+// getEditable loads a subagent the caller may change: their own, or an
+// official one for admins.
+func (s *Server) getEditable(ctx context.Context, id int64) (*models.Subagent, error) {
+	userID := ctxkeys.UserID(ctx)
+	sa, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sa.IsOfficial && ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("Only admins can edit official subagents")
+	}
+	if !sa.IsOfficial && sa.CreatedBy != userID {
+		return nil, grpcerr.Forbidden("You don't have permission to edit this subagent")
+	}
+	return sa, nil
+}
+
+// checkGroup normalizes group_id and requires membership of the group.
+func (s *Server) checkGroup(ctx context.Context, sa *models.Subagent, userID int64) error {
+	if sa.GroupID != nil && *sa.GroupID <= 0 {
+		sa.GroupID = nil
+	}
+	if sa.GroupID != nil && !s.isGroupMember(ctx, *sa.GroupID, userID) {
+		return grpcerr.Forbidden("You are not a member of this group")
+	}
+	return nil
+}
+
+func (s *Server) ListSubagents(ctx context.Context, _ *sacv1.Empty) (*sacv1.SubagentListResponse, error) {
+	var subagents []models.Subagent
+	q := s.db.NewSelect().Model(&subagents).
+		Relation("Creator").
+		OrderExpr("sa.is_official DESC, sa.name ASC")
+	if err := visibleTo(q, ctxkeys.UserID(ctx)).Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to list subagents", err)
+	}
+	return &sacv1.SubagentListResponse{Subagents: convert.SubagentsToProto(subagents)}, nil
+}
+
+func (s *Server) GetSubagent(ctx context.Context, req *sacv1.SubagentByIdRequest) (*sacv1.Subagent, error) {
+	sa, err := s.getVisible(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return convert.SubagentToProto(sa), nil
+}
+
+func (s *Server) create(ctx context.Context, req *sacv1.CreateSubagentRequest, official bool) (*sacv1.Subagent, error) {
+	userID := ctxkeys.UserID(ctx)
+	sa := &models.Subagent{
+		Name:         req.Name,
+		Description:  req.Description,
+		Tools:        req.Tools,
+		Model:        req.Model,
+		SystemPrompt: req.SystemPrompt,
+		IsOfficial:   official,
+		IsPublic:     req.IsPublic,
+		GroupID:      req.GroupId,
+		CreatedBy:    userID,
+	}
+	if err := Validate(sa); err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+	if err := s.checkGroup(ctx, sa, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.NewInsert().Model(sa).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create subagent", err)
+	}
+	return convert.SubagentToProto(sa), nil
+}
+
+func (s *Server) CreateSubagent(ctx context.Context, req *sacv1.CreateSubagentRequest) (*sacv1.Subagent, error) {
+	return s.create(ctx, req, false)
+}
+
+// AdminCreateSubagent adds an official subagent, visible to every user.
+func (s *Server) AdminCreateSubagent(ctx context.Context, req *sacv1.CreateSubagentRequest) (*sacv1.Subagent, error) {
+	if ctxkeys.Role(ctx) != "admin" {
+		return nil, grpcerr.Forbidden("admin access required")
+	}
+	return s.create(ctx, req, true)
+}
+
+func (s *Server) UpdateSubagent(ctx context.Context, req *sacv1.UpdateSubagentRequest) (*sacv1.Subagent, error) {
+	sa, err := s.getEditable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	sa.Name = req.Name
+	sa.Description = req.Description
+	sa.Tools = req.Tools
+	sa.Model = req.Model
+	sa.SystemPrompt = req.SystemPrompt
+	sa.IsPublic = req.IsPublic
+	sa.GroupID = req.GroupId
+	sa.UpdatedAt = time.Now()
+	if err := Validate(sa); err != nil {
+		return nil, grpcerr.BadRequest(err.Error())
+	}
+	if err := s.checkGroup(ctx, sa, ctxkeys.UserID(ctx)); err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.NewUpdate().Model(sa).
+		Column("name", "description", "tools", "model", "system_prompt", "is_public", "group_id", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update subagent", err)
+	}
+
+	go s.syncAgents(s.installedAgents(context.Background(), sa.ID))
+	return convert.SubagentToProto(sa), nil
+}
+
+func (s *Server) DeleteSubagent(ctx context.Context, req *sacv1.SubagentByIdRequest) (*sacv1.SuccessMessage, error) {
+	sa, err := s.getEditable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the agents first: the installations go with the subagent.
+	agents := s.installedAgents(ctx, sa.ID)
+	if _, err := s.db.NewDelete().Model(sa).WherePK().Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to delete subagent", err)
+	}
+
+	go s.syncAgents(agents)
+	return &sacv1.SuccessMessage{Message: "Subagent deleted"}, nil
+}
+
+// installedAgents returns the agents a subagent is installed on.
+func (s *Server) installedAgents(ctx context.Context, subagentID int64) []models.Agent {
+	var agents []models.Agent
+	err := s.db.NewSelect().Model(&agents).
+		Column("ag.id", "ag.created_by").
+		Join("JOIN agent_subagents AS asa ON asa.agent_id = ag.id").
+		Where("asa.subagent_id = ?", subagentID).
+		Scan(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("subagent_id", subagentID).Msg("failed to list agents with subagent")
+	}
+	return agents
+}
+
+// syncAgents rewrites the subagents of running agents. Agents whose pod is
+// not running pick them up on session start.
+func (s *Server) syncAgents(agents []models.Agent) {
+	for _, a := range agents {
+		s.syncAgent(a.CreatedBy, a.ID)
+	}
+}
+
+func (s *Server) syncAgent(userID, agentID int64) {
+	if _, err := s.syncer.SyncToAgent(context.Background(), userID, agentID); err != nil {
+		log.Debug().Err(err).Int64("agent_id", agentID).Msg("subagent sync skipped")
+	}
+}
+
+// ownAgent checks that the caller owns the agent.
+func (s *Server) ownAgent(ctx context.Context, agentID, userID int64) error {
+	exists, err := s.db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", agentID, userID).
+		Exists(ctx)
+	if err != nil {
+		return grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return grpcerr.NotFound("Agent not found")
+	}
+	return nil
+}
+
+func (s *Server) ListAgentSubagents(ctx context.Context, req *sacv1.ListAgentSubagentsRequest) (*sacv1.AgentSubagentListResponse, error) {
+	if err := s.ownAgent(ctx, req.AgentId, ctxkeys.UserID(ctx)); err != nil {
+		return nil, err
+	}
+
+	var installs []models.AgentSubagent
+	err := s.db.NewSelect().Model(&installs).
+		Relation("Subagent").
+		Where("asa.agent_id = ?", req.AgentId).
+		OrderExpr("asa.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list agent subagents", err)
+	}
+
+	out := make([]*sacv1.AgentSubagent, len(installs))
+	for i := range installs {
+		out[i] = convert.AgentSubagentToProto(&installs[i])
+	}
+	return &sacv1.AgentSubagentListResponse{Subagents: out}, nil
+}
+
+func (s *Server) InstallSubagent(ctx context.Context, req *sacv1.AgentSubagentRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+	if err := s.ownAgent(ctx, req.AgentId, userID); err != nil {
+		return nil, err
+	}
+	sa, err := s.getVisible(ctx, req.SubagentId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Names are file names in the pod, so they must be unique per agent.
+	clash, err := s.db.NewSelect().Model((*models.Subagent)(nil)).
+		Join("JOIN agent_subagents AS asa ON asa.subagent_id = sa.id").
+		Where("asa.agent_id = ?", req.AgentId).
+		Where("sa.name = ?", sa.Name).
+		Where("sa.id != ?", sa.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to check installed subagents", err)
+	}
+	if clash {
+		return nil, grpcerr.Conflict(fmt.Sprintf("A subagent named %q is already installed on this agent", sa.Name))
+	}
+
+	_, err = s.db.NewInsert().
+		Model(&models.AgentSubagent{AgentID: req.AgentId, SubagentID: sa.ID}).
+		On("CONFLICT (agent_id, subagent_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to install subagent", err)
+	}
+
+	go s.syncAgent(userID, req.AgentId)
+	return &sacv1.SuccessMessage{Message: "Subagent installed successfully"}, nil
+}
+
+// UninstallSubagent removes a subagent from an agent unless an installed
+// skill still runs in it.
+func (s *Server) UninstallSubagent(ctx context.Context, req *sacv1.AgentSubagentRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+	if err := s.ownAgent(ctx, req.AgentId, userID); err != nil {
+		return nil, err
+	}
+
+	var sa models.Subagent
+	if err := s.db.NewSelect().Model(&sa).Where("id = ?", req.SubagentId).Scan(ctx); err == nil {
+		var users []string
+		err := s.db.NewSelect().
+			Model((*models.Skill)(nil)).
+			Column("sk.command_name").
+			Join("JOIN agent_skills AS ags ON ags.skill_id = sk.id").
+			Where("ags.agent_id = ?", req.AgentId).
+			Where("sk.frontmatter->>'agent' = ?", sa.Name).
+			Scan(ctx, &users)
+		if err != nil {
+			return nil, grpcerr.Internal("Failed to check installed skills", err)
+		}
+		if len(users) > 0 {
+			return nil, grpcerr.BadRequest(fmt.Sprintf("Subagent %q is used by installed skills: /%s", sa.Name, strings.Join(users, ", /")))
+		}
+	}
+
+	_, err := s.db.NewDelete().Model((*models.AgentSubagent)(nil)).
+		Where("agent_id = ? AND subagent_id = ?", req.AgentId, req.SubagentId).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to uninstall subagent", err)
+	}
+
+	go s.syncAgent(userID, req.AgentId)
+	return &sacv1.SuccessMessage{Message: "Subagent uninstalled successfully"}, nil
+}
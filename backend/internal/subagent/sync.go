@@ -0,0 +1,153 @@
+package subagent
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	agentsDir    = "/root/.claude/agents"
+	checksumFile = agentsDir + "/.checksum"
+)
+
+// visibleTo restricts a subagents query to those userID may use.
+func visibleTo(q *bun.SelectQuery, userID int64) *bun.SelectQuery {
+	return q.Where(`(sa.created_by = ? OR sa.is_official OR sa.is_public
+		OR sa.group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))`, userID, userID)
+}
+
+// Installed returns the subagents installed on an agent that its owner can
+// still use, by installation order.
+func Installed(ctx context.Context, db bun.IDB, userID, agentID int64) ([]models.Subagent, error) {
+	var subagents []models.Subagent
+	q := db.NewSelect().Model(&subagents).
+		Join("JOIN agent_subagents AS asa ON asa.subagent_id = sa.id").
+		Where("asa.agent_id = ?", agentID).
+		OrderExpr("asa.id ASC")
+	err := visibleTo(q, userID).Scan(ctx)
+	return subagents, err
+}
+
+// Syncer writes the subagents installed on an agent into its pod.
+type Syncer struct {
+	db               *bun.DB
+	containerManager *container.Manager
+}
+
+// NewSyncer creates a new Syncer.
+func NewSyncer(db *bun.DB, containerManager *container.Manager) *Syncer {
+	return &Syncer{db: db, containerManager: containerManager}
+}
+
+// SyncToAgent replaces the pod's .claude/agents with the agent's installed
+// subagents. Installations the owner lost access to are dropped. Claude
+// Code loads subagents at startup, so when the directory changed the
+// Claude Code process is restarted. Returns whether anything changed.
+func (s *Syncer) SyncToAgent(ctx context.Context, userID int64, agentID int64) (bool, error) {
+	_, err := s.db.NewDelete().Model((*models.AgentSubagent)(nil)).
+		Where("agent_id = ?", agentID).
+		Where(`subagent_id NOT IN (SELECT id FROM subagents WHERE created_by = ? OR is_official OR is_public
+			OR group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))`, userID, userID).
+		Exec(ctx)
+	if err != nil {
+		log.Warn().Err(err).Int64("agent_id", agentID).Msg("failed to revoke inaccessible subagents")
+	}
+
+	subagents, err := Installed(ctx, s.db, userID, agentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load subagents of agent %d: %w", agentID, err)
+	}
+
+	files := make(map[string]string, len(subagents))
+	for i := range subagents {
+		name := subagents[i].Name + ".md"
+		if _, dup := files[name]; dup {
+			continue
+		}
+		files[name] = Render(&subagents[i])
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pod := fmt.Sprintf("claude-code-%d-%d-0", userID, agentID)
+	stdout, _, err := s.containerManager.ExecInPod(ctx, pod, []string{"sh", "-c", "cat " + checksumFile + " 2>/dev/null || true"}, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to read subagents checksum in pod %s: %w", pod, err)
+	}
+	current := strings.TrimSpace(stdout)
+
+	if len(files) == 0 {
+		if current == "" {
+			return false, nil
+		}
+		if err := s.containerManager.RemovePathInPod(ctx, pod, agentsDir); err != nil {
+			return false, err
+		}
+	} else {
+		checksum := Checksum(files, names)
+		if current == checksum {
+			return false, nil
+		}
+		files[".checksum"] = checksum
+		names = append(names, ".checksum")
+
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, name := range names {
+			data := []byte(files[name])
+			if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data))}); err != nil {
+				return false, err
+			}
+			if _, err := tw.Write(data); err != nil {
+				return false, err
+			}
+		}
+		if err := tw.Close(); err != nil {
+			return false, err
+		}
+
+		// Extract next to the directory and swap it in.
+		tmpDir := agentsDir + ".partial"
+		cmd := []string{"bash", "-c", fmt.Sprintf("rm -rf %[2]s && mkdir -p %[2]s && tar xf - -C %[2]s && rm -rf %[1]s && mv %[2]s %[1]s", agentsDir, tmpDir)}
+		if _, stderr, err := s.containerManager.ExecInPod(ctx, pod, cmd, &buf); err != nil {
+			return false, fmt.Errorf("failed to write subagents in pod %s: %w (stderr: %s)", pod, err, stderr)
+		}
+	}
+
+	if err := s.containerManager.RestartClaudeCodeProcess(ctx, pod); err != nil {
+		log.Warn().Err(err).Str("pod", pod).Msg("failed to restart Claude Code after subagent sync")
+	}
+	log.Info().Int("subagents", len(subagents)).Str("pod", pod).Msg("synced subagents")
+	return true, nil
+}
+
+// MissingForSkill returns the subagent a skill runs in when it is neither
+// built in nor installed on the agent, or "" when nothing is missing.
+func MissingForSkill(ctx context.Context, db bun.IDB, userID, agentID int64, sk *models.Skill) (string, error) {
+	name := sk.Frontmatter.Agent
+	if name == "" || IsBuiltin(name) {
+		return "", nil
+	}
+	subagents, err := Installed(ctx, db, userID, agentID)
+	if err != nil {
+		return "", err
+	}
+	for _, sa := range subagents {
+		if sa.Name == name {
+			return "", nil
+		}
+	}
+	return name, nil
+}
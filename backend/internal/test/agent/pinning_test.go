@@ -0,0 +1,147 @@
+package agent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/agent"
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const (
+	ownerID = 7
+	agentID = 3
+	skillID = 5
+)
+
+// newAgentServer returns an agent server whose background skill syncs run
+// against a fake cluster; the tests only check what the RPCs store.
+func newAgentServer(t *testing.T) (*agent.Server, sqlmock.Sqlmock) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	kube := testutil.NewFakeKube(t)
+	return agent.NewServer(db, kube.Manager, skill.NewSyncService(db, kube.Manager, nil), nil, nil), mock
+}
+
+func ctxAs(userID int64) context.Context {
+	return testutil.WithUser(context.Background(), userID, "user")
+}
+
+// expectInstalled loads skill 5, at version 3 and created by createdBy, as
+// installed on agent 3 with the given pin.
+func expectInstalled(mock sqlmock.Sqlmock, createdBy int64, published, pinned any) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents" .*id = 3 AND created_by = 7`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM "agent_skills" AS "as" LEFT JOIN "skills" AS "skill" .*"as".agent_id = 3 AND "as".skill_id = 5`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "agent_id", "skill_id", "pinned_version", "skill__id", "skill__name", "skill__command_name", "skill__created_by", "skill__version", "skill__published_version"}).
+			AddRow(11, agentID, skillID, pinned, skillID, "Review", "review", createdBy, 3, published))
+}
+
+func expectPinStored(mock sqlmock.Sqlmock, pinned string) {
+	mock.ExpectExec(`UPDATE "agent_skills" AS "as" SET "pinned_version" = ` + pinned + ` WHERE \("as"."id" = 11\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func pin(v int32) *int32 { return &v }
+
+func TestSetAgentSkillVersion_PinsOlderVersion(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	expectInstalled(mock, ownerID, nil, nil)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "skill_versions" .*skill_id = 5 AND version = 2.*bundle_key <> ''`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	expectPinStored(mock, "2")
+
+	got, err := srv.SetAgentSkillVersion(ctxAs(ownerID), &sacv1.SetAgentSkillVersionRequest{AgentId: agentID, SkillId: skillID, PinnedVersion: pin(2)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, got.GetPinnedVersion())
+	assert.True(t, got.UpdateAvailable)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetAgentSkillVersion_FollowLatest(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	expectInstalled(mock, ownerID, nil, 2)
+	expectPinStored(mock, "NULL")
+
+	got, err := srv.SetAgentSkillVersion(ctxAs(ownerID), &sacv1.SetAgentSkillVersionRequest{AgentId: agentID, SkillId: skillID})
+	require.NoError(t, err)
+	assert.Nil(t, got.PinnedVersion)
+	assert.False(t, got.UpdateAvailable)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetAgentSkillVersion_Rejects(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdBy int64
+		published any
+		version   int32
+		bundle    bool
+		message   string
+	}{
+		{"zero", ownerID, nil, 0, true, "version must be between 1 and 3"},
+		{"newer than latest", ownerID, nil, 4, true, "version must be between 1 and 3"},
+		{"draft of another user", 9, 2, 3, true, "version must be between 1 and 2"},
+		{"no bundle", ownerID, nil, 1, false, "version 1 of this skill cannot be pinned"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, mock := newAgentServer(t)
+			expectInstalled(mock, tt.createdBy, tt.published, nil)
+			mock.ExpectQuery(`FROM "skill_versions"`).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.bundle))
+
+			_, err := srv.SetAgentSkillVersion(ctxAs(ownerID), &sacv1.SetAgentSkillVersionRequest{AgentId: agentID, SkillId: skillID, PinnedVersion: pin(tt.version)})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.message)
+		})
+	}
+}
+
+func TestSetAgentSkillVersion_NotInstalled(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	mock.ExpectQuery(`FROM "agents"`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM "agent_skills"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := srv.SetAgentSkillVersion(ctxAs(ownerID), &sacv1.SetAgentSkillVersionRequest{AgentId: agentID, SkillId: skillID, PinnedVersion: pin(2)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetAgentSkillVersion_OtherUsersAgent(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	mock.ExpectQuery(`FROM "agents" .*created_by = 8`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := srv.SetAgentSkillVersion(ctxAs(8), &sacv1.SetAgentSkillVersionRequest{AgentId: agentID, SkillId: skillID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpgradeAgentSkill_RepinsToLatest(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	expectInstalled(mock, ownerID, nil, 1)
+	expectPinStored(mock, "3")
+
+	got, err := srv.UpgradeAgentSkill(ctxAs(ownerID), &sacv1.AgentSkillRequest{AgentId: agentID, SkillId: skillID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, got.GetPinnedVersion())
+	assert.False(t, got.UpdateAvailable)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpgradeAgentSkill_FollowingLatestOnlyResyncs(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	expectInstalled(mock, ownerID, nil, nil)
+
+	got, err := srv.UpgradeAgentSkill(ctxAs(ownerID), &sacv1.AgentSkillRequest{AgentId: agentID, SkillId: skillID})
+	require.NoError(t, err)
+	assert.Nil(t, got.PinnedVersion)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,51 @@
+package agent_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+)
+
+func TestGetAgentSyncStatus_CountsFailedAndPending(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	retry := time.Now().Add(4 * time.Minute).Truncate(time.Second)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents" .*id = 3 AND created_by = 7`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM "agent_skills" AS "as" LEFT JOIN "skills" AS "skill" .*WHERE \("as".agent_id = 3\) ORDER BY "as"."order" ASC, "as"."id" ASC`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "agent_id", "skill_id", "synced_version", "sync_error", "sync_attempts", "next_sync_retry_at", "skill__id", "skill__name", "skill__created_by", "skill__version"}).
+			AddRow(11, agentID, skillID, 2, "bundle not found", 3, retry, skillID, "Review", ownerID, 3).
+			AddRow(12, agentID, 6, 0, "", 0, nil, 6, "Lint", ownerID, 1).
+			AddRow(13, agentID, 7, 4, "", 0, nil, 7, "Deploy", ownerID, 4))
+
+	resp, err := srv.GetAgentSyncStatus(ctxAs(ownerID), &sacv1.GetAgentRequest{Id: agentID})
+
+	require.NoError(t, err)
+	assert.EqualValues(t, agentID, resp.AgentId)
+	assert.EqualValues(t, 1, resp.Failed)
+	assert.EqualValues(t, 1, resp.Pending)
+	require.Len(t, resp.Skills, 3)
+	failed := resp.Skills[0]
+	assert.Equal(t, "bundle not found", failed.SyncError)
+	assert.EqualValues(t, 3, failed.SyncAttempts)
+	assert.True(t, retry.Equal(failed.NextSyncRetryAt.AsTime()))
+	assert.Nil(t, resp.Skills[2].NextSyncRetryAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAgentSyncStatus_OtherUsersAgent(t *testing.T) {
+	srv, mock := newAgentServer(t)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents" .*id = 3 AND created_by = 8`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := srv.GetAgentSyncStatus(ctxAs(8), &sacv1.GetAgentRequest{Id: agentID})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,95 @@
+package claudesettings_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/claudesettings"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	c := &models.ClaudeSettings{
+		Allow: []string{" Bash(npm run test:*) ", "Read", "Read", ""},
+		Model: " sonnet ",
+	}
+	require.NoError(t, claudesettings.Validate(c))
+	assert.Equal(t, []string{"Bash(npm run test:*)", "Read"}, c.Allow)
+	assert.Equal(t, "sonnet", c.Model)
+
+	bad := []models.ClaudeSettings{
+		{Deny: []string{"Bash(rm"}},
+		{DefaultMode: "yolo"},
+		{DefaultMode: "bypassPermissions", DisableBypassPermissions: true},
+		{Model: "gpt-4"},
+		{Env: map[string]string{"BAD-KEY": "x"}},
+		{Hooks: []models.ClaudeHook{{Event: "OnSave", Command: "true"}}},
+		{Hooks: []models.ClaudeHook{{Event: "Stop"}}},
+		{Hooks: []models.ClaudeHook{{Event: "Stop", Command: "true", Timeout: 601}}},
+	}
+	for i := range bad {
+		assert.Error(t, claudesettings.Validate(&bad[i]), i)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	merged, notes := claudesettings.Merge([]claudesettings.Layer{
+		{Source: claudesettings.SourcePlatform, Settings: models.ClaudeSettings{
+			Deny:                     []string{"WebFetch"},
+			DisableBypassPermissions: true,
+			Model:                    "sonnet",
+			Env:                      map[string]string{"A": "1", "B": "1"},
+			Hooks:                    []models.ClaudeHook{{Event: "Stop", Command: "audit"}},
+		}},
+		{Source: claudesettings.SourceGroup, ID: 3, Name: "eng", Settings: models.ClaudeSettings{
+			Allow: []string{"Bash(make:*)"},
+			Deny:  []string{"Bash(curl:*)"},
+		}},
+		{Source: claudesettings.SourceAgent, ID: 7, Name: "bot", Settings: models.ClaudeSettings{
+			Allow:       []string{"WebFetch", "Bash(make:*)"},
+			DefaultMode: "bypassPermissions",
+			Model:       "opus",
+			Env:         map[string]string{"B": "2"},
+			Hooks:       []models.ClaudeHook{{Event: "Stop", Command: "notify"}},
+		}},
+	})
+
+	assert.Equal(t, []string{"Bash(make:*)"}, merged.Allow)
+	assert.Equal(t, []string{"WebFetch", "Bash(curl:*)"}, merged.Deny)
+	assert.True(t, merged.DisableBypassPermissions)
+	assert.Empty(t, merged.DefaultMode)
+	assert.Equal(t, "opus", merged.Model)
+	assert.Equal(t, map[string]string{"A": "1", "B": "2"}, merged.Env)
+	assert.Len(t, merged.Hooks, 2)
+	assert.Len(t, notes, 2)
+}
+
+func TestRender(t *testing.T) {
+	out, err := claudesettings.Render(&models.ClaudeSettings{})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	out, err = claudesettings.Render(&models.ClaudeSettings{
+		Deny:                     []string{"WebFetch"},
+		DisableBypassPermissions: true,
+		Hooks: []models.ClaudeHook{
+			{Event: "PreToolUse", Matcher: "Bash", Command: "a"},
+			{Event: "PreToolUse", Matcher: "Bash", Command: "b", Timeout: 30},
+		},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		Permissions map[string]any `json:"permissions"`
+		Hooks       map[string][]struct {
+			Matcher string           `json:"matcher"`
+			Hooks   []map[string]any `json:"hooks"`
+		} `json:"hooks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &doc))
+	assert.Equal(t, "disable", doc.Permissions["disableBypassPermissionsMode"])
+	require.Len(t, doc.Hooks["PreToolUse"], 1)
+	assert.Len(t, doc.Hooks["PreToolUse"][0].Hooks, 2)
+}
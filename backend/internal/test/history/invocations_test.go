@@ -0,0 +1,49 @@
+package history_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/history"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+func TestReceiveEvents_CountsSkillInvocations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.ExpectQuery(`FROM users WHERE \(id = 7\)`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM agents WHERE \(id = 3\)`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`INSERT INTO "conversation_histories"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3).AddRow(4).AddRow(5))
+	// Two runs of /review and one of /deploy; the assistant message is not
+	// counted and /clear, a built-in, matches no skill.
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET invocation_count = invocation_count \+ 2 WHERE \(command_name = 'review'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET invocation_count = invocation_count \+ 1 WHERE \(command_name = 'deploy'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET invocation_count = invocation_count \+ 1 WHERE \(command_name = 'clear'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	r := gin.New()
+	history.NewHandler(db).RegisterInternalRoutes(r.Group("/internal"))
+	body := `{"user_id":"7","agent_id":"3","session_id":"s1","messages":[
+		{"role":"user","content":"<command-name>/review</command-name>"},
+		{"role":"assistant","content":"<command-name>/review</command-name>"},
+		{"role":"user","content":"<command-message>review</command-message><command-name>/review</command-name>"},
+		{"role":"user","content":"<command-name>/deploy</command-name><command-args>prod</command-args>"},
+		{"role":"user","content":"<command-name>/clear</command-name>"}]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/internal/conversations/events", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"inserted":5`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
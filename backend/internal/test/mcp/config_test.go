@@ -0,0 +1,55 @@
+package mcp_test
+
+import (
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/mcp"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	stdio := &models.MCPServer{Name: " github ", Transport: "stdio", Command: "npx", Env: map[string]string{"GITHUB_TOKEN": "${secret:GH_TOKEN}"}}
+	require.NoError(t, mcp.Validate(stdio))
+	assert.Equal(t, "github", stdio.Name)
+	assert.Equal(t, []string{}, stdio.Args)
+
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "x", Transport: "stdio"}))
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "x", Transport: "http", URL: "ftp://host"}))
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "x", Transport: "sse", URL: "https://host", Env: map[string]string{"A": "b"}}))
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "bad name", Transport: "stdio", Command: "x"}))
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "x", Transport: "ws", URL: "https://host"}))
+	assert.Error(t, mcp.Validate(&models.MCPServer{Name: "x", Transport: "stdio", Command: "x", Env: map[string]string{"A": "${secret:1bad}"}}))
+}
+
+func TestRenderConfig(t *testing.T) {
+	servers := []models.MCPServer{
+		{Name: "github", Transport: "stdio", Command: "npx", Args: []string{"-y", "server-github"}, Env: map[string]string{"GITHUB_TOKEN": "token ${secret:GH_TOKEN}"}},
+		{Name: "docs", Transport: "http", URL: "https://docs.example.com/mcp", Headers: map[string]string{"Authorization": "Bearer ${secret:DOCS}"}},
+		{Name: "github", Transport: "sse", URL: "https://other.example.com"},
+	}
+
+	config, skipped := mcp.RenderConfig(servers, map[string]string{"GH_TOKEN": "abc"})
+	require.Contains(t, config, "github")
+	assert.NotContains(t, config, "docs")
+	assert.Equal(t, "missing secrets: DOCS", skipped["docs"])
+	assert.Equal(t, "duplicate name", skipped["github"])
+
+	entry := config["github"].(map[string]any)
+	assert.Equal(t, "stdio", entry["type"])
+	assert.Equal(t, map[string]string{"GITHUB_TOKEN": "token abc"}, entry["env"])
+
+	config, skipped = mcp.RenderConfig(servers[1:2], map[string]string{"DOCS": "k"})
+	assert.Empty(t, skipped)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer k"}, config["docs"].(map[string]any)["headers"])
+}
+
+func TestSecretRefs(t *testing.T) {
+	srv := &models.MCPServer{
+		Env:     map[string]string{"A": "${secret:B} ${secret:A}", "C": "plain"},
+		Headers: map[string]string{"X": "${secret:A}"},
+	}
+	assert.Equal(t, []string{"A", "B"}, mcp.SecretRefs(srv))
+	assert.Equal(t, []string{"B"}, mcp.MissingSecrets(srv, map[string]string{"A": ""}))
+}
@@ -0,0 +1,289 @@
+package notify_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/notify"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const userID = 5
+
+func channelRow(typ, target, secret string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "name", "type", "target", "secret", "events", "enabled"}).
+		AddRow(1, userID, "ops", typ, target, secret, "{}", true)
+}
+
+// newServer returns a notification server whose notifier may reach the
+// loopback test receivers.
+func newServer(t *testing.T, allowPrivate bool) (*notify.Server, sqlmock.Sqlmock) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	notifier := notify.NewNotifier(db)
+	notifier.SetAllowPrivateTargets(allowPrivate)
+	return notify.NewServer(db, notifier), mock
+}
+
+func sendTest(srv *notify.Server) error {
+	ctx := testutil.WithUser(context.Background(), userID, "user")
+	_, err := srv.TestNotificationChannel(ctx, &sacv1.NotificationChannelByIdRequest{Id: 1})
+	return err
+}
+
+type captured struct {
+	header http.Header
+	body   []byte
+}
+
+func receiver(t *testing.T, code int) (*httptest.Server, <-chan captured) {
+	ch := make(chan captured, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ch <- captured{header: r.Header.Clone(), body: body}
+		w.WriteHeader(code)
+		w.Write([]byte("nope"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, ch
+}
+
+func TestWebhookChannel_SignedPayload(t *testing.T) {
+	srv, mock := newServer(t, true)
+	rcv, got := receiver(t, http.StatusNoContent)
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("webhook", rcv.URL, "k3y"))
+	mock.ExpectExec(`UPDATE "notification_channels" .*"last_error" = ''`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, sendTest(srv))
+
+	req := <-got
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+	assert.Equal(t, "test", req.header.Get("X-SAC-Event"))
+	mac := hmac.New(sha256.New, []byte("k3y"))
+	mac.Write(req.body)
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), req.header.Get("X-Signature"))
+
+	var ev notify.Event
+	require.NoError(t, json.Unmarshal(req.body, &ev))
+	assert.Equal(t, "test", ev.Type)
+	assert.Equal(t, int64(userID), ev.UserID)
+	assert.Equal(t, "Test notification", ev.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlackChannel_TextPayload(t *testing.T) {
+	srv, mock := newServer(t, true)
+	rcv, got := receiver(t, http.StatusOK)
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("slack", rcv.URL, ""))
+	mock.ExpectExec(`UPDATE "notification_channels"`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, sendTest(srv))
+
+	req := <-got
+	var payload map[string]string
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	assert.Equal(t, "*Test notification*\nThis is a test notification for channel \"ops\".", payload["text"])
+	assert.Empty(t, req.header.Get("X-Signature"))
+}
+
+func TestWebhookChannel_ErrorStatusIsRecorded(t *testing.T) {
+	srv, mock := newServer(t, true)
+	rcv, _ := receiver(t, http.StatusBadGateway)
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("webhook", rcv.URL, ""))
+	mock.ExpectExec(`UPDATE "notification_channels" .*"last_error" = 'HTTP 502: nope'`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := sendTest(srv)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookChannel_PrivateAddressBlockedAtSend(t *testing.T) {
+	srv, mock := newServer(t, false)
+	rcv, got := receiver(t, http.StatusOK)
+	// A channel stored before the check existed, or a public name that
+	// resolves to loopback, is still refused when dialing.
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("webhook", rcv.URL, ""))
+	mock.ExpectExec(`UPDATE "notification_channels" .*"last_error" = '.*public address.*'`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := sendTest(srv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public address")
+	assert.Empty(t, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateChannel_RejectsInternalTargets(t *testing.T) {
+	targets := []string{
+		"http://169.254.169.254/latest/meta-data",
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://10.0.0.8/hook",
+		"http://[::1]/hook",
+		"http://[::ffff:192.168.1.1]/hook",
+		"http://100.64.1.1/hook",
+		"http://postgres:5432/",
+		"https://api-gateway.sac.svc.cluster.local/api",
+		"http://metadata.google.internal/",
+	}
+	for _, target := range targets {
+		t.Run(target, func(t *testing.T) {
+			srv, mock := newServer(t, false)
+			mock.ExpectQuery(`SELECT count\(\*\) FROM "notification_channels"`).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+			ctx := testutil.WithUser(context.Background(), userID, "user")
+			_, err := srv.CreateNotificationChannel(ctx, &sacv1.CreateNotificationChannelRequest{Name: "x", Type: "webhook", Target: target})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCreateChannel_AcceptsPublicTarget(t *testing.T) {
+	srv, mock := newServer(t, false)
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "notification_channels"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`INSERT INTO "notification_channels"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	ctx := testutil.WithUser(context.Background(), userID, "user")
+	ch, err := srv.CreateNotificationChannel(ctx, &sacv1.CreateNotificationChannelRequest{Name: "x", Type: "slack", Target: "https://hooks.slack.com/services/T/B/X"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.slack.com/services/T/B/X", ch.Target)
+}
+
+// smtpStandIn is a plain SMTP server without STARTTLS or AUTH, like MailHog.
+type smtpStandIn struct {
+	addr     string
+	mu       sync.Mutex
+	commands []string
+	data     string
+	done     chan struct{}
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	s := &smtpStandIn{addr: ln.Addr().String(), done: make(chan struct{})}
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		defer close(s.done)
+		r := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+		reply("220 stand-in ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			s.mu.Lock()
+			s.commands = append(s.commands, line)
+			s.mu.Unlock()
+
+			switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); cmd {
+			case "EHLO":
+				reply("250-stand-in")
+				reply("250 8BITMIME")
+			case "DATA":
+				reply("354 go ahead")
+				var msg strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					msg.WriteString(l)
+				}
+				s.mu.Lock()
+				s.data = msg.String()
+				s.mu.Unlock()
+				reply("250 queued")
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return s
+}
+
+func smtpSettings(addr string) *sqlmock.Rows {
+	host, port, _ := net.SplitHostPort(addr)
+	return sqlmock.NewRows([]string{"key", "value"}).
+		AddRow("smtp_host", []byte(`"`+host+`"`)).
+		AddRow("smtp_port", []byte(`"`+port+`"`)).
+		AddRow("smtp_from", []byte(`"sac@example.com"`))
+}
+
+func TestEmailChannel_DeliversToLocalSMTP(t *testing.T) {
+	smtp := newSMTPStandIn(t)
+	// SMTP is configured by the admin, so the private-target guard does
+	// not apply to it.
+	srv, mock := newServer(t, false)
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("email", "dev@example.com", ""))
+	mock.ExpectQuery(`FROM "system_settings"`).WillReturnRows(smtpSettings(smtp.addr))
+	mock.ExpectExec(`UPDATE "notification_channels" .*"last_error" = ''`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, sendTest(srv))
+	<-smtp.done
+
+	smtp.mu.Lock()
+	defer smtp.mu.Unlock()
+	assert.Contains(t, smtp.commands, "MAIL FROM:<sac@example.com> BODY=8BITMIME")
+	assert.Contains(t, smtp.commands, "RCPT TO:<dev@example.com>")
+	for _, c := range smtp.commands {
+		assert.NotContains(t, c, "STARTTLS")
+		assert.NotContains(t, c, "AUTH")
+	}
+
+	headers, body, ok := strings.Cut(smtp.data, "\r\n\r\n")
+	require.True(t, ok)
+	assert.Contains(t, headers, "From: sac@example.com\r\n")
+	assert.Contains(t, headers, "To: dev@example.com\r\n")
+	assert.Contains(t, headers, "Subject: [SAC] Test notification\r\n")
+	assert.Contains(t, headers, "Content-Type: text/plain; charset=UTF-8")
+	assert.Contains(t, body, "This is a test notification")
+	assert.Contains(t, body, "Event: test")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailChannel_NotConfigured(t *testing.T) {
+	srv, mock := newServer(t, false)
+	mock.ExpectQuery(`FROM "notification_channels"`).WillReturnRows(channelRow("email", "dev@example.com", ""))
+	mock.ExpectQuery(`FROM "system_settings"`).WillReturnRows(sqlmock.NewRows([]string{"key", "value"}))
+	mock.ExpectExec(`UPDATE "notification_channels" .*not configured`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := sendTest(srv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not configured")
+}
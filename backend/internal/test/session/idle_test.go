@@ -0,0 +1,152 @@
+package session_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStatefulSet = "claude-code-7-3"
+
+func idleSessionRows(lastActive time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "agent_id", "session_id", "status", "last_active"}).
+		AddRow(21, testUserID, testAgentID, "sess-1", "running", lastActive)
+}
+
+// expectIdleConfig answers GetIdleConfig with a per-user timeout and the
+// given system idle action.
+func expectIdleConfig(mock sqlmock.Sqlmock, timeoutMinutes, action string) {
+	mock.ExpectQuery(`FROM "user_settings" .*session_idle_timeout_minutes`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "key", "value"}).AddRow(testUserID, "session_idle_timeout_minutes", []byte(timeoutMinutes)))
+	mock.ExpectQuery(`FROM "system_settings" .*session_idle_warning_minutes`).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "value"}))
+	mock.ExpectQuery(`FROM "system_settings" .*session_idle_action`).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "value"}).AddRow("session_idle_action", []byte(`"`+action+`"`)))
+}
+
+func expectNoBusyTask(mock sqlmock.Sqlmock, busy bool) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agent_tasks" .*status IN \('pending', 'running'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(busy))
+}
+
+func expectMarkIdle(mock sqlmock.Sqlmock, claimed int64) {
+	mock.ExpectExec(`UPDATE "sessions" .* SET status = 'idle', .*WHERE \(id = 21\) AND \(status = 'running'\) AND \(last_active = `).
+		WillReturnResult(sqlmock.NewResult(0, claimed))
+}
+
+func TestReapIdleSessions_ScaleDown(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetStatefulSet(testStatefulSet, 1)
+
+	mock.ExpectQuery(`FROM "sessions" .*status = 'running'.*last_active < `).
+		WillReturnRows(idleSessionRows(time.Now().Add(-45 * time.Minute)))
+	expectIdleConfig(mock, "30", "scale_down")
+	expectNoBusyTask(mock, false)
+	expectMarkIdle(mock, 1)
+
+	assert.Equal(t, 1, srv.ReapIdleSessions(context.Background()))
+	assert.Equal(t, int32(0), kube.Replicas(testStatefulSet))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReapIdleSessions_StopProcess(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetPod(testPod, "10.0.0.3")
+	var (
+		mu   sync.Mutex
+		cmds []string
+	)
+	kube.HandleExec(func(pod string, cmd []string, _ io.Reader, _, _ io.Writer) error {
+		mu.Lock()
+		defer mu.Unlock()
+		cmds = append(cmds, pod+": "+strings.Join(cmd, " "))
+		return nil
+	})
+
+	mock.ExpectQuery(`FROM "sessions"`).WillReturnRows(idleSessionRows(time.Now().Add(-45 * time.Minute)))
+	expectIdleConfig(mock, "30", "stop_process")
+	expectNoBusyTask(mock, false)
+	expectMarkIdle(mock, 1)
+
+	assert.Equal(t, 1, srv.ReapIdleSessions(context.Background()))
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, cmds, 1)
+	assert.Contains(t, cmds[0], testPod+": sh -c touch /tmp/claude-idle")
+	assert.Contains(t, cmds[0], "pkill -x claude")
+}
+
+func TestReapIdleSessions_WithinTimeoutIsKept(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetStatefulSet(testStatefulSet, 1)
+
+	mock.ExpectQuery(`FROM "sessions"`).WillReturnRows(idleSessionRows(time.Now().Add(-10 * time.Minute)))
+	expectIdleConfig(mock, "30", "scale_down")
+
+	assert.Equal(t, 0, srv.ReapIdleSessions(context.Background()))
+	assert.Equal(t, int32(1), kube.Replicas(testStatefulSet))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReapIdleSessions_RunningTaskKeepsPod(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetStatefulSet(testStatefulSet, 1)
+
+	mock.ExpectQuery(`FROM "sessions"`).WillReturnRows(idleSessionRows(time.Now().Add(-45 * time.Minute)))
+	expectIdleConfig(mock, "30", "scale_down")
+	expectNoBusyTask(mock, true)
+
+	assert.Equal(t, 0, srv.ReapIdleSessions(context.Background()))
+	assert.Equal(t, int32(1), kube.Replicas(testStatefulSet))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReapIdleSessions_ActivityWinsRace(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetStatefulSet(testStatefulSet, 1)
+
+	// Input arrived (or another replica claimed the session) between the
+	// listing and the conditional update.
+	mock.ExpectQuery(`FROM "sessions"`).WillReturnRows(idleSessionRows(time.Now().Add(-45 * time.Minute)))
+	expectIdleConfig(mock, "30", "scale_down")
+	expectNoBusyTask(mock, false)
+	expectMarkIdle(mock, 0)
+
+	assert.Equal(t, 0, srv.ReapIdleSessions(context.Background()))
+	assert.Equal(t, int32(1), kube.Replicas(testStatefulSet))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReapIdleSessions_ScaleFailureKeepsGoing(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetStatefulSet("claude-code-8-4", 1)
+
+	// The first session's StatefulSet is gone; the second is still stopped.
+	mock.ExpectQuery(`FROM "sessions"`).WillReturnRows(
+		idleSessionRows(time.Now().Add(-45*time.Minute)).
+			AddRow(22, 8, 4, "sess-2", "running", time.Now().Add(-45*time.Minute)))
+	expectIdleConfig(mock, "30", "scale_down")
+	mock.ExpectQuery(`FROM "user_settings" .*user_id = 8`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "key", "value"}).AddRow(8, "session_idle_timeout_minutes", []byte("30")))
+	mock.ExpectQuery(`FROM "system_settings" .*session_idle_warning_minutes`).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "value"}))
+	mock.ExpectQuery(`FROM "system_settings" .*session_idle_action`).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "value"}).AddRow("session_idle_action", []byte(`"scale_down"`)))
+	expectNoBusyTask(mock, false)
+	expectNoBusyTask(mock, false)
+	expectMarkIdle(mock, 1)
+	mock.ExpectExec(`UPDATE "sessions" .* SET status = 'idle', .*WHERE \(id = 22\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Equal(t, 2, srv.ReapIdleSessions(context.Background()))
+	assert.Equal(t, int32(-1), kube.Replicas(testStatefulSet))
+	assert.Equal(t, int32(0), kube.Replicas("claude-code-8-4"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,124 @@
+package session_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/session"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+func dueJobRows(schedule string, nextRun time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "agent_id", "name", "schedule", "timezone", "prompt", "allowed_tools", "timeout_seconds", "enabled", "next_run_at"}).
+		AddRow(9, testUserID, testAgentID, "nightly", schedule, "UTC", "summarize", "{}", 600, true, nextRun)
+}
+
+func TestRunDueJobs_ConcurrentReplicasClaimOnce(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	kube := testutil.NewFakeKube(t)
+	replicas := []*session.Server{
+		session.NewServer(db, kube.Manager, nil, admin.NewSettingsService(db), nil, nil),
+		session.NewServer(db, kube.Manager, nil, admin.NewSettingsService(db), nil, nil),
+	}
+
+	// Both replicas see the same due job; the conditional update on
+	// next_run_at lets exactly one of them claim it.
+	nextRun := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	for range replicas {
+		mock.ExpectQuery(`FROM "agent_jobs" .*WHERE \(enabled = TRUE\) AND \(next_run_at <= `).
+			WillReturnRows(dueJobRows("*/5 * * * *", nextRun))
+	}
+	claim := `UPDATE "agent_jobs" .* SET next_run_at = .*, last_run_at = .* WHERE \(id = 9\) AND \(next_run_at = '` + nextRun.Format("2006-01-02 15:04:05") + `.*'\)`
+	mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(claim).WillReturnResult(sqlmock.NewResult(0, 0))
+	// The agent pod is down, so the claimed run is recorded as failed.
+	mock.ExpectQuery(`INSERT INTO "agent_tasks" .*'failed'.*'agent pod is not running'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		total int
+	)
+	for _, srv := range replicas {
+		wg.Add(1)
+		go func(srv *session.Server) {
+			defer wg.Done()
+			n := srv.RunDueJobs(context.Background())
+			mu.Lock()
+			total += n
+			mu.Unlock()
+		}(srv)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunDueJobs_InvalidScheduleDisablesJob(t *testing.T) {
+	srv, mock, _ := newTaskServer(t)
+	mock.ExpectQuery(`FROM "agent_jobs"`).
+		WillReturnRows(dueJobRows("not a cron", time.Now().Add(-time.Minute)))
+	mock.ExpectExec(`UPDATE "agent_jobs" .* SET enabled = FALSE, next_run_at = NULL WHERE \(id = 9\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Equal(t, 0, srv.RunDueJobs(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunDueJobs_DispatchesToRunningPod(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetPod(testPod, "10.0.0.3")
+	kube.HandleExec(fakeClaude([]string{`{"type":"result","result":"ok"}`}, nil))
+
+	mock.ExpectQuery(`FROM "agent_jobs"`).
+		WillReturnRows(dueJobRows("0 * * * *", time.Now().Add(-time.Minute)))
+	mock.ExpectExec(`UPDATE "agent_jobs" .* SET next_run_at`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "agent_tasks" .*'summarize'.*'pending'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'running'`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'completed'`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.Equal(t, 1, srv.RunDueJobs(context.Background()))
+	assert.Eventually(t, func() bool { return mock.ExpectationsWereMet() == nil }, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestCreateJob_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *sacv1.CreateJobRequest
+		agent  bool
+		code   codes.Code
+		substr string
+	}{
+		{"missing name", &sacv1.CreateJobRequest{AgentId: testAgentID, Schedule: "@daily", Prompt: "hi"}, true, codes.InvalidArgument, "name"},
+		{"bad cron", &sacv1.CreateJobRequest{AgentId: testAgentID, Name: "n", Schedule: "every day", Prompt: "hi"}, true, codes.InvalidArgument, "schedule"},
+		{"bad timezone", &sacv1.CreateJobRequest{AgentId: testAgentID, Name: "n", Schedule: "@daily", Timezone: "Mars/Base", Prompt: "hi"}, true, codes.InvalidArgument, "timezone"},
+		{"no prompt or skill", &sacv1.CreateJobRequest{AgentId: testAgentID, Name: "n", Schedule: "@daily"}, true, codes.InvalidArgument, "prompt"},
+		{"foreign agent", &sacv1.CreateJobRequest{AgentId: testAgentID, Name: "n", Schedule: "@daily", Prompt: "hi"}, false, codes.NotFound, "Agent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, mock, _ := newTaskServer(t)
+			mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents"`).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.agent))
+
+			_, err := srv.CreateJob(userCtx(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.substr)
+		})
+	}
+}
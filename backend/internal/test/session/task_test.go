@@ -0,0 +1,224 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/session"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const (
+	testUserID  = 7
+	testAgentID = 3
+	testPod     = "claude-code-7-3-0"
+)
+
+func newTaskServer(t *testing.T) (*session.Server, sqlmock.Sqlmock, *testutil.FakeKube) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	kube := testutil.NewFakeKube(t)
+	return session.NewServer(db, kube.Manager, nil, admin.NewSettingsService(db), nil, nil), mock, kube
+}
+
+func userCtx() context.Context {
+	return testutil.WithUser(context.Background(), testUserID, "user")
+}
+
+// fakeClaude answers the exec calls of a task run: the claude invocation
+// prints the given stream-json lines (or fails), the output listing reports
+// one new file.
+func fakeClaude(lines []string, runErr error) testutil.ExecFunc {
+	return func(pod string, cmd []string, _ io.Reader, stdout, _ io.Writer) error {
+		script := strings.Join(cmd, " ")
+		switch {
+		case strings.Contains(script, "claude -p"):
+			for _, l := range lines {
+				fmt.Fprintln(stdout, l)
+			}
+			return runErr
+		case strings.Contains(script, "find /workspace/output"):
+			fmt.Fprintln(stdout, "/workspace/output/report.md")
+		}
+		return nil
+	}
+}
+
+func expectTaskStart(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents"`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`INSERT INTO "agent_tasks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'running'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestRunTask_CompletesWithResult(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetPod(testPod, "10.0.0.3")
+	kube.HandleExec(fakeClaude([]string{
+		`{"type":"system","subtype":"init"}`,
+		`{"type":"result","subtype":"success","is_error":false,"result":"all done","session_id":"cs-1","num_turns":2,"total_cost_usd":0.01}`,
+	}, nil))
+
+	expectTaskStart(mock)
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'completed', error = '', stdout = .*, output_files = '\{"report.md"\}'.*result = 'all done'.*WHERE .*status = 'running'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	task, err := srv.RunTask(userCtx(), &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "  summarize  "})
+	require.NoError(t, err)
+	assert.Equal(t, "pending", task.Status)
+	assert.Equal(t, "summarize", task.Prompt)
+	assert.NotEmpty(t, task.TaskId)
+
+	assert.Eventually(t, func() bool { return mock.ExpectationsWereMet() == nil }, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestRunTask_ClaudeFailureMarksTaskFailed(t *testing.T) {
+	srv, mock, kube := newTaskServer(t)
+	kube.SetPod(testPod, "10.0.0.3")
+	kube.HandleExec(fakeClaude(nil, errors.New("command terminated with exit code 1")))
+
+	expectTaskStart(mock)
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'failed', error = '.*exit code 1.*'.*WHERE .*status = 'running'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := srv.RunTask(userCtx(), &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "summarize"})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool { return mock.ExpectationsWereMet() == nil }, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestRunTask_Validation(t *testing.T) {
+	srv, _, _ := newTaskServer(t)
+
+	tests := []struct {
+		name string
+		req  *sacv1.RunTaskRequest
+	}{
+		{"missing agent", &sacv1.RunTaskRequest{Prompt: "hi"}},
+		{"blank prompt", &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "   "}},
+		{"timeout too long", &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "hi", TimeoutSeconds: 7200}},
+		{"negative timeout", &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "hi", TimeoutSeconds: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.RunTask(userCtx(), tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestRunTask_PodNotRunning(t *testing.T) {
+	srv, mock, _ := newTaskServer(t)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents"`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	_, err := srv.RunTask(userCtx(), &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "hi"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestRunTask_ForeignAgent(t *testing.T) {
+	srv, mock, _ := newTaskServer(t)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents" .*created_by = 7`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := srv.RunTask(userCtx(), &sacv1.RunTaskRequest{AgentId: testAgentID, Prompt: "hi"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func taskRow(status string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "task_id", "user_id", "agent_id", "status", "error"}).
+		AddRow(42, "task-1", testUserID, testAgentID, status, "")
+}
+
+func TestCancelTask_Pending(t *testing.T) {
+	srv, mock, _ := newTaskServer(t)
+	mock.ExpectQuery(`FROM "agent_tasks"`).WillReturnRows(taskRow("pending"))
+	mock.ExpectExec(`UPDATE "agent_tasks" .* SET status = 'cancelled'.*status IN \('pending', 'running'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM "agent_tasks"`).WillReturnRows(taskRow("cancelled"))
+
+	task, err := srv.CancelTask(userCtx(), &sacv1.GetTaskRequest{TaskId: "task-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", task.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelTask_FinishedIsUnchanged(t *testing.T) {
+	srv, mock, _ := newTaskServer(t)
+	mock.ExpectQuery(`FROM "agent_tasks"`).WillReturnRows(taskRow("completed"))
+
+	task, err := srv.CancelTask(userCtx(), &sacv1.GetTaskRequest{TaskId: "task-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "completed", task.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func streamTask(t *testing.T, h *session.TaskHandler) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/tasks/task-1/events", nil)
+	c.Params = gin.Params{{Key: "task_id", Value: "task-1"}}
+	c.Set("userID", int64(testUserID))
+	h.StreamTask(c)
+	return w
+}
+
+func TestStreamTask_FinishedTaskSendsDone(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.ExpectQuery(`SELECT "at"."status", "at"."error" FROM "agent_tasks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "error"}).AddRow("failed", "boom"))
+
+	w := streamTask(t, session.NewTaskHandler(db, nil))
+
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/event-stream")
+	assert.Contains(t, w.Body.String(), "event:done")
+	assert.Contains(t, w.Body.String(), `"status":"failed"`)
+	assert.Contains(t, w.Body.String(), `"text":"boom"`)
+}
+
+func TestStreamTask_PollsUntilDone(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.MatchExpectationsInOrder(true)
+	mock.ExpectQuery(`FROM "agent_tasks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "error"}).AddRow("running", ""))
+	mock.ExpectQuery(`FROM "agent_tasks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "error"}).AddRow("completed", ""))
+
+	w := streamTask(t, session.NewTaskHandler(db, nil))
+
+	body := w.Body.String()
+	require.Contains(t, body, "event:status")
+	require.Contains(t, body, "event:done")
+	assert.Less(t, strings.Index(body, "event:status"), strings.Index(body, "event:done"))
+	assert.Contains(t, body, `"status":"completed"`)
+}
+
+func TestStreamTask_UnknownTask(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.ExpectQuery(`FROM "agent_tasks"`).WillReturnRows(sqlmock.NewRows([]string{"status", "error"}))
+
+	w := streamTask(t, session.NewTaskHandler(db, nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
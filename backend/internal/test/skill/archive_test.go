@@ -0,0 +1,352 @@
+package skill_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const importedID = 12
+
+// archiveFile is an entry of a test archive.
+type archiveFile struct {
+	name, body string
+}
+
+func makeTar(t *testing.T, files ...archiveFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: f.name, Size: int64(len(f.body)), Mode: 0o644}))
+		_, err := io.WriteString(tw, f.body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func makeZip(t *testing.T, files ...archiveFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, f.body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func gzipped(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// newArchiveHandler returns a skill handler storing files in memory.
+func newArchiveHandler(t *testing.T) (*skill.Handler, sqlmock.Sqlmock, *testutil.MemStorage) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	store := testutil.NewMemStorage()
+	return skill.NewHandler(db, nil, storage.NewStaticProvider(store)), mock, store
+}
+
+// importArchive posts an archive to ImportSkill as user 7.
+func importArchive(t *testing.T, h *skill.Handler, filename string, data []byte, fields map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for k, v := range fields {
+		require.NoError(t, mw.WriteField(k, v))
+	}
+	fw, err := mw.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, _ = fw.Write(data)
+	require.NoError(t, mw.Close())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/skills/import", &body)
+	c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+	c.Set("userID", int64(ownerID))
+	h.ImportSkill(c)
+	return w
+}
+
+// exportArchive fetches skill 5 as a zip through ExportSkill.
+func exportArchive(t *testing.T, h *skill.Handler, userID int64) *zip.Reader {
+	t.Helper()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/skills/5/export?format=zip", nil)
+	c.Params = gin.Params{{Key: "id", Value: "5"}}
+	c.Set("userID", userID)
+	c.Set("role", "user")
+	h.ExportSkill(c)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func readZipEntry(t *testing.T, zr *zip.Reader, name string) string {
+	t.Helper()
+	rc, err := zr.Open(name)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func expectCommandTaken(mock sqlmock.Sqlmock, name string, taken bool) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "skills" AS "sk" WHERE \(command_name = '` + name + `'\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(taken))
+}
+
+// expectImported expects the insert of skill 12 matching pattern, with the
+// default lint settings. The bundle rebuild that follows fails, which an
+// import only logs.
+func expectImported(mock sqlmock.Sqlmock, pattern string) {
+	mock.ExpectQuery(`FROM "system_settings"`).WillReturnError(errors.New("no settings"))
+	mock.ExpectQuery(`INSERT INTO "skills" .*` + pattern).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(importedID))
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 12\)`).WillReturnError(errors.New("connection reset"))
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(sk.id = 12\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "command_name", "created_by"}).
+			AddRow(importedID, "Review", "review", ownerID))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \("sf"."skill_id" IN \(12\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "filepath"}))
+}
+
+func expectFileStored(mock sqlmock.Sqlmock, filepath string) {
+	mock.ExpectQuery(`INSERT INTO "skill_files" .*'` + filepath + `'.*ON CONFLICT \(skill_id, filepath\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+}
+
+func TestImportSkill_Formats(t *testing.T) {
+	files := []archiveFile{
+		{"review/SKILL.md", "---\nname: Review\ndescription: Review the diff\n---\nReview the staged changes.\n"},
+		{"review/docs/style.md", "Use tabs."},
+	}
+	tests := []struct {
+		name     string
+		filename string
+		data     []byte
+	}{
+		{"zip", "review.zip", makeZip(t, files...)},
+		{"tar", "review.tar", makeTar(t, files...)},
+		{"tar.gz", "review.tar.gz", gzipped(t, makeTar(t, files...))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock, store := newArchiveHandler(t)
+			expectCommandTaken(mock, "review", false)
+			expectImported(mock, `'Review', 'Review the diff', .*'Review the staged changes.\s', 'review'`)
+			expectFileStored(mock, "docs/style.md")
+
+			w := importArchive(t, h, tt.filename, tt.data, nil)
+
+			require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+			data, ok := store.Get("skills/12/docs/style.md")
+			require.True(t, ok)
+			assert.Equal(t, "Use tabs.", string(data))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImportSkill_RejectsUnsafePaths(t *testing.T) {
+	skillMD := archiveFile{"review/SKILL.md", "Review the staged changes.\n"}
+	tests := []struct {
+		name  string
+		entry string
+	}{
+		{"parent directory", "../review/notes.md"},
+		{"absolute path", "/etc/cron.d/review"},
+		{"zip slip", "review/../../notes.md"},
+		{"backslashes", `review\..\..\notes.md`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock, store := newArchiveHandler(t)
+
+			w := importArchive(t, h, "review.zip", makeZip(t, skillMD, archiveFile{tt.entry, "x"}), nil)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "unsafe path in archive")
+			assert.Empty(t, store.Keys())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImportSkill_ContentOverBudget(t *testing.T) {
+	h, mock, _ := newArchiveHandler(t)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "review/SKILL.md", Size: 6, Mode: 0o644}))
+	_, _ = io.WriteString(tw, "Review")
+	// Compresses to a few hundred KB, well under the upload limit.
+	const size = 200<<20 + 1
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "review/zeros.bin", Size: size, Mode: 0o644}))
+	_, err := io.CopyN(tw, zeroReader{}, size)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	w := importArchive(t, h, "review.tar.gz", buf.Bytes(), nil)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "archive content exceeds 200 MB")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestImportSkill_RenamesTakenCommand(t *testing.T) {
+	h, mock, _ := newArchiveHandler(t)
+	expectCommandTaken(mock, "review", true)
+	expectCommandTaken(mock, "review-2", false)
+	expectImported(mock, `'review-2'`)
+
+	w := importArchive(t, h, "review.zip", makeZip(t, archiveFile{"review/SKILL.md", "Review the staged changes.\n"}), nil)
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportSkill_FailsOnTakenCommand(t *testing.T) {
+	h, mock, store := newArchiveHandler(t)
+	expectCommandTaken(mock, "lint", true)
+
+	w := importArchive(t, h, "review.zip", makeZip(t, archiveFile{"review/SKILL.md", "Review the staged changes.\n"}),
+		map[string]string{"command_name": "lint", "on_conflict": "fail"})
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "Command name '/lint' is already taken")
+	assert.Empty(t, store.Keys())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportSkill_ClaudeCodeFrontmatter(t *testing.T) {
+	h, mock, _ := newArchiveHandler(t)
+	expectCommandTaken(mock, "lint", false)
+	// Kebab-case keys from a ~/.claude/skills directory map onto the
+	// snake_case fields SKILL.md is exported with.
+	expectImported(mock, `'Lint', .*'Lint the staged changes.\s', 'lint', .*'\{"allowed_tools":\["Read","Bash"\],"argument_hint":"\[path\]","user_invocable":false\}'`)
+
+	skillMD := "---\nname: Lint\nallowed-tools: Read, Bash\nargument-hint: \"[path]\"\nuser-invocable: false\n---\nLint the staged changes.\n"
+	w := importArchive(t, h, "lint.tar", makeTar(t, archiveFile{"SKILL.md", skillMD}), nil)
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	h, mock, _ := newArchiveHandler(t)
+	frontmatter := `{"allowed_tools":["Read","Grep"],"model":"sonnet","argument_hint":"<path>","disable_model_invocation":true}`
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(sk.id = 5\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "tags", "command_name", "prompt", "frontmatter", "created_by", "version"}).
+			AddRow(skillID, "Review", "Review the diff", "{go,style}", "review", "Review the staged changes.\n", []byte(frontmatter), ownerID, 3))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \("sf"."skill_id" IN \(5\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "filepath"}))
+
+	zr := exportArchive(t, h, ownerID)
+	assert.Contains(t, readZipEntry(t, zr, "review/SKILL.md"), "allowed_tools:\n    - Read\n    - Grep\n")
+
+	var exported bytes.Buffer
+	zw := zip.NewWriter(&exported)
+	for _, f := range zr.File {
+		w, err := zw.Create(f.Name)
+		require.NoError(t, err)
+		_, _ = io.WriteString(w, readZipEntry(t, zr, f.Name))
+	}
+	require.NoError(t, zw.Close())
+
+	expectCommandTaken(mock, "review", true)
+	expectCommandTaken(mock, "review-2", false)
+	expectImported(mock, `'Review', 'Review the diff', .*'\{"go","style"\}', 'Review the staged changes.\s', 'review-2', .*'`+
+		`\{"allowed_tools":\["Read","Grep"\],"model":"sonnet","disable_model_invocation":true,"argument_hint":"\\u003cpath\\u003e"\}'`)
+
+	w := importArchive(t, h, "review.zip", exported.Bytes(), nil)
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportSkill_PublishedRevision(t *testing.T) {
+	h, mock, store := newArchiveHandler(t)
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(sk.id = 5\)`).WillReturnRows(skillRow(3, 2, true))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \("sf"."skill_id" IN \(5\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "filepath", "s3_key", "checksum", "size"}).
+			AddRow(1, skillID, "a.md", "skills/5/a.md", "live-a", 8).
+			AddRow(2, skillID, "unreviewed.md", "skills/5/unreviewed.md", "live-u", 3))
+	revFiles := `[{"filepath":"a.md","checksum":"rev-a","size":5,"content_type":"text/markdown"}]`
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(\(skill_id = 5 AND version = 2\)\)`).
+		WillReturnRows(versionRows().AddRow(22, skillID, 2, "", "published prompt", "{}", revFiles, "skills/5/versions/2/bundle.tar", ""))
+	mock.ExpectQuery(`SELECT "sv"."bundle_key", "sv"."files" FROM "skill_versions" AS "sv" WHERE \(skill_id = 5 AND version = 2\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"bundle_key", "files"}).AddRow("skills/5/versions/2/bundle.tar", revFiles))
+	store.Put("skills/5/versions/2/bundle.tar", makeTar(t,
+		archiveFile{"SKILL.md", "published prompt"},
+		archiveFile{"a.md", "old a"},
+		archiveFile{".checksum", "sum-v2"}))
+	store.Put("skills/5/a.md", []byte("latest a"))
+
+	zr := exportArchive(t, h, otherID)
+
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"review/SKILL.md", "review/a.md", "review/sac-skill.json"}, names)
+	assert.Contains(t, readZipEntry(t, zr, "review/SKILL.md"), "published prompt")
+	assert.Equal(t, "old a", readZipEntry(t, zr, "review/a.md"))
+
+	var manifest struct {
+		Version int `json:"version"`
+		Files   []struct {
+			Filepath string `json:"filepath"`
+			Checksum string `json:"checksum"`
+			Size     int64  `json:"size"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(readZipEntry(t, zr, "review/sac-skill.json")), &manifest))
+	assert.Equal(t, 2, manifest.Version)
+	require.Len(t, manifest.Files, 1)
+	assert.Equal(t, "a.md", manifest.Files[0].Filepath)
+	assert.Equal(t, "rev-a", manifest.Files[0].Checksum)
+	assert.EqualValues(t, 5, manifest.Files[0].Size)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,273 @@
+package skill_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const liveBundleKey = "skills/5/bundle.tar"
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// readTar returns the entries of a tar archive by name, in archive order.
+func readTar(t *testing.T, data []byte) ([]string, map[string]string) {
+	t.Helper()
+	var names []string
+	bodies := make(map[string]string)
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return names, bodies
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+		bodies[hdr.Name] = string(body)
+	}
+}
+
+// rejectingStorage refuses uploads without reading them.
+type rejectingStorage struct{ *testutil.MemStorage }
+
+func (rejectingStorage) Upload(context.Context, string, io.Reader, int64, string) error {
+	return errors.New("access denied")
+}
+
+func newBundleSync(t *testing.T, backend storage.StorageBackend) (*skill.SyncService, sqlmock.Sqlmock) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	return skill.NewSyncService(db, nil, storage.NewStaticProvider(backend)), mock
+}
+
+func expectLintSettings(mock sqlmock.Sqlmock, value string) {
+	q := mock.ExpectQuery(`FROM "system_settings"`)
+	if value == "" {
+		q.WillReturnError(errors.New("no settings"))
+		return
+	}
+	q.WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow([]byte(value)))
+}
+
+// expectBundleSources expects skill 5 and its file a.md of size bytes with
+// the given checksum to be loaded for a rebuild.
+func expectBundleSources(mock sqlmock.Sqlmock, size int, checksum string) {
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \(skill_id = 5\) ORDER BY "filepath" ASC`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "filepath", "s3_key", "checksum", "size"}).
+			AddRow(1, skillID, "a.md", "skills/5/a.md", checksum, size))
+}
+
+func expectBundleError(mock sqlmock.Sqlmock, message string) {
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET bundle_error = '` + message + `' WHERE \(id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestRebuildSkillBundle_StreamsBundle(t *testing.T) {
+	store := testutil.NewMemStorage()
+	store.Put("skills/5/a.md", []byte("hello"))
+	svc, mock := newBundleSync(t, store)
+	expectBundleSources(mock, 5, md5Hex("hello"))
+	expectLintSettings(mock, "")
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) ORDER BY "version" DESC LIMIT 1`).
+		WillReturnRows(versionRows())
+	mock.ExpectQuery(`UPDATE "skills" AS "sk" SET content_checksum = '[0-9a-f]{64}', bundle_error = '', version = version \+ 1, .*WHERE \(id = 5\) RETURNING version`).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+	mock.ExpectQuery(`INSERT INTO "skill_versions" .*'skills/5/versions/4/bundle.tar'.*ON CONFLICT \(skill_id, version\) DO NOTHING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(24))
+
+	require.NoError(t, svc.RebuildSkillBundle(context.Background(), skillID, "test"))
+
+	// MemStorage rejects uploads that differ from the announced size, so the
+	// streamed archive matched its precomputed length.
+	bundle, ok := store.Get(liveBundleKey)
+	require.True(t, ok)
+	names, bodies := readTar(t, bundle)
+	assert.Equal(t, []string{"SKILL.md", "a.md", ".checksum"}, names)
+	assert.Equal(t, "hello", bodies["a.md"])
+	sum := sha256.Sum256([]byte(bodies["SKILL.md"] + "\x00a.md\x005\x00hello"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), bodies[".checksum"])
+
+	kept, ok := store.Get("skills/5/versions/4/bundle.tar")
+	require.True(t, ok)
+	assert.Equal(t, bundle, kept)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRebuildSkillBundle_UploadRejected(t *testing.T) {
+	store := testutil.NewMemStorage()
+	store.Put("skills/5/a.md", []byte("hello"))
+	svc, mock := newBundleSync(t, rejectingStorage{store})
+	expectBundleSources(mock, 5, md5Hex("hello"))
+	expectLintSettings(mock, "")
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\)`).WillReturnRows(versionRows())
+	expectBundleError(mock, "failed to upload bundle: access denied")
+
+	// The bundle writer is blocked on the pipe nobody reads; the rebuild
+	// must still return.
+	err := svc.RebuildSkillBundle(context.Background(), skillID, "test")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "access denied")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRebuildSkillBundle_OverSizeLimit(t *testing.T) {
+	store := testutil.NewMemStorage()
+	store.Put("skills/5/a.md", []byte("hello"))
+	store.Put(liveBundleKey, []byte("previous"))
+	svc, mock := newBundleSync(t, store)
+	expectBundleSources(mock, 5, md5Hex("hello"))
+	expectLintSettings(mock, `{"max_skill_bytes":4}`)
+	expectBundleError(mock, "attached files total 5 bytes, the limit per skill is 4")
+
+	err := svc.RebuildSkillBundle(context.Background(), skillID, "test")
+	require.Error(t, err)
+	bundle, _ := store.Get(liveBundleKey)
+	assert.Equal(t, "previous", string(bundle))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRebuildSkillBundle_BadSourceFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  *string
+		message string
+	}{
+		{"missing", nil, "a.md: object skills/5/a.md not found"},
+		{"truncated", ptr("hel"), "a.md: stored file has 3 bytes, expected 5"},
+		{"corrupted", ptr("jello"), "a.md: stored file does not match its checksum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := testutil.NewMemStorage()
+			if tt.stored != nil {
+				store.Put("skills/5/a.md", []byte(*tt.stored))
+			}
+			store.Put(liveBundleKey, []byte("previous"))
+			svc, mock := newBundleSync(t, store)
+			expectBundleSources(mock, 5, md5Hex("hello"))
+			expectLintSettings(mock, "")
+			mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\)`).WillReturnRows(versionRows())
+			expectBundleError(mock, tt.message)
+
+			err := svc.RebuildSkillBundle(context.Background(), skillID, "test")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.message)
+			// A failed build keeps the previous bundle.
+			bundle, _ := store.Get(liveBundleKey)
+			assert.Equal(t, "previous", string(bundle))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
+
+// fakeSkillPod records the extract command a sync runs and the bundle bytes
+// it receives. The pod has no .checksum, so every sync streams the bundle.
+type fakeSkillPod struct {
+	mu       sync.Mutex
+	commands []string
+	received []byte
+}
+
+func newFakeSkillPod(t *testing.T) (*fakeSkillPod, *testutil.FakeKube) {
+	pod := &fakeSkillPod{}
+	kube := testutil.NewFakeKube(t)
+	kube.SetPod("claude-code-7-3-0", "10.0.0.3")
+	kube.HandleExec(func(_ string, cmd []string, stdin io.Reader, _, _ io.Writer) error {
+		line := strings.Join(cmd, " ")
+		pod.mu.Lock()
+		pod.commands = append(pod.commands, line)
+		pod.mu.Unlock()
+		if !strings.Contains(line, "tar xf -") {
+			if strings.HasPrefix(line, "cat ") {
+				return errors.New("No such file or directory")
+			}
+			return nil
+		}
+		data, _ := io.ReadAll(stdin)
+		pod.mu.Lock()
+		pod.received = data
+		pod.mu.Unlock()
+		// Like tar, fail on an archive that ends early.
+		tr := tar.NewReader(bytes.NewReader(data))
+		for {
+			if _, err := tr.Next(); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return errors.New("tar: Unexpected EOF in archive")
+			}
+			if _, err := io.Copy(io.Discard, tr); err != nil {
+				return errors.New("tar: Unexpected EOF in archive")
+			}
+		}
+	})
+	return pod, kube
+}
+
+func TestSyncSkillToAgent_ExtractsNextToSkillDir(t *testing.T) {
+	pod, kube := newFakeSkillPod(t)
+	store := testutil.NewMemStorage()
+	bundle := makeTar(t, archiveFile{"SKILL.md", "Review"}, archiveFile{"a.md", "hello"}, archiveFile{".checksum", "sum-v3"})
+	store.Put(liveBundleKey, bundle)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	svc := skill.NewSyncService(db, kube.Manager, storage.NewStaticProvider(store))
+	expectPin(mock, nil)
+	expectLintSettings(mock, "")
+	expectSynced(mock, "3")
+
+	require.NoError(t, svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil)))
+
+	assert.Contains(t, pod.commands, "bash -c rm -rf /root/.claude/skills/.review.partial && "+
+		"mkdir -p /root/.claude/skills/.review.partial && "+
+		"tar xf - -C /root/.claude/skills/.review.partial && "+
+		"test -f /root/.claude/skills/.review.partial/.checksum && "+
+		"rm -rf /root/.claude/skills/review && "+
+		"mv /root/.claude/skills/.review.partial /root/.claude/skills/review")
+	assert.Equal(t, bundle, pod.received)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_OversizedBundleAborted(t *testing.T) {
+	pod, kube := newFakeSkillPod(t)
+	store := testutil.NewMemStorage()
+	bundle := makeTar(t, archiveFile{"SKILL.md", "Review"}, archiveFile{"big.bin", strings.Repeat("x", 2<<20)}, archiveFile{".checksum", "sum-v3"})
+	store.Put(liveBundleKey, bundle)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	svc := skill.NewSyncService(db, kube.Manager, storage.NewStaticProvider(store))
+	expectPin(mock, nil)
+	expectLintSettings(mock, `{"max_skill_bytes":1,"max_skill_md_bytes":1}`)
+	expectSyncFailed(mock, `failed to extract tar for skill "review" in pod claude-code-7-3-0: .*`)
+
+	err := svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil))
+	require.Error(t, err)
+
+	// The stream stops one byte past the limit: 1 + 1 + 1MB of headers.
+	assert.LessOrEqual(t, len(pod.received), 1<<20+3)
+	assert.Contains(t, pod.commands, "rm -rf /root/.claude/skills/.review.partial")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
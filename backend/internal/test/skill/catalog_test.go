@@ -0,0 +1,105 @@
+package skill_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+)
+
+func catalogRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "command_name", "prompt", "frontmatter", "tags", "created_by", "is_public", "version", "published_version", "install_count", "fork_count", "invocation_count"})
+}
+
+func TestListPublicSkills_SearchWithStats(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`(?s)SELECT .*AS install_count.*AS fork_count.*FROM "skills" AS "sk" LEFT JOIN "users" AS "creator" .*` +
+		`\(sk.search_vector @@ websearch_to_tsquery\('simple', 'Deploy k8s'\) OR 'deploy k8s' = ANY\(sk.tags\)\) ` +
+		`AND \(sk.category = 'ops'\) AND \(sk.tags @> '\{"prod","k8s"\}'\) ` +
+		`ORDER BY ts_rank\(sk.search_vector, websearch_to_tsquery\('simple', 'Deploy k8s'\)\) DESC, sk.id ASC LIMIT 10 OFFSET 20`).
+		WillReturnRows(catalogRows().AddRow(skillID, "Deploy", "deploy", "latest draft", "{}", `{k8s,prod}`, ownerID, true, 3, 2, 4, 2, 9))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "skills" AS "sk"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(21))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \("sf"."skill_id" IN \(5\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	// Other users see the published v2, not the unreviewed v3.
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(\(skill_id = 5 AND version = 2\)\)`).
+		WillReturnRows(versionRows().AddRow(2, skillID, 2, "", "published prompt", "{}", "[]", "skills/5/versions/2/bundle.tar", ""))
+
+	resp, err := srv.ListPublicSkills(asUser(otherID), &sacv1.ListPublicSkillsRequest{
+		Query: " Deploy k8s ", Category: "ops", Tags: []string{"Prod", "k8s", "prod"}, Limit: 10, Offset: 20,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 21, resp.Total)
+	require.Len(t, resp.Skills, 1)
+	got := resp.Skills[0]
+	assert.Equal(t, "published prompt", got.Prompt)
+	assert.EqualValues(t, 2, got.Version)
+	assert.Equal(t, []string{"k8s", "prod"}, got.Tags)
+	assert.EqualValues(t, 4, got.InstallCount)
+	assert.EqualValues(t, 2, got.ForkCount)
+	assert.EqualValues(t, 9, got.InvocationCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPublicSkills_SortAndPaging(t *testing.T) {
+	tests := []struct {
+		name  string
+		sort  string
+		limit int32
+		sql   string
+	}{
+		{"default", "", 0, `ORDER BY "sk"."category" ASC, "sk"."name" ASC, sk.id ASC LIMIT 50$`},
+		{"installs capped", "installs", 500, `ORDER BY "install_count" DESC, sk.id ASC LIMIT 50$`},
+		{"invocations", "invocations", 5, `ORDER BY "invocation_count" DESC, sk.id ASC LIMIT 5$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, mock := newSkillServer(t)
+			mock.ExpectQuery(`FROM "skills" AS "sk" .*WHERE \(sk.is_public = TRUE OR sk.is_official = TRUE\) AND \(sk.published_version IS NOT NULL\) ` + tt.sql).
+				WillReturnRows(catalogRows())
+			mock.ExpectQuery(`SELECT count\(\*\)`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+			resp, err := srv.ListPublicSkills(asUser(otherID), &sacv1.ListPublicSkillsRequest{Sort: tt.sort, Limit: tt.limit})
+			require.NoError(t, err)
+			assert.Empty(t, resp.Skills)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListPublicSkills_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *sacv1.ListPublicSkillsRequest
+	}{
+		{"unknown sort", &sacv1.ListPublicSkillsRequest{Sort: "stars"}},
+		{"relevance without query", &sacv1.ListPublicSkillsRequest{Sort: "relevance"}},
+		{"invalid tag", &sacv1.ListPublicSkillsRequest{Tags: []string{"a tag"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newSkillServer(t)
+			_, err := srv.ListPublicSkills(asUser(otherID), tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestListPublicSkillTags(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`SELECT tag, COUNT\(\*\) AS count FROM skills AS sk, unnest\(sk.tags\) AS tag .*sk.published_version IS NOT NULL.* GROUP BY tag ORDER BY count DESC, tag ASC`).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "count"}).AddRow("k8s", 3).AddRow("ops", 1))
+
+	resp, err := srv.ListPublicSkillTags(asUser(otherID), &sacv1.Empty{})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 2)
+	assert.Equal(t, "k8s", resp.Tags[0].Tag)
+	assert.EqualValues(t, 3, resp.Tags[0].Count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
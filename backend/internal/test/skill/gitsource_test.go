@@ -0,0 +1,134 @@
+package skill_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/skill"
+)
+
+// newBareRepo creates a bare repository whose main branch holds files.
+func newBareRepo(t *testing.T, files map[string]string) (string, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	work := filepath.Join(dir, "work")
+	bare := filepath.Join(dir, "skills.git")
+	for name, content := range files {
+		p := filepath.Join(work, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+
+	git := func(dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=t", "GIT_AUTHOR_EMAIL=t@example.com",
+			"GIT_COMMITTER_NAME=t", "GIT_COMMITTER_EMAIL=t@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git(work, "init", "-q", "-b", "main")
+	git(work, "add", "-A")
+	git(work, "commit", "-q", "-m", "skills")
+	git(dir, "clone", "-q", "--bare", work, bare)
+	return bare, git(work, "rev-parse", "HEAD")
+}
+
+func TestFetchGitSource_ScansSkillDirectories(t *testing.T) {
+	bare, head := newBareRepo(t, map[string]string{
+		"skills/report/SKILL.md":         "---\nname: Report\n---\nWrite a report.\n",
+		"skills/report/templates/a.md":   "# A\n",
+		"skills/report/.hidden/skip.txt": "x",
+		"skills/lint/SKILL.md":           "Lint the code.\n",
+		"skills/notes/README.md":         "no SKILL.md here",
+		"README.md":                      "top level",
+	})
+
+	checkout := filepath.Join(t.TempDir(), "repo")
+	commit, err := skill.FetchGitSource(context.Background(), skill.GitFetchOptions{
+		RepoURL: bare,
+		Branch:  "main",
+	}, checkout)
+	require.NoError(t, err)
+	assert.Equal(t, head, commit)
+
+	found, err := skill.ScanSourceTree(checkout, "/skills/")
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+
+	assert.Equal(t, "lint", found[0].Path)
+	assert.Empty(t, found[0].Files)
+
+	assert.Equal(t, "report", found[1].Path)
+	assert.Contains(t, found[1].SkillMD, "name: Report")
+	require.Len(t, found[1].Files, 1)
+	assert.Equal(t, "templates/a.md", found[1].Files[0].Path)
+}
+
+func TestFetchGitSource_UnknownBranch(t *testing.T) {
+	bare, _ := newBareRepo(t, map[string]string{"s/SKILL.md": "x"})
+
+	_, err := skill.FetchGitSource(context.Background(), skill.GitFetchOptions{
+		RepoURL: bare,
+		Branch:  "release",
+	}, filepath.Join(t.TempDir(), "repo"))
+	assert.Error(t, err)
+}
+
+func TestScanSourceTree_IgnoresSymlinks(t *testing.T) {
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0o600))
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "s")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("x"), 0o644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "leak.txt")))
+
+	found, err := skill.ScanSourceTree(root, "")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Empty(t, found[0].Files)
+
+	// A subdir cannot climb out of the checkout.
+	found, err = skill.ScanSourceTree(filepath.Join(root, "s"), "../..")
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestScanSourceTree_SubdirSymlink(t *testing.T) {
+	outside := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(outside, "leak"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "leak", "SKILL.md"), []byte("secret"), 0o644))
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "skills", "report"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "skills", "report", "SKILL.md"), []byte("x"), 0o644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+	require.NoError(t, os.Symlink("skills", filepath.Join(root, "current")))
+
+	// A symlink out of the checkout is rejected, also as a parent directory.
+	for _, subdir := range []string{"escape", "escape/leak"} {
+		_, err := skill.ScanSourceTree(root, subdir)
+		assert.ErrorContains(t, err, "outside the repository", subdir)
+	}
+
+	// One that stays inside the checkout is followed.
+	found, err := skill.ScanSourceTree(root, "current")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "report", found[0].Path)
+}
@@ -0,0 +1,69 @@
+package skill_test
+
+import (
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/skill"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parameterizedSkill() *models.Skill {
+	return &models.Skill{
+		Name:        "Release",
+		Description: "Cut a release",
+		Prompt:      "Release $1 from $2.",
+		CommandName: "release",
+		Parameters: models.SkillParameters{
+			{Name: "version", Type: skill.ParamText, Required: true},
+			{Name: "branch", Type: skill.ParamSelect, Options: []string{"main", "stable"}, DefaultValue: "main"},
+			{Name: "date", Type: skill.ParamDate},
+			{Name: "note", Type: skill.ParamText},
+		},
+	}
+}
+
+func TestRenderInvocation(t *testing.T) {
+	sk := parameterizedSkill()
+
+	got, args, err := skill.RenderInvocation(sk, map[string]string{"version": "1.2.0"})
+	require.NoError(t, err)
+	assert.Equal(t, "/release 1.2.0 main", got)
+	assert.Equal(t, []string{"1.2.0", "main"}, args)
+
+	got, _, err = skill.RenderInvocation(sk, map[string]string{"version": "1.2.0", "note": `say "hi"`})
+	require.NoError(t, err)
+	assert.Equal(t, `/release 1.2.0 main "" "say \"hi\""`, got)
+}
+
+func TestRenderInvocation_RejectsInvalidValues(t *testing.T) {
+	sk := parameterizedSkill()
+
+	for name, values := range map[string]map[string]string{
+		"missing required": {},
+		"unknown option":   {"version": "1", "branch": "dev"},
+		"bad date":         {"version": "1", "date": "18/10/2026"},
+		"unknown name":     {"version": "1", "nope": "x"},
+		"line break":       {"version": "1\n/clear"},
+	} {
+		_, _, err := skill.RenderInvocation(sk, values)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLintSkill_InvalidParameters(t *testing.T) {
+	sk := parameterizedSkill()
+	sk.Parameters = append(sk.Parameters,
+		models.SkillParameter{Name: "version", Type: skill.ParamText},
+		models.SkillParameter{Name: "count", Type: skill.ParamNumber, DefaultValue: "many"},
+	)
+
+	issues := skill.LintSkill(skill.DefaultLintConfig(), sk, nil)
+	require.Len(t, issues, 2)
+	for _, is := range issues {
+		assert.Equal(t, skill.RuleInvalidParameter, is.Rule)
+	}
+	// Parameters generate the argument hint, so none is required.
+	assert.Empty(t, skill.LintSkill(skill.DefaultLintConfig(), parameterizedSkill(), nil))
+}
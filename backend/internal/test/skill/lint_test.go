@@ -0,0 +1,96 @@
+package skill_test
+
+import (
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/skill"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validSkill() *models.Skill {
+	return &models.Skill{
+		Name:        "Review",
+		Description: "Review the current diff",
+		Prompt:      "Review the staged changes.",
+		CommandName: "review",
+	}
+}
+
+func rules(issues []skill.LintIssue) map[string]string {
+	out := make(map[string]string, len(issues))
+	for _, is := range issues {
+		out[is.Rule] = is.Severity
+	}
+	return out
+}
+
+func TestLintSkill_Valid(t *testing.T) {
+	sk := validSkill()
+	sk.Frontmatter.AllowedTools = []string{"Read", "Bash(git diff:*)", "mcp__github__create_issue"}
+	sk.Frontmatter.Model = "sonnet"
+
+	assert.Empty(t, skill.LintSkill(skill.DefaultLintConfig(), sk, nil))
+}
+
+func TestLintSkill_ReportsFrontmatterMistakes(t *testing.T) {
+	sk := validSkill()
+	sk.Prompt = "Review $ARGUMENTS"
+	sk.Frontmatter.AllowedTools = []string{"Reed"}
+	sk.Frontmatter.Model = "gpt-4"
+
+	got := rules(skill.LintSkill(skill.DefaultLintConfig(), sk, nil))
+	assert.Equal(t, map[string]string{
+		skill.RuleUnknownTool:         skill.LintWarning,
+		skill.RuleInvalidModel:        skill.LintError,
+		skill.RuleMissingArgumentHint: skill.LintWarning,
+	}, got)
+}
+
+func TestLintSkill_Files(t *testing.T) {
+	cfg := skill.DefaultLintConfig()
+	cfg.MaxFileBytes = 10
+	files := []skill.LintFile{
+		{Path: "ok.md", Size: 2, Content: []byte("ok"), Text: true},
+		{Path: "../escape.sh", Size: 1},
+		{Path: "big.bin", Size: 11},
+		{Path: "latin1.txt", Size: 1, Content: []byte{0xe9}, Text: true},
+	}
+
+	issues := skill.LintSkill(cfg, validSkill(), files)
+	require.Len(t, issues, 3)
+	assert.Equal(t, skill.RuleUnsafePath, issues[0].Rule)
+	assert.Equal(t, "files/../escape.sh", issues[0].Field)
+	assert.Equal(t, skill.RuleFileTooLarge, issues[1].Rule)
+	assert.Equal(t, skill.RuleNonUTF8, issues[2].Rule)
+}
+
+func TestParseLintConfig_Overrides(t *testing.T) {
+	cfg, err := skill.ParseLintConfig([]byte(`{"rules":{"invalid_model":"off","unknown_tool":"error"},"extra_tools":["Deploy"]}`))
+	require.NoError(t, err)
+
+	sk := validSkill()
+	sk.Frontmatter.AllowedTools = []string{"Deploy", "Nope"}
+	sk.Frontmatter.Model = "gpt-4"
+	assert.Equal(t, map[string]string{skill.RuleUnknownTool: skill.LintError}, rules(skill.LintSkill(cfg, sk, nil)))
+	assert.Equal(t, skill.DefaultLintConfig().MaxFileBytes, cfg.MaxFileBytes)
+}
+
+func TestParseLintConfig_SkillSizeLimit(t *testing.T) {
+	cfg, err := skill.ParseLintConfig([]byte(`{"max_skill_bytes":1048576}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<20), cfg.MaxSkillBytes)
+
+	cfg, err = skill.ParseLintConfig([]byte(`{"max_skill_bytes":0}`))
+	require.NoError(t, err)
+	assert.Equal(t, skill.DefaultLintConfig().MaxSkillBytes, cfg.MaxSkillBytes)
+}
+
+func TestParseLintConfig_RejectsUnknownRules(t *testing.T) {
+	_, err := skill.ParseLintConfig([]byte(`{"rules":{"no_such_rule":"off"}}`))
+	assert.Error(t, err)
+
+	_, err = skill.ParseLintConfig([]byte(`{"rules":{"empty_prompt":"fatal"}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package skill_test
+
+import (
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/skill"
+	"github.com/stretchr/testify/assert"
+)
+
+const mergeBase = "# Review\n\nCheck style.\nCheck tests.\nCheck docs.\n"
+
+func TestThreeWayMerge_OneSideChanged(t *testing.T) {
+	ours := "# Review\n\nCheck style carefully.\nCheck tests.\nCheck docs.\n"
+
+	got, conflict := skill.ThreeWayMerge(mergeBase, ours, mergeBase, "upstream")
+	assert.False(t, conflict)
+	assert.Equal(t, ours, got)
+
+	got, conflict = skill.ThreeWayMerge(mergeBase, mergeBase, ours, "upstream")
+	assert.False(t, conflict)
+	assert.Equal(t, ours, got)
+}
+
+func TestThreeWayMerge_DisjointChanges(t *testing.T) {
+	ours := "# Review\n\nCheck style carefully.\nCheck tests.\nCheck docs.\n"
+	theirs := "# Review\n\nCheck style.\nCheck tests.\nCheck docs and changelog.\nReport findings.\n"
+
+	got, conflict := skill.ThreeWayMerge(mergeBase, ours, theirs, "upstream")
+	assert.False(t, conflict)
+	assert.Equal(t, "# Review\n\nCheck style carefully.\nCheck tests.\nCheck docs and changelog.\nReport findings.\n", got)
+}
+
+func TestThreeWayMerge_Conflict(t *testing.T) {
+	ours := "# Review\n\nCheck style.\nRun the unit tests.\nCheck docs.\n"
+	theirs := "# Review\n\nCheck style.\nRun all tests.\nCheck docs.\n"
+
+	got, conflict := skill.ThreeWayMerge(mergeBase, ours, theirs, "upstream v3")
+	assert.True(t, conflict)
+	assert.Equal(t, "# Review\n\nCheck style.\n"+
+		"<<<<<<< fork\nRun the unit tests.\n=======\nRun all tests.\n>>>>>>> upstream v3\n"+
+		"Check docs.\n", got)
+}
+
+func TestThreeWayMerge_SameChangeOnBothSides(t *testing.T) {
+	changed := "# Review\n\nCheck style.\nCheck tests.\n"
+
+	got, conflict := skill.ThreeWayMerge(mergeBase, changed, changed, "upstream")
+	assert.False(t, conflict)
+	assert.Equal(t, changed, got)
+}
+
+func TestThreeWayMerge_NoBase(t *testing.T) {
+	got, conflict := skill.ThreeWayMerge("", "a\n", "b\n", "upstream")
+	assert.True(t, conflict)
+	assert.Equal(t, "<<<<<<< fork\na\n=======\nb\n>>>>>>> upstream\n", got)
+}
@@ -0,0 +1,121 @@
+package skill_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const agentID = 3
+
+// newPinnedSync returns a sync service whose agent pod reports podChecksum
+// in the skill's .checksum file.
+func newPinnedSync(t *testing.T, podChecksum string) (*skill.SyncService, sqlmock.Sqlmock) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	kube := testutil.NewFakeKube(t)
+	kube.SetPod("claude-code-7-3-0", "10.0.0.3")
+	kube.HandleExec(func(_ string, cmd []string, _ io.Reader, stdout, _ io.Writer) error {
+		if strings.Join(cmd, " ") == "cat /root/.claude/skills/review/.checksum" {
+			io.WriteString(stdout, podChecksum+"\n")
+		}
+		return nil
+	})
+	return skill.NewSyncService(db, kube.Manager, nil), mock
+}
+
+// liveSkill is skill 5 at version 3, owned by createdBy.
+func liveSkill(createdBy int64, published *int) *models.Skill {
+	return &models.Skill{
+		ID: skillID, Name: "Review", CommandName: "review",
+		CreatedBy: createdBy, Version: 3, PublishedVersion: published,
+		ContentChecksum: "sum-v3",
+	}
+}
+
+func expectPin(mock sqlmock.Sqlmock, pinned any) {
+	mock.ExpectQuery(`SELECT "as"."pinned_version" FROM "agent_skills" AS "as" WHERE \(agent_id = 3\) AND \(skill_id = 5\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"pinned_version"}).AddRow(pinned))
+}
+
+func expectRevision(mock sqlmock.Sqlmock, version int, bundleKey string) {
+	mock.ExpectQuery(fmt.Sprintf(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5 AND version = %d\)`, version)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "version", "content_checksum", "bundle_key"}).
+			AddRow(20+version, skillID, version, fmt.Sprintf("sum-v%d", version), bundleKey))
+}
+
+func expectSynced(mock sqlmock.Sqlmock, version string) {
+	mock.ExpectExec(`UPDATE "agent_skills" AS "as" SET .*synced_version = ` + version + `, .*WHERE \(agent_id = 3\) AND \(skill_id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectSyncFailed(mock sqlmock.Sqlmock, message string) {
+	mock.ExpectQuery(`UPDATE "agent_skills" AS "as" SET .*sync_error = '` + message + `'.* RETURNING sync_attempts`).
+		WillReturnRows(sqlmock.NewRows([]string{"sync_attempts"}).AddRow(1))
+	mock.ExpectExec(`UPDATE "agent_skills" AS "as" SET next_sync_retry_at = `).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestSyncSkillToAgent_UsesPinnedRevision(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v2")
+	expectPin(mock, 2)
+	expectRevision(mock, 2, "skills/5/versions/2/bundle.tar")
+	// The pod already runs v2, so nothing is streamed and v2 is recorded.
+	expectSynced(mock, "2")
+
+	require.NoError(t, svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_PinnedToLatestUsesLiveBundle(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v3")
+	expectPin(mock, 3)
+	expectSynced(mock, "3")
+
+	require.NoError(t, svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_OthersGetPublishedVersion(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v2")
+	published := 2
+	expectPin(mock, nil)
+	expectRevision(mock, 2, "skills/5/versions/2/bundle.tar")
+	expectSynced(mock, "2")
+
+	require.NoError(t, svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(otherID, &published)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_PinnedBundleMissing(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v3")
+	expectPin(mock, 1)
+	// A seeded revision that never had a bundle built.
+	expectRevision(mock, 1, "")
+	expectSyncFailed(mock, `version 1 of skill "review" is not available`)
+
+	err := svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "version 1 of skill \"review\" is not available")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_UnpublishedSkillOfOthers(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v3")
+	expectSyncFailed(mock, `skill "review" is not published`)
+
+	err := svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(otherID, nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not published")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,221 @@
+package skill_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/notify"
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const (
+	adminID  = 1
+	reviewID = 30
+	groupID  = 4
+)
+
+// newReviewServer returns a skill server whose notifier looks up channels in
+// the mock database, so notified users show up as expected queries.
+func newReviewServer(t *testing.T) (*skill.Server, sqlmock.Sqlmock, *notify.Notifier) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	kube := testutil.NewFakeKube(t)
+	syncService := skill.NewSyncService(db, kube.Manager, nil)
+	notifier := notify.NewNotifier(db)
+	syncService.SetNotifier(notifier)
+	return skill.NewServer(db, syncService), mock, notifier
+}
+
+// expectNotified expects the channel lookup for an event sent to userID.
+func expectNotified(mock sqlmock.Sqlmock, userID, event string) {
+	mock.ExpectQuery(`FROM "notification_channels" AS "nc" WHERE \(user_id = ` + userID + `\) .*'` + event + `' = ANY\(events\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+func expectAudit(mock sqlmock.Sqlmock, action string) {
+	mock.ExpectQuery(`INSERT INTO "skill_audit_events" .*'` + action + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(90))
+}
+
+// expectPendingReview loads review 30 of skill 5 v3, submitted by the owner.
+func expectPendingReview(mock sqlmock.Sqlmock, target string, group any) {
+	mock.ExpectQuery(`FROM "skill_reviews" AS "sr" LEFT JOIN "skills" AS "skill" .*WHERE \(sr.id = 30\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "version", "target", "group_id", "status", "submitted_by", "skill__id", "skill__command_name", "skill__created_by", "skill__version"}).
+			AddRow(reviewID, skillID, 3, target, group, "pending", ownerID, skillID, "review", ownerID, 3))
+}
+
+func expectReviewReload(mock sqlmock.Sqlmock, status string) {
+	mock.ExpectQuery(`FROM "skill_reviews" AS "sr" .*LEFT JOIN "users" AS "submitter" .*WHERE \(sr.id = 30\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "skill_id", "version", "target", "status", "submitted_by"}).
+			AddRow(reviewID, skillID, 3, "public", status, ownerID))
+}
+
+func TestSubmitSkillReview_NotifiesReviewers(t *testing.T) {
+	srv, mock, notifier := newReviewServer(t)
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "skill_reviews" .*skill_id = 5 AND status = 'pending'`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery(`INSERT INTO "skill_reviews" .*\(DEFAULT, 5, 3, 'public', DEFAULT, 'pending', 'please'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(reviewID))
+	expectAudit(mock, "review_submitted")
+	mock.ExpectQuery(`SELECT "u"."id" FROM "users" AS "u" WHERE \(role = 'admin'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(adminID).AddRow(ownerID))
+	// The submitter is an admin too but is not asked to review their own skill.
+	expectNotified(mock, "1", notify.EventSkillReviewRequest)
+	expectReviewReload(mock, "pending")
+
+	got, err := srv.SubmitSkillReview(asUser(ownerID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "public", Comment: "please"})
+	require.NoError(t, err)
+	assert.Equal(t, "pending", got.Status)
+	notifier.Wait()
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubmitSkillReview_Rejects(t *testing.T) {
+	t.Run("not the owner", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+
+		_, err := srv.SubmitSkillReview(asUser(otherID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "public"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+
+		_, err := srv.SubmitSkillReview(asUser(ownerID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "everyone"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("group the owner is not in", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+		mock.ExpectQuery(`FROM "group_members"`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		group := int64(groupID)
+		_, err := srv.SubmitSkillReview(asUser(ownerID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "group", GroupId: &group})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("already published", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, 3, true))
+
+		_, err := srv.SubmitSkillReview(asUser(ownerID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "public"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("review pending", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+		mock.ExpectQuery(`FROM "skill_reviews"`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		_, err := srv.SubmitSkillReview(asUser(ownerID), &sacv1.SubmitSkillReviewRequest{Id: skillID, Target: "public"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "already pending")
+	})
+}
+
+func TestApproveSkillReview_PublishesVersion(t *testing.T) {
+	srv, mock, notifier := newReviewServer(t)
+	expectPendingReview(mock, "public", nil)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "skill_reviews" AS "sr" SET status = 'approved', reviewed_by = 1, review_comment = 'lgtm', .*WHERE \(id = 30 AND status = 'pending'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET published_version = 3, is_public = true, group_id = NULL WHERE \(id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAudit(mock, "review_approved")
+	mock.ExpectCommit()
+	expectNotified(mock, "7", notify.EventSkillReviewDecided)
+	expectReviewReload(mock, "approved")
+
+	got, err := srv.ApproveSkillReview(testutil.WithUser(context.Background(), adminID, "admin"),
+		&sacv1.SkillReviewDecisionRequest{ReviewId: reviewID, Comment: "lgtm"})
+	require.NoError(t, err)
+	assert.Equal(t, "approved", got.Status)
+	notifier.Wait()
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveSkillReview_GroupAdminPublishesToGroup(t *testing.T) {
+	srv, mock, notifier := newReviewServer(t)
+	expectPendingReview(mock, "group", groupID)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "group_members" .*group_id = 4 AND user_id = 8 AND role = 'admin'`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "skill_reviews"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "skills" AS "sk" SET published_version = 3, is_public = false, group_id = 4 WHERE \(id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAudit(mock, "review_approved")
+	mock.ExpectCommit()
+	expectNotified(mock, "7", notify.EventSkillReviewDecided)
+	expectReviewReload(mock, "approved")
+
+	_, err := srv.ApproveSkillReview(asUser(otherID), &sacv1.SkillReviewDecisionRequest{ReviewId: reviewID})
+	require.NoError(t, err)
+	notifier.Wait()
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveSkillReview_OnlyReviewers(t *testing.T) {
+	srv, mock, _ := newReviewServer(t)
+	// Platform reviews are for platform admins only.
+	expectPendingReview(mock, "public", nil)
+
+	_, err := srv.ApproveSkillReview(asUser(otherID), &sacv1.SkillReviewDecisionRequest{ReviewId: reviewID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveSkillReview_AlreadyDecided(t *testing.T) {
+	srv, mock, _ := newReviewServer(t)
+	expectPendingReview(mock, "public", nil)
+	mock.ExpectBegin()
+	// Another reviewer decided first: the pending condition matches nothing.
+	mock.ExpectExec(`UPDATE "skill_reviews" .*WHERE \(id = 30 AND status = 'pending'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := srv.ApproveSkillReview(testutil.WithUser(context.Background(), adminID, "admin"),
+		&sacv1.SkillReviewDecisionRequest{ReviewId: reviewID})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "already decided")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRejectSkillReview(t *testing.T) {
+	admin := testutil.WithUser(context.Background(), adminID, "admin")
+
+	t.Run("requires a comment", func(t *testing.T) {
+		srv, mock, _ := newReviewServer(t)
+		expectPendingReview(mock, "public", nil)
+
+		_, err := srv.RejectSkillReview(admin, &sacv1.SkillReviewDecisionRequest{ReviewId: reviewID})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("notifies the submitter", func(t *testing.T) {
+		srv, mock, notifier := newReviewServer(t)
+		expectPendingReview(mock, "public", nil)
+		mock.ExpectExec(`UPDATE "skill_reviews" AS "sr" SET status = 'rejected', reviewed_by = 1, review_comment = 'needs tests'`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAudit(mock, "review_rejected")
+		expectNotified(mock, "7", notify.EventSkillReviewDecided)
+		expectReviewReload(mock, "rejected")
+
+		got, err := srv.RejectSkillReview(admin, &sacv1.SkillReviewDecisionRequest{ReviewId: reviewID, Comment: "needs tests"})
+		require.NoError(t, err)
+		assert.Equal(t, "rejected", got.Status)
+		notifier.Wait()
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
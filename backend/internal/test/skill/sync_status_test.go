@@ -0,0 +1,150 @@
+package skill_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+func TestSyncRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), skill.SyncRetryDelay(0))
+	assert.Equal(t, time.Minute, skill.SyncRetryDelay(1))
+	assert.Equal(t, 2*time.Minute, skill.SyncRetryDelay(2))
+	assert.Equal(t, 16*time.Minute, skill.SyncRetryDelay(5))
+	assert.Equal(t, 6*time.Hour, skill.SyncRetryDelay(10))
+	assert.Equal(t, 6*time.Hour, skill.SyncRetryDelay(1000))
+}
+
+// aroundNow matches the timestamp bun renders for time.Now().Add(d) taken
+// within the next few seconds.
+func aroundNow(d time.Duration) string {
+	start := time.Now().Add(d)
+	alts := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		alts = append(alts, regexp.QuoteMeta(start.Add(time.Duration(i)*time.Second).Format("2006-01-02 15:04:05")))
+	}
+	return `'(` + strings.Join(alts, "|") + `)`
+}
+
+func TestSyncSkillToAgent_FailureSchedulesRetry(t *testing.T) {
+	svc, mock := newPinnedSync(t, "")
+	// Third failure in a row: retried after four minutes.
+	mock.ExpectQuery(`UPDATE "agent_skills" AS "as" SET last_sync_attempt_at = .*, sync_error = 'skill "review" is not published', ` +
+		`sync_attempts = sync_attempts \+ 1 WHERE \(agent_id = 3\) AND \(skill_id = 5\) RETURNING sync_attempts`).
+		WillReturnRows(sqlmock.NewRows([]string{"sync_attempts"}).AddRow(3))
+	mock.ExpectExec(`UPDATE "agent_skills" AS "as" SET next_sync_retry_at = ` + aroundNow(4*time.Minute) + `.*WHERE \(agent_id = 3\) AND \(skill_id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(otherID, nil))
+	require.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncSkillToAgent_SuccessResetsFailures(t *testing.T) {
+	svc, mock := newPinnedSync(t, "sum-v3")
+	expectPin(mock, nil)
+	mock.ExpectExec(`UPDATE "agent_skills" AS "as" SET last_sync_attempt_at = .*, synced_version = 3, last_synced_at = .*, ` +
+		`sync_error = '', sync_attempts = 0, next_sync_retry_at = NULL WHERE \(agent_id = 3\) AND \(skill_id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, svc.SyncSkillToAgent(context.Background(), "7", agentID, liveSkill(ownerID, nil)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectInstallations loads skill 5 as installed on agent 3, never synced,
+// with the given retry time.
+func expectInstallations(mock sqlmock.Sqlmock, nextRetry any) {
+	mock.ExpectQuery(`FROM "skills" AS "sk" JOIN agent_skills AS ags ON ags.skill_id = sk.id WHERE \(ags.agent_id = 3\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "command_name", "created_by", "version", "synced_version", "pinned_version", "next_sync_retry_at"}).
+			AddRow(skillID, "Review", "review", ownerID, 3, 0, nil, nextRetry))
+	mock.ExpectQuery(`FROM group_members WHERE \(user_id = 7\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"group_id"}))
+}
+
+func TestSyncDueSkillsToAgent_PodUnavailable(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	kube := testutil.NewFakeKube(t) // the agent's pod does not exist
+	svc := skill.NewSyncService(db, kube.Manager, nil)
+	expectInstallations(mock, nil)
+
+	err := svc.SyncDueSkillsToAgent(context.Background(), "7", agentID)
+
+	// The maintenance job skips the agent; nothing is recorded against the
+	// skill, so its retry schedule is left alone.
+	assert.ErrorIs(t, err, skill.ErrPodUnavailable)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncDueSkillsToAgent_DefersUntilRetry(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	kube := testutil.NewFakeKube(t)
+	kube.SetPod("claude-code-7-3-0", "10.0.0.3")
+	var mu sync.Mutex
+	var commands []string
+	kube.HandleExec(func(_ string, cmd []string, _ io.Reader, stdout, _ io.Writer) error {
+		line := strings.Join(cmd, " ")
+		mu.Lock()
+		commands = append(commands, line)
+		mu.Unlock()
+		if strings.HasPrefix(line, "sh -c test -d /root/.claude/skills") {
+			io.WriteString(stdout, "present\n")
+		}
+		return nil
+	})
+	svc := skill.NewSyncService(db, kube.Manager, nil)
+	expectInstallations(mock, time.Now().Add(time.Hour))
+
+	require.NoError(t, svc.SyncDueSkillsToAgent(context.Background(), "7", agentID))
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, c := range commands {
+		assert.NotContains(t, c, "/root/.claude/skills/review", "a deferred skill is not synced")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaySyncEvents_StoredFailures(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	svc := skill.NewSyncService(db, nil, nil)
+	mock.ExpectQuery(`FROM "agent_skills" AS "as" LEFT JOIN "skills" AS "skill" .*WHERE \("as".agent_id = 3\) AND \("as".sync_error <> ''\) ` +
+		`AND \("as".agent_id IN \(SELECT id FROM agents WHERE created_by = 7\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "agent_id", "skill_id", "sync_error", "sync_attempts", "skill__id", "skill__name", "skill__command_name"}).
+			AddRow(11, agentID, skillID, "bundle not found", 2, skillID, "Review", "review"))
+
+	events, err := svc.ReplaySyncEvents(context.Background(), ownerID, agentID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []skill.SkillSyncEvent{{
+		Type: "skill_sync", Action: "error", SkillID: skillID, SkillName: "Review", CommandName: "review",
+		AgentID: agentID, Step: "syncing_skill", Message: "Failed to sync Review: bundle not found", Replay: true,
+	}}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaySyncEvents_QueryFails(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	svc := skill.NewSyncService(db, nil, nil)
+	mock.ExpectQuery(`FROM "agent_skills"`).WillReturnError(errors.New("connection reset"))
+
+	events, err := svc.ReplaySyncEvents(context.Background(), ownerID, agentID)
+
+	assert.Error(t, err)
+	assert.Empty(t, events)
+}
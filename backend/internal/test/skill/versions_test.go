@@ -0,0 +1,197 @@
+package skill_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/skill"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+)
+
+const (
+	ownerID = 7
+	otherID = 8
+	skillID = 5
+)
+
+// newSkillServer returns a skill server without storage or a cluster.
+func newSkillServer(t *testing.T) (*skill.Server, sqlmock.Sqlmock) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	return skill.NewServer(db, skill.NewSyncService(db, nil, nil)), mock
+}
+
+func asUser(userID int64) context.Context {
+	return testutil.WithUser(context.Background(), userID, "user")
+}
+
+// skillRow is skill 5 owned by user 7 at the given version.
+func skillRow(version int, published any, public bool) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "command_name", "prompt", "frontmatter", "created_by", "is_public", "version", "published_version"}).
+		AddRow(skillID, "Review", "review", "current prompt", "{}", ownerID, public, version, published)
+}
+
+func versionRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "skill_id", "version", "skill_md", "prompt", "frontmatter", "files", "bundle_key", "note"})
+}
+
+func TestDiffSkillVersions_SkillMDAndFiles(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.MatchExpectationsInOrder(true)
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) AND \(version <= 3\) AND \(version = 1\)`).
+		WillReturnRows(versionRows().AddRow(1, skillID, 1, "---\nname: review\n---\nCheck style.\n", "", "{}",
+			`[{"filepath":"a.md","checksum":"1","size":10},{"filepath":"b.md","checksum":"2","size":20}]`, "", ""))
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) AND \(version <= 3\) AND \(version = 3\)`).
+		WillReturnRows(versionRows().AddRow(3, skillID, 3, "---\nname: review\n---\nCheck style and tests.\n", "", "{}",
+			`[{"filepath":"a.md","checksum":"1b","size":12},{"filepath":"c.md","checksum":"3","size":30}]`, "", ""))
+
+	diff, err := srv.DiffSkillVersions(asUser(ownerID), &sacv1.DiffSkillVersionsRequest{Id: skillID, FromVersion: 1, ToVersion: 3})
+	require.NoError(t, err)
+
+	assert.True(t, diff.SkillMdChanged)
+	assert.Contains(t, diff.SkillMdDiff, "--- v1/SKILL.md\n+++ v3/SKILL.md\n")
+	assert.Contains(t, diff.SkillMdDiff, "-Check style.\n+Check style and tests.\n")
+
+	changes := map[string]string{}
+	for _, f := range diff.Files {
+		changes[f.Filepath] = f.Change
+	}
+	assert.Equal(t, map[string]string{"a.md": "changed", "b.md": "removed", "c.md": "added"}, changes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiffSkillVersions_HidesUnpublishedFromOthers(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	// Published at v2; v3 is a draft only the owner sees.
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, 2, true))
+	mock.ExpectQuery(`FROM "skill_reviews"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) AND \(version <= 2\) AND \(version = 1\)`).
+		WillReturnRows(versionRows().AddRow(1, skillID, 1, "v1\n", "", "{}", "[]", "", ""))
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) AND \(version <= 2\) AND \(version = 3\)`).
+		WillReturnRows(versionRows())
+
+	_, err := srv.DiffSkillVersions(asUser(otherID), &sacv1.DiffSkillVersionsRequest{Id: skillID, FromVersion: 1, ToVersion: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiffSkillVersions_RequiresFromVersion(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+
+	_, err := srv.DiffSkillVersions(asUser(ownerID), &sacv1.DiffSkillVersionsRequest{Id: skillID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRollbackSkill_RestoresPromptAndRecordsVersion(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_versions" AS "sv" WHERE \(skill_id = 5\) AND \(version <= 3\) AND \(version = 1\)`).
+		WillReturnRows(versionRows().AddRow(1, skillID, 1, "", "old prompt", "{}", "[]", "", ""))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \(skill_id = 5\)`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "skills" .* SET prompt = 'old prompt', frontmatter = .* WHERE \(id = 5\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// The rebuild bumps the version and records the rollback as v4.
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \(skill_id = 5\) ORDER BY`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`UPDATE "skills" .* SET content_checksum = .*version = version \+ 1.* RETURNING version`).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+	mock.ExpectQuery(`INSERT INTO "skill_versions" .*, 4, .*'Rolled back to version 1'.*ON CONFLICT \(skill_id, version\) DO NOTHING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(sk.id = 5\)`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "prompt", "created_by", "version"}).AddRow(skillID, "Review", "old prompt", ownerID, 4))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \("sf"."skill_id" IN \(5\)\)`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	got, err := srv.RollbackSkill(asUser(ownerID), &sacv1.RollbackSkillRequest{Id: skillID, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "old prompt", got.Prompt)
+	assert.EqualValues(t, 4, got.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackSkill_FilesNoLongerAvailable(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	// A seeded revision with a file but no stored bundle.
+	mock.ExpectQuery(`FROM "skill_versions"`).
+		WillReturnRows(versionRows().AddRow(1, skillID, 1, "", "old prompt", "{}", `[{"filepath":"a.md","checksum":"1","size":10}]`, "", ""))
+	mock.ExpectQuery(`FROM "skill_files"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := srv.RollbackSkill(asUser(ownerID), &sacv1.RollbackSkillRequest{Id: skillID, Version: 1})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "Version 1 cannot be restored")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackSkill_FailedRestoreKeepsLiveFiles(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	store := testutil.NewMemStorage()
+	store.Put("skills/5/a.md", []byte("current"))
+	store.Put("skills/5/versions/1/bundle.tar", makeTar(t, archiveFile{"SKILL.md", "old prompt"}, archiveFile{"a.md", "restored"}))
+	srv := skill.NewServer(db, skill.NewSyncService(db, nil, storage.NewStaticProvider(store)))
+
+	mock.ExpectQuery(`FROM "skills" AS "sk" WHERE \(id = 5\)`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_versions"`).WillReturnRows(versionRows().AddRow(1, skillID, 1, "", "old prompt", "{}",
+		`[{"filepath":"a.md","checksum":"`+md5Hex("restored")+`","size":8}]`, "skills/5/versions/1/bundle.tar", ""))
+	mock.ExpectQuery(`FROM "skill_files" AS "sf" WHERE \(skill_id = 5\)`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "skill_id", "filepath", "s3_key", "checksum", "size"}).
+			AddRow(20, skillID, "a.md", "skills/5/a.md", md5Hex("current"), 7))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "skill_files" .*'a.md'.*ON CONFLICT \(skill_id, filepath\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectExec(`UPDATE "skills"`).WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	_, err := srv.RollbackSkill(asUser(ownerID), &sacv1.RollbackSkillRequest{Id: skillID, Version: 1})
+
+	require.Error(t, err)
+	live, _ := store.Get("skills/5/a.md")
+	assert.Equal(t, "current", string(live), "the live file is only replaced once the restore commits")
+	assert.ElementsMatch(t, []string{"skills/5/a.md", "skills/5/versions/1/bundle.tar"}, store.Keys(), "staged files are removed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackSkill_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  int64
+		version int32
+		code    codes.Code
+	}{
+		{"not the owner", otherID, 1, codes.PermissionDenied},
+		{"missing version", ownerID, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, mock := newSkillServer(t)
+			mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+
+			_, err := srv.RollbackSkill(asUser(tt.userID), &sacv1.RollbackSkillRequest{Id: skillID, Version: tt.version})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestRollbackSkill_UnknownVersion(t *testing.T) {
+	srv, mock := newSkillServer(t)
+	mock.ExpectQuery(`FROM "skills"`).WillReturnRows(skillRow(3, nil, false))
+	mock.ExpectQuery(`FROM "skill_versions" .*\(version <= 3\) AND \(version = 9\)`).WillReturnRows(versionRows())
+
+	_, err := srv.RollbackSkill(asUser(ownerID), &sacv1.RollbackSkillRequest{Id: skillID, Version: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,50 @@
+package subagent_test
+
+import (
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/subagent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	sa := &models.Subagent{
+		Name:         " code-reviewer ",
+		Description:  "Reviews diffs",
+		Tools:        []string{"Read", " Grep ", "Read", ""},
+		SystemPrompt: "You review code.",
+	}
+	require.NoError(t, subagent.Validate(sa))
+	assert.Equal(t, "code-reviewer", sa.Name)
+	assert.Equal(t, []string{"Read", "Grep"}, sa.Tools)
+
+	bad := []models.Subagent{
+		{Name: "Code Reviewer", Description: "d", SystemPrompt: "p"},
+		{Name: "general-purpose", Description: "d", SystemPrompt: "p"},
+		{Name: "x", SystemPrompt: "p"},
+		{Name: "x", Description: "d"},
+		{Name: "x", Description: "d", SystemPrompt: "p", Model: "gpt-4"},
+		{Name: "x", Description: "d", SystemPrompt: "p", Tools: []string{"Read, Write"}},
+	}
+	for i := range bad {
+		assert.Error(t, subagent.Validate(&bad[i]), bad[i].Name)
+	}
+}
+
+func TestRender(t *testing.T) {
+	md := subagent.Render(&models.Subagent{
+		Name:         "code-reviewer",
+		Description:  "Reviews diffs: use after edits",
+		Tools:        []string{"Read", "Grep"},
+		Model:        "sonnet",
+		SystemPrompt: "You review code.\n\n",
+	})
+	assert.Equal(t, "---\nname: code-reviewer\ndescription: 'Reviews diffs: use after edits'\ntools: Read, Grep\nmodel: sonnet\n---\n\nYou review code.\n", md)
+}
+
+func TestIsBuiltin(t *testing.T) {
+	assert.True(t, subagent.IsBuiltin("general-purpose"))
+	assert.False(t, subagent.IsBuiltin("code-reviewer"))
+}
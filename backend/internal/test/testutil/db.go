@@ -0,0 +1,35 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+)
+
+// NewMockDB returns a bun DB backed by sqlmock. Expected statements are
+// matched as regular expressions, in any order, since most code under test
+// issues some of its queries from background goroutines.
+func NewMockDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock, func()) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	mock.MatchExpectationsInOrder(false)
+
+	db := bun.NewDB(sqlDB, pgdialect.New())
+	return db, mock, func() { db.Close() }
+}
+
+// WithUser returns a context carrying the authenticated user the way the
+// gateway's auth interceptor sets it up.
+func WithUser(ctx context.Context, userID int64, role string) context.Context {
+	ctx = context.WithValue(ctx, ctxkeys.UserIDKey, userID)
+	return context.WithValue(ctx, ctxkeys.RoleKey, role)
+}
@@ -0,0 +1,252 @@
+package testutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/apimachinery/pkg/util/remotecommand"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"g.echo.tech/dev/sac/internal/container"
+)
+
+// KubeNamespace is the namespace the fake cluster serves.
+const KubeNamespace = "sac-test"
+
+// ExecFunc handles a command run in a fake pod. Returning an error makes the
+// exec fail the way a non-zero exit does.
+type ExecFunc func(pod string, cmd []string, stdin io.Reader, stdout, stderr io.Writer) error
+
+// FakeKube is a minimal Kubernetes API server for container.Manager: it
+// serves pod lookups, pod exec (SPDY, protocol v4) and StatefulSet scale,
+// which is all the task, upload, pod file and idle paths need.
+type FakeKube struct {
+	Manager *container.Manager
+
+	mu     sync.Mutex
+	pods   map[string]string // pod name -> IP
+	scales map[string]int32  // statefulset name -> replicas
+	exec   ExecFunc
+}
+
+// NewFakeKube starts a fake API server and a container.Manager talking to it.
+func NewFakeKube(t *testing.T) *FakeKube {
+	t.Helper()
+	t.Setenv("KUBERNETES_SERVICE_HOST", "") // never pick up an in-cluster config
+
+	k := &FakeKube{pods: make(map[string]string), scales: make(map[string]int32)}
+	srv := httptest.NewServer(http.HandlerFunc(k.serve))
+	t.Cleanup(srv.Close)
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	cfg := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: fake
+  cluster:
+    server: %s
+contexts:
+- name: fake
+  context:
+    cluster: fake
+    user: fake
+current-context: fake
+users:
+- name: fake
+  user:
+    token: fake
+`, srv.URL)
+	if err := os.WriteFile(kubeconfig, []byte(cfg), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+
+	m, err := container.NewManager(kubeconfig, KubeNamespace, "", "claude-code:test")
+	if err != nil {
+		t.Fatalf("failed to create container manager: %v", err)
+	}
+	k.Manager = m
+	return k
+}
+
+// SetPod registers a running pod with the given IP.
+func (k *FakeKube) SetPod(name, ip string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.pods[name] = ip
+}
+
+// SetStatefulSet registers a StatefulSet with the given replica count.
+func (k *FakeKube) SetStatefulSet(name string, replicas int32) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.scales[name] = replicas
+}
+
+// Replicas returns the current replica count of a StatefulSet, or -1 if it
+// does not exist.
+func (k *FakeKube) Replicas(name string) int32 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	n, ok := k.scales[name]
+	if !ok {
+		return -1
+	}
+	return n
+}
+
+// HandleExec installs the handler for pod exec requests.
+func (k *FakeKube) HandleExec(fn ExecFunc) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.exec = fn
+}
+
+func (k *FakeKube) serve(w http.ResponseWriter, r *http.Request) {
+	stsPrefix := "/apis/apps/v1/namespaces/" + KubeNamespace + "/statefulsets/"
+	if rest, ok := strings.CutPrefix(r.URL.Path, stsPrefix); ok {
+		if name, ok := strings.CutSuffix(rest, "/scale"); ok {
+			k.serveScale(w, r, name)
+			return
+		}
+	}
+
+	prefix := "/api/v1/namespaces/" + KubeNamespace + "/pods/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Resource: r.URL.Path}, ""))
+		return
+	}
+	name, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	k.mu.Lock()
+	ip, ok := k.pods[name]
+	exec := k.exec
+	k.mu.Unlock()
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, name))
+		return
+	}
+
+	switch {
+	case sub == "" && r.Method == http.MethodGet:
+		pod := corev1.Pod{
+			TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: KubeNamespace},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(pod)
+	case sub == "exec":
+		serveExec(w, r, name, exec)
+	default:
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Resource: "pods/" + sub}, name))
+	}
+}
+
+func (k *FakeKube) serveScale(w http.ResponseWriter, r *http.Request, name string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	replicas, ok := k.scales[name]
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, name))
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		// client-go sends protobuf or JSON depending on its content config.
+		body, _ := io.ReadAll(r.Body)
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(body, nil, nil)
+		scale, ok := obj.(*autoscalingv1.Scale)
+		if err != nil || !ok {
+			writeStatus(w, apierrors.NewBadRequest(fmt.Sprintf("invalid scale body: %v", err)))
+			return
+		}
+		replicas = scale.Spec.Replicas
+		k.scales[name] = replicas
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(autoscalingv1.Scale{
+		TypeMeta:   metav1.TypeMeta{Kind: "Scale", APIVersion: "autoscaling/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: KubeNamespace},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
+	})
+}
+
+func writeStatus(w http.ResponseWriter, err *apierrors.StatusError) {
+	status := err.Status()
+	status.Kind, status.APIVersion = "Status", "v1"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(status.Code))
+	json.NewEncoder(w).Encode(status)
+}
+
+// serveExec speaks the server side of the SPDY remotecommand protocol.
+func serveExec(w http.ResponseWriter, r *http.Request, pod string, exec ExecFunc) {
+	if _, err := httpstream.Handshake(r, w, []string{remotecommand.StreamProtocolV4Name}); err != nil {
+		return
+	}
+
+	expected := 3 // error, stdout, stderr
+	if r.URL.Query().Get("stdin") == "true" {
+		expected++
+	}
+	streams := make(chan httpstream.Stream, expected)
+	conn := spdy.NewResponseUpgrader().UpgradeResponse(w, r, func(s httpstream.Stream, _ <-chan struct{}) error {
+		streams <- s
+		return nil
+	})
+	if conn == nil {
+		return
+	}
+	defer conn.Close()
+
+	var errStream, stdin, stdout, stderr httpstream.Stream
+	for i := 0; i < expected; i++ {
+		s := <-streams
+		switch s.Headers().Get(corev1.StreamType) {
+		case corev1.StreamTypeError:
+			errStream = s
+		case corev1.StreamTypeStdin:
+			stdin = s
+		case corev1.StreamTypeStdout:
+			stdout = s
+		case corev1.StreamTypeStderr:
+			stderr = s
+		}
+	}
+
+	var in io.Reader = strings.NewReader("")
+	if stdin != nil {
+		in = stdin
+	}
+	err := errors.New("no exec handler installed")
+	if exec != nil {
+		err = exec(pod, r.URL.Query()["command"], in, stdout, stderr)
+	}
+
+	status := metav1.Status{Status: metav1.StatusSuccess}
+	if err != nil {
+		status = metav1.Status{Status: metav1.StatusFailure, Message: err.Error(), Reason: metav1.StatusReasonInternalError}
+	}
+	data, _ := json.Marshal(status)
+	errStream.Write(data)
+	stdout.Close()
+	stderr.Close()
+	errStream.Close()
+}
@@ -0,0 +1,147 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/storage"
+)
+
+// MemStorage is an in-memory storage.StorageBackend.
+type MemStorage struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+var _ storage.StorageBackend = (*MemStorage)(nil)
+
+// NewMemStorage returns an empty in-memory storage backend.
+func NewMemStorage() *MemStorage {
+	return &MemStorage{objects: make(map[string][]byte)}
+}
+
+// Put stores an object directly.
+func (m *MemStorage) Put(key string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = append([]byte(nil), data...)
+}
+
+// Get returns a stored object and whether it exists.
+func (m *MemStorage) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	return data, ok
+}
+
+// Keys returns the sorted keys of all stored objects.
+func (m *MemStorage) Keys() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0, len(m.objects))
+	for k := range m.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *MemStorage) Upload(_ context.Context, key string, reader io.Reader, size int64, _ string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && int64(len(data)) != size {
+		return fmt.Errorf("upload %s: got %d bytes, want %d", key, len(data), size)
+	}
+	m.Put(key, data)
+	return nil
+}
+
+func (m *MemStorage) Download(_ context.Context, key string) (io.ReadCloser, error) {
+	data, ok := m.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("object %s not found", key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *MemStorage) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *MemStorage) DeletePrefix(_ context.Context, prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k := range m.objects {
+		if strings.HasPrefix(k, prefix) {
+			delete(m.objects, k)
+		}
+	}
+	return nil
+}
+
+func (m *MemStorage) List(ctx context.Context, prefix, delimiter string, _ int) ([]storage.ObjectInfo, error) {
+	all, _ := m.ListAll(ctx, prefix)
+	if delimiter == "" {
+		return all, nil
+	}
+	var items []storage.ObjectInfo
+	seen := map[string]bool{}
+	for _, o := range all {
+		rest := strings.TrimPrefix(o.Key, prefix)
+		if i := strings.Index(rest, delimiter); i >= 0 {
+			dir := prefix + rest[:i+len(delimiter)]
+			if !seen[dir] {
+				seen[dir] = true
+				items = append(items, storage.ObjectInfo{Key: dir, IsDirectory: true})
+			}
+			continue
+		}
+		items = append(items, o)
+	}
+	return items, nil
+}
+
+func (m *MemStorage) ListAll(_ context.Context, prefix string) ([]storage.ObjectInfo, error) {
+	var items []storage.ObjectInfo
+	for _, k := range m.Keys() {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		data, _ := m.Get(k)
+		items = append(items, storage.ObjectInfo{Key: k, Size: int64(len(data)), LastModified: time.Now()})
+	}
+	return items, nil
+}
+
+func (m *MemStorage) GeneratePresignedURL(_ context.Context, key string, _ time.Duration) (string, error) {
+	return "https://storage.test/" + key, nil
+}
+
+func (m *MemStorage) Copy(_ context.Context, srcKey, destKey string) error {
+	data, ok := m.Get(srcKey)
+	if !ok {
+		return fmt.Errorf("object %s not found", srcKey)
+	}
+	m.Put(destKey, data)
+	return nil
+}
+
+func (m *MemStorage) GetObjectSize(_ context.Context, key string) (int64, error) {
+	data, ok := m.Get(key)
+	if !ok {
+		return 0, fmt.Errorf("object %s not found", key)
+	}
+	return int64(len(data)), nil
+}
@@ -0,0 +1,149 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+	"g.echo.tech/dev/sac/internal/webhook"
+)
+
+const secret = "s3cret"
+
+// fakeDispatcher records the tasks a delivery starts.
+type fakeDispatcher struct {
+	mu    sync.Mutex
+	tasks []*models.AgentTask
+}
+
+func (d *fakeDispatcher) DispatchTask(_ context.Context, task *models.AgentTask) (*models.AgentTask, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	task.TaskID = "task-1"
+	task.Status = models.TaskStatusPending
+	d.tasks = append(d.tasks, task)
+	return task, nil
+}
+
+func webhookRow(template string, limit int) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "agent_id", "token", "secret", "signature_format", "prompt_template", "allowed_tools", "timeout_seconds", "rate_limit_per_hour", "enabled"}).
+		AddRow(4, 7, 3, "tok", secret, models.WebhookSignatureHMACSHA256, template, "{}", 600, limit, true)
+}
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliver(h *webhook.Handler, body []byte, signature string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/hooks/tok", bytes.NewReader(body))
+	c.Request.Header.Set("X-Signature", signature)
+	c.Request.Header.Set("X-Event-Type", "push")
+	c.Params = gin.Params{{Key: "token", Value: "tok"}}
+	h.Receive(c)
+	return w
+}
+
+// expectClaim expects the locked rate-limit check; accepted tells whether
+// the count is still under the limit.
+func expectClaim(mock sqlmock.Sqlmock, recent int, accepted bool) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "wh"."id" FROM "agent_webhooks" AS "wh" WHERE \(id = 4\) FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "webhook_deliveries" .*status = 'accepted'`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(recent))
+	if accepted {
+		mock.ExpectQuery(`INSERT INTO "webhook_deliveries" .*'accepted', 202`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	}
+	mock.ExpectCommit()
+}
+
+func TestReceive_ClaimsSlotBeforeDispatch(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.MatchExpectationsInOrder(true)
+	dispatcher := &fakeDispatcher{}
+	h := webhook.NewHandler(db, dispatcher)
+
+	body := []byte(`{"ref":"main","commit":{"msg":"say <no value> please"}}`)
+	mock.ExpectQuery(`FROM "agent_webhooks"`).
+		WillReturnRows(webhookRow(`Build {{.payload.ref}}{{.payload.missing}}: {{.payload.commit.msg}} ({{.event}})`, 10))
+	expectClaim(mock, 9, true)
+	mock.ExpectExec(`UPDATE "webhook_deliveries" .*SET "status" = 'accepted', "status_code" = 202, "error" = '', "task_id" = 'task-1' WHERE .*"id" = 11`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	w := deliver(h, body, sign(body))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.JSONEq(t, `{"task_id":"task-1","delivery_id":11}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, dispatcher.tasks, 1)
+	// Missing keys render empty; literal payload text is left alone.
+	assert.Equal(t, "Build main: say <no value> please (push)", dispatcher.tasks[0].Prompt)
+}
+
+func TestReceive_RateLimited(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	mock.MatchExpectationsInOrder(true)
+	dispatcher := &fakeDispatcher{}
+	h := webhook.NewHandler(db, dispatcher)
+
+	body := []byte(`{}`)
+	mock.ExpectQuery(`FROM "agent_webhooks"`).WillReturnRows(webhookRow("run", 10))
+	expectClaim(mock, 10, false)
+	mock.ExpectQuery(`INSERT INTO "webhook_deliveries" .*'rate_limited', 429`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+
+	w := deliver(h, body, sign(body))
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Empty(t, dispatcher.tasks)
+}
+
+func TestReceive_BadSignatureLoggingIsThrottled(t *testing.T) {
+	db, mock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	dispatcher := &fakeDispatcher{}
+	h := webhook.NewHandler(db, dispatcher)
+
+	const attempts, logged = 30, 20
+	for i := 0; i < attempts; i++ {
+		mock.ExpectQuery(`FROM "agent_webhooks"`).WillReturnRows(webhookRow("run", 10))
+	}
+	for i := 0; i < logged; i++ {
+		mock.ExpectQuery(`INSERT INTO "webhook_deliveries" .*'rejected', 401`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i + 1))
+	}
+
+	for i := 0; i < attempts; i++ {
+		w := deliver(h, []byte(`{}`), "sha256=00")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Nothing beyond the limit was written: a further insert would have
+	// consumed this expectation.
+	mock.ExpectQuery(`INSERT INTO "webhook_deliveries"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(99))
+	assert.Error(t, mock.ExpectationsWereMet())
+	assert.Empty(t, dispatcher.tasks)
+}
@@ -0,0 +1,281 @@
+package websocket_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/auth"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+	wsproxy "g.echo.tech/dev/sac/internal/websocket"
+)
+
+const (
+	testSessionID = "sess-1"
+	ownerID       = 1
+	guestID       = 2
+)
+
+// fakeTtyd is a stand-in for the ttyd server in a session pod. It records
+// terminal input and lets the test push terminal output.
+type fakeTtyd struct {
+	srv    *httptest.Server
+	input  chan string
+	output chan string
+}
+
+func newFakeTtyd(t *testing.T) *fakeTtyd {
+	f := &fakeTtyd{input: make(chan string, 64), output: make(chan string, 64)}
+	upgrader := websocket.Upgrader{Subprotocols: []string{"tty"}}
+	f.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		go func() {
+			for out := range f.output {
+				if conn.WriteMessage(websocket.BinaryMessage, append([]byte{'0'}, out...)) != nil {
+					return
+				}
+			}
+		}()
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			// Only INPUT frames; the auth handshake and resizes are noise here.
+			if len(msg) > 0 && msg[0] == '0' {
+				f.input <- string(msg[1:])
+			}
+		}
+	}))
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+type testEnv struct {
+	t     *testing.T
+	mock  sqlmock.Sqlmock
+	proxy *wsproxy.ProxyHandler
+	srv   *httptest.Server
+	ttyd  *fakeTtyd
+	jwt   *auth.JWTService
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	gin.SetMode(gin.TestMode)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+
+	ttyd := newFakeTtyd(t)
+	u, _ := url.Parse(ttyd.srv.URL)
+	port, _ := strconv.Atoi(u.Port())
+
+	jwt := auth.NewJWTService("test-secret")
+	proxy := wsproxy.NewProxyHandler(db, jwt)
+	proxy.SetTtydPort(port)
+
+	router := gin.New()
+	router.GET("/ws/:sessionId", proxy.HandleWebSocket)
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	return &testEnv{t: t, mock: mock, proxy: proxy, srv: srv, ttyd: ttyd, jwt: jwt}
+}
+
+// expectGrant queues the answer to the next share grant lookup; an empty
+// mode means no grant.
+func (e *testEnv) expectGrant(mode string) {
+	rows := sqlmock.NewRows([]string{"mode"})
+	if mode != "" {
+		rows.AddRow(mode)
+	}
+	e.mock.ExpectQuery(`FROM "session_shares"`).WillReturnRows(rows)
+}
+
+// expectSession queues the answer to the next session lookup.
+func (e *testEnv) expectSession() {
+	e.mock.ExpectQuery(`FROM "sessions" AS "s"`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_id", "agent_id", "session_id", "pod_ip", "status"}).
+			AddRow(10, ownerID, 5, testSessionID, "127.0.0.1", "running"))
+}
+
+// connect attaches a user's terminal to the test session.
+func (e *testEnv) connect(userID int64, username string) *websocket.Conn {
+	e.t.Helper()
+	e.expectSession()
+
+	token, err := e.jwt.GenerateToken(userID, username, "user")
+	require.NoError(e.t, err)
+	wsURL := "ws" + strings.TrimPrefix(e.srv.URL, "http") + "/ws/" + testSessionID + "?token=" + token
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(e.t, err)
+	e.t.Cleanup(func() { conn.Close() })
+
+	require.NoError(e.t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"resize","columns":80,"rows":24}`)))
+	// The first presence frame confirms the client joined the hub.
+	readUntil(e.t, conn, func(msgType int, data []byte) bool {
+		return msgType == websocket.TextMessage && strings.Contains(string(data), `"presence"`)
+	})
+	return conn
+}
+
+type presence struct {
+	Participants []struct {
+		UserID int64  `json:"user_id"`
+		Role   string `json:"role"`
+	} `json:"participants"`
+}
+
+// readUntil reads frames until match returns true and fails the test on
+// timeout or a read error.
+func readUntil(t *testing.T, conn *websocket.Conn, match func(int, []byte) bool) []byte {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	for {
+		msgType, data, err := conn.ReadMessage()
+		require.NoError(t, err)
+		if match(msgType, data) {
+			return data
+		}
+	}
+}
+
+func readPresence(t *testing.T, conn *websocket.Conn, match func(presence) bool) presence {
+	t.Helper()
+	var p presence
+	readUntil(t, conn, func(msgType int, data []byte) bool {
+		if msgType != websocket.TextMessage || !strings.Contains(string(data), `"presence"`) {
+			return false
+		}
+		p = presence{}
+		return json.Unmarshal(data, &p) == nil && match(p)
+	})
+	return p
+}
+
+func roleOf(p presence, userID int64) string {
+	for _, part := range p.Participants {
+		if part.UserID == userID {
+			return part.Role
+		}
+	}
+	return ""
+}
+
+func expectInput(t *testing.T, f *fakeTtyd, want string) {
+	t.Helper()
+	select {
+	case got := <-f.input:
+		assert.Equal(t, want, got)
+	case <-time.After(3 * time.Second):
+		t.Fatalf("ttyd did not receive %q", want)
+	}
+}
+
+func expectNoInput(t *testing.T, f *fakeTtyd) {
+	t.Helper()
+	select {
+	case got := <-f.input:
+		t.Fatalf("ttyd received unexpected input %q", got)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestHub_FanOutReachesEveryClient(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+	e.expectGrant("read_only")
+	viewer := e.connect(guestID, "guest")
+
+	e.ttyd.output <- "hello"
+
+	for _, conn := range []*websocket.Conn{owner, viewer} {
+		data := readUntil(t, conn, func(msgType int, _ []byte) bool { return msgType == websocket.BinaryMessage })
+		assert.Equal(t, "hello", string(data))
+	}
+}
+
+func TestHub_ViewerInputIsDropped(t *testing.T) {
+	e := newTestEnv(t)
+	e.connect(ownerID, "owner")
+	e.expectGrant("read_only")
+	viewer := e.connect(guestID, "guest")
+
+	require.NoError(t, viewer.WriteMessage(websocket.TextMessage, []byte("rm -rf /")))
+	expectNoInput(t, e.ttyd)
+}
+
+func TestHub_InputArbitration(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+	e.expectGrant("read_write")
+	editor := e.connect(guestID, "guest")
+
+	// Nobody holds control, so the editor may type.
+	require.NoError(t, editor.WriteMessage(websocket.TextMessage, []byte("ls")))
+	expectInput(t, e.ttyd, "ls")
+
+	// The owner always takes over immediately...
+	require.NoError(t, owner.WriteMessage(websocket.TextMessage, []byte("pwd")))
+	expectInput(t, e.ttyd, "pwd")
+
+	// ...and keeps the keyboard while active.
+	require.NoError(t, editor.WriteMessage(websocket.TextMessage, []byte("whoami")))
+	expectNoInput(t, e.ttyd)
+}
+
+func TestHub_RevokedGrantDisconnectsClient(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+	e.expectGrant("read_write")
+	editor := e.connect(guestID, "guest")
+
+	e.expectGrant("")
+	e.proxy.RecheckAccess(context.Background(), testSessionID)
+
+	data := readUntil(t, editor, func(msgType int, data []byte) bool {
+		return msgType == websocket.TextMessage && !strings.Contains(string(data), `"presence"`)
+	})
+	assert.Contains(t, string(data), "revoked")
+
+	editor.SetReadDeadline(time.Now().Add(3 * time.Second))
+	_, _, err := editor.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "expected policy violation close, got %v", err)
+
+	p := readPresence(t, owner, func(p presence) bool { return len(p.Participants) == 1 })
+	assert.Equal(t, "owner", roleOf(p, ownerID))
+}
+
+func TestHub_DowngradedEditorLosesKeyboard(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+	e.expectGrant("read_write")
+	editor := e.connect(guestID, "guest")
+
+	require.NoError(t, editor.WriteMessage(websocket.TextMessage, []byte("ls")))
+	expectInput(t, e.ttyd, "ls")
+
+	e.expectGrant("read_only")
+	e.proxy.RecheckAccess(context.Background(), testSessionID)
+
+	p := readPresence(t, owner, func(p presence) bool { return roleOf(p, guestID) == "viewer" })
+	assert.Equal(t, "viewer", roleOf(p, guestID))
+
+	require.NoError(t, editor.WriteMessage(websocket.TextMessage, []byte("whoami")))
+	expectNoInput(t, e.ttyd)
+}
@@ -0,0 +1,129 @@
+package websocket_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dial opens a terminal WebSocket without completing the attach handshake.
+func (e *testEnv) dial(userID int64, username string) *websocket.Conn {
+	e.t.Helper()
+	token, err := e.jwt.GenerateToken(userID, username, "user")
+	require.NoError(e.t, err)
+	wsURL := "ws" + strings.TrimPrefix(e.srv.URL, "http") + "/ws/" + testSessionID + "?token=" + token
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(e.t, err)
+	e.t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// expectRejected checks that the proxy explains the rejection in the
+// terminal and closes with the given code.
+func expectRejected(t *testing.T, conn *websocket.Conn, text string, code int) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), text)
+
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, code), "expected close %d, got %v", code, err)
+}
+
+func TestLimits_PerUserCap(t *testing.T) {
+	e := newTestEnv(t)
+	e.proxy.SetLimits(0, 1)
+	e.connect(ownerID, "owner")
+
+	expectRejected(t, e.dial(ownerID, "owner"), "Too many open terminals", websocket.ClosePolicyViolation)
+
+	// Another user is not affected by the owner's cap.
+	e.expectGrant("read_only")
+	e.connect(guestID, "guest")
+}
+
+func TestLimits_GlobalCapReleasesOnClose(t *testing.T) {
+	e := newTestEnv(t)
+	e.proxy.SetLimits(1, 0)
+	owner := e.connect(ownerID, "owner")
+
+	expectRejected(t, e.dial(guestID, "guest"), "Too many open terminals", websocket.ClosePolicyViolation)
+
+	// Closing the owner's tab frees the slot for the next connection.
+	require.NoError(t, owner.Close())
+	e.expectSession()
+	e.expectGrant("read_only")
+	require.Eventually(t, func() bool {
+		conn := e.dial(guestID, "guest")
+		defer conn.Close()
+		// An accepted client waits silently for its initial resize message;
+		// a rejected one is told at once.
+		conn.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+		_, _, err := conn.ReadMessage()
+		var ne net.Error
+		return errors.As(err, &ne) && ne.Timeout()
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestLimits_DrainClosesTerminalsAndRefusesNew(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	e.proxy.Drain(ctx)
+
+	owner.SetReadDeadline(time.Now().Add(3 * time.Second))
+	for {
+		_, _, err := owner.ReadMessage()
+		if err != nil {
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseServiceRestart), "expected service restart close, got %v", err)
+			break
+		}
+	}
+
+	expectRejected(t, e.dial(guestID, "guest"), "Server is restarting", websocket.CloseServiceRestart)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/health", nil)
+	e.proxy.HealthCheck(c)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "draining")
+}
+
+func TestLimits_DrainReturnsOnceTerminalsClose(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.connect(ownerID, "owner")
+
+	done := make(chan struct{})
+	go func() {
+		e.proxy.Drain(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Drain returned while a terminal was still open")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	require.NoError(t, owner.Close())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Drain did not return after the last terminal closed")
+	}
+}
@@ -0,0 +1,73 @@
+package workspace_test
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/test/testutil"
+	"g.echo.tech/dev/sac/internal/workspace"
+)
+
+// downloadPodFile requests a file from the live workspace of a fake pod
+// holding content at any path.
+func downloadPodFile(t *testing.T, rel, content string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	kube := testutil.NewFakeKube(t)
+	kube.SetPod("claude-code-7-3-0", "10.0.0.3")
+	kube.HandleExec(func(_ string, cmd []string, _ io.Reader, stdout, _ io.Writer) error {
+		switch script := strings.Join(cmd, " "); {
+		case strings.Contains(script, "stat -c %s"):
+			fmt.Fprintf(stdout, "f %d\n", len(content))
+		case strings.Contains(script, "cat --"):
+			io.WriteString(stdout, content)
+		}
+		return nil
+	})
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "agents" .*id = 3 AND created_by = 7`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	h := workspace.NewHandler(db, nil, nil, nil, kube.Manager)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/workspace/pod/download?agent_id=3&path="+url.QueryEscape(rel), nil)
+	c.Set("userID", int64(testUserID))
+	h.DownloadPodFile(c)
+	return w
+}
+
+func TestDownloadPodFile_Disposition(t *testing.T) {
+	tests := []struct {
+		name string
+		rel  string
+	}{
+		{"plain", "output/report.md"},
+		{"quote and semicolon", `output/a"; filename="evil.exe`},
+		{"non-ascii", "output/报告 é.txt"},
+		{"control characters", "output/a\r\nSet-Cookie: x=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := downloadPodFile(t, tt.rel, "hello")
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			assert.Equal(t, "hello", w.Body.String())
+
+			disposition, params, err := mime.ParseMediaType(w.Header().Get("Content-Disposition"))
+			require.NoError(t, err)
+			assert.Equal(t, "attachment", disposition)
+			assert.Equal(t, tt.rel[strings.LastIndex(tt.rel, "/")+1:], params["filename"])
+		})
+	}
+}
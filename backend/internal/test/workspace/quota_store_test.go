@@ -0,0 +1,189 @@
+package workspace_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g.echo.tech/dev/sac/internal/workspace"
+)
+
+const (
+	outputPrefix = "users/7/agents/3/output/"
+	reportKey    = outputPrefix + "report.txt"
+)
+
+// uploadOutput posts content to report.txt through UploadOutputFile.
+func (e *uploadEnv) uploadOutput(t *testing.T, content string) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("agent_id", "3"))
+	require.NoError(t, mw.WriteField("path", "report.txt"))
+	fw, err := mw.CreateFormFile("file", "report.txt")
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte(content))
+	require.NoError(t, mw.Close())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/workspace/output/files", &body)
+	c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+	c.Set("userID", int64(testUserID))
+	c.Set("storageBackend", e.store)
+	e.h.UploadOutputFile(c)
+	return w
+}
+
+func (e *uploadEnv) expectFileSaved() {
+	e.mock.ExpectQuery(`INSERT INTO "workspace_files" .*'` + reportKey + `'.*ON CONFLICT \(oss_key\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(31))
+}
+
+func (e *uploadEnv) expectCharged(addBytes, addFiles string) {
+	e.mock.ExpectExec(`UPDATE "workspace_quotas" AS "wq" SET used_bytes = GREATEST\(used_bytes \+ ` + addBytes + `, 0\), file_count = GREATEST\(file_count \+ ` + addFiles + `, 0\), .*WHERE \(user_id = 7 AND agent_id = 3\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestUploadOutputFile_ChargesNewFile(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectStoreCheck(reportKey, 0, 1<<30, nil)
+	e.expectFileSaved()
+	e.expectCharged("10", "1")
+	e.mock.ExpectCommit()
+
+	w := e.uploadOutput(t, "0123456789")
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	data, ok := e.store.Get(reportKey)
+	require.True(t, ok)
+	assert.Equal(t, "0123456789", string(data))
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestUploadOutputFile_OverwriteChargesDifference(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(reportKey, []byte("old"))
+	e.expectStoreCheck(reportKey, 3, 1<<30, 3)
+	e.expectFileSaved()
+	e.expectCharged("7", "0")
+	e.mock.ExpectCommit()
+
+	w := e.uploadOutput(t, "0123456789")
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	data, _ := e.store.Get(reportKey)
+	assert.Equal(t, "0123456789", string(data))
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestUploadOutputFile_OverQuotaKeepsExistingFile(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(reportKey, []byte("old"))
+	// Growing the file by 7 bytes does not fit in the 5 left.
+	e.expectStoreCheck(reportKey, 1<<30-5, 1<<30, 3)
+	e.mock.ExpectRollback()
+
+	w := e.uploadOutput(t, "0123456789")
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "quota exceeded")
+	data, _ := e.store.Get(reportKey)
+	assert.Equal(t, "old", string(data), "a refused upload never reaches storage")
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestUploadOutputFile_UnrecordedNewFileRemoved(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectStoreCheck(reportKey, 0, 1<<30, nil)
+	e.mock.ExpectQuery(`INSERT INTO "workspace_files"`).WillReturnError(errors.New("connection reset"))
+	e.mock.ExpectRollback()
+
+	w := e.uploadOutput(t, "0123456789")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, e.store.Keys())
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestUploadOutputFile_UnrecordedOverwriteKept(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(reportKey, []byte("old"))
+	e.expectStoreCheck(reportKey, 3, 1<<30, 3)
+	e.mock.ExpectQuery(`INSERT INTO "workspace_files"`).WillReturnError(errors.New("connection reset"))
+	e.mock.ExpectRollback()
+
+	w := e.uploadOutput(t, "0123456789")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	// The old record still points at the key, so the object must stay.
+	assert.Equal(t, []string{reportKey}, e.store.Keys())
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestInternalOutputDelete_ReleasesUsage(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(outputPrefix+"logs/a.log", []byte("0123456789"))
+	e.store.Put(outputPrefix+"logs/b.log", []byte("01234"))
+	e.mock.ExpectBegin()
+	e.mock.ExpectQuery(`DELETE FROM "workspace_files" AS "wf" WHERE \(user_id = 7 AND agent_id = 3 AND workspace_type = 'output' AND oss_key LIKE '` + outputPrefix + `logs/%'\) RETURNING user_id, agent_id, group_id, size_bytes`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "agent_id", "group_id", "size_bytes"}).
+			AddRow(testUserID, testAgentID, nil, 10).
+			AddRow(testUserID, testAgentID, nil, 5))
+	e.expectCharged("-15", "-2")
+	e.mock.ExpectCommit()
+
+	w := e.call(e.h.InternalOutputDelete, http.MethodPost, "/internal/output/delete",
+		strings.NewReader(`{"user_id":7,"agent_id":3,"path":"logs/"}`))
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Empty(t, e.store.Keys())
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestReconcileQuota(t *testing.T) {
+	tests := []struct {
+		name      string
+		usedBytes int64
+		fileCount int
+		changed   bool
+	}{
+		{"drifted", 40, 1, true},
+		{"in sync", 15, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newUploadEnv(t)
+			e.store.Put(inputPrefix+"input/data.csv", []byte("0123456789"))
+			e.store.Put(outputPrefix+"report.txt", []byte("01234"))
+			e.store.Put("users/7/agents/4/output/other.txt", []byte("not counted"))
+			e.expectEnsureQuota()
+			e.mock.ExpectBegin()
+			e.mock.ExpectQuery(`FROM "workspace_quotas" AS "wq" WHERE \(user_id = 7 AND agent_id = 3\) FOR UPDATE`).
+				WillReturnRows(sqlmock.NewRows([]string{"user_id", "agent_id", "used_bytes", "file_count"}).
+					AddRow(testUserID, testAgentID, tt.usedBytes, tt.fileCount))
+			if tt.changed {
+				e.mock.ExpectExec(`UPDATE "workspace_quotas" AS "wq" SET used_bytes = 15, file_count = 2, .*WHERE \(user_id = 7 AND agent_id = 3\)`).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			e.mock.ExpectCommit()
+
+			old, actual, changed, err := workspace.ReconcileQuota(context.Background(), e.db, e.store, testUserID, testAgentID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.usedBytes, old)
+			assert.EqualValues(t, 15, actual)
+			assert.Equal(t, tt.changed, changed)
+			assert.NoError(t, e.mock.ExpectationsWereMet())
+		})
+	}
+}
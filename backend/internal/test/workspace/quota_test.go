@@ -0,0 +1,37 @@
+package workspace_test
+
+import (
+	"errors"
+	"testing"
+
+	"g.echo.tech/dev/sac/internal/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckQuota(t *testing.T) {
+	// Within limits, exactly at the limit, and shrinking an over-quota workspace.
+	assert.NoError(t, workspace.CheckQuota("agent", 100, 1000, 1, 10, 900, 1))
+	assert.NoError(t, workspace.CheckQuota("agent", 2000, 1000, 20, 10, -500, 0))
+	assert.NoError(t, workspace.CheckQuota("agent", 2000, 1000, 20, 10, 0, 0))
+
+	err := workspace.CheckQuota("agent", 100, 1000, 1, 10, 901, 1)
+	var qe *workspace.QuotaExceededError
+	require.True(t, errors.As(err, &qe))
+	assert.Equal(t, int64(901), qe.AddBytes)
+	assert.Contains(t, err.Error(), "agent workspace quota exceeded")
+
+	err = workspace.CheckQuota("group", 0, 1<<30, 10, 10, 1, 1)
+	require.Error(t, err)
+	assert.Equal(t, "group workspace quota exceeded: 10 of 10 files", err.Error())
+
+	// Overwriting with a bigger file adds bytes but no file.
+	assert.Error(t, workspace.CheckQuota("agent", 900, 1000, 10, 10, 200, 0))
+	assert.NoError(t, workspace.CheckQuota("agent", 900, 1000, 10, 10, 50, 0))
+}
+
+func TestQuotaExceededMessage(t *testing.T) {
+	err := workspace.CheckQuota("agent", 1020<<20, 1<<30, 0, 1000, 5<<20, 1)
+	require.Error(t, err)
+	assert.Equal(t, "agent workspace quota exceeded: 1020.0 MiB used of 1.0 GiB, upload needs 5.0 MiB more", err.Error())
+}
@@ -0,0 +1,183 @@
+package workspace_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/auth"
+	"g.echo.tech/dev/sac/internal/grpcauth"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/internal/test/testutil"
+	"g.echo.tech/dev/sac/internal/workspace"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSharedLinkExpired(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	assert.False(t, (&models.SharedLink{}).Expired(now))
+	assert.False(t, (&models.SharedLink{ExpiresAt: &future}).Expired(now))
+	assert.True(t, (&models.SharedLink{ExpiresAt: &past}).Expired(now))
+	assert.True(t, (&models.SharedLink{ExpiresAt: &now}).Expired(now))
+
+	assert.False(t, (&models.SharedLink{MaxDownloads: 3, DownloadCount: 2}).Expired(now))
+	assert.True(t, (&models.SharedLink{MaxDownloads: 3, DownloadCount: 3}).Expired(now))
+	assert.False(t, (&models.SharedLink{MaxDownloads: 0, DownloadCount: 100}).Expired(now))
+}
+
+const shareCode = "Zq3xV8mKp2LwR7tYb4NcHd"
+
+// newShareHandler returns a handler without Redis, so password failures
+// are counted in memory, and with no storage configured.
+func newShareHandler(t *testing.T) (*workspace.Handler, sqlmock.Sqlmock) {
+	gin.SetMode(gin.TestMode)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	return workspace.NewHandler(db, storage.NewStorageProvider(db), nil, nil, nil), mock
+}
+
+// expectShareLink loads the test link, owned by user 7.
+func expectShareLink(mock sqlmock.Sqlmock, hash, access, groupIDs string) {
+	mock.ExpectQuery(`FROM "shared_links" AS "sl" WHERE \(short_code = '` + shareCode + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "short_code", "user_id", "agent_id", "oss_key", "file_name", "password_hash", "access", "group_ids"}).
+			AddRow(1, shareCode, 7, 3, "users/7/agents/3/output/report.txt", "report.txt", hash, access, groupIDs))
+}
+
+// downloadShared opens the protected test link with password. Every call
+// loads the link first.
+func downloadShared(h *workspace.Handler, mock sqlmock.Sqlmock, hash, password string) int {
+	expectShareLink(mock, hash, models.ShareAccessPublic, "{}")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/s/"+shareCode+"/raw", nil)
+	c.Request.Header.Set(workspace.SharePasswordHeader, password)
+	c.Params = gin.Params{{Key: "code", Value: shareCode}}
+	h.DownloadSharedFile(c)
+	return w.Code
+}
+
+func TestDownloadSharedFile_PasswordLockout(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	h, mock := newShareHandler(t)
+
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusForbidden, downloadShared(h, mock, string(hash), "guess"))
+	}
+	// Locked: even the right password is refused without being checked.
+	assert.Equal(t, http.StatusTooManyRequests, downloadShared(h, mock, string(hash), "correct horse"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDownloadSharedFile_CorrectPasswordResetsFailures(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	h, mock := newShareHandler(t)
+
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, http.StatusForbidden, downloadShared(h, mock, string(hash), "guess"))
+	}
+	// The password is accepted; there is just no storage to serve from.
+	mock.ExpectQuery(`FROM system_settings`).WillReturnRows(sqlmock.NewRows([]string{"key", "value"}))
+	assert.Equal(t, http.StatusServiceUnavailable, downloadShared(h, mock, string(hash), "correct horse"))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusForbidden, downloadShared(h, mock, string(hash), "guess"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, downloadShared(h, mock, string(hash), "guess"))
+}
+
+// shareMeta calls GetSharedFileMeta through the auth interceptor, as userID
+// when it is not 0.
+func shareMeta(t *testing.T, srv *workspace.Server, userID int64) (*sacv1.SharedFileMeta, error) {
+	t.Helper()
+	jwt := auth.NewJWTService("test-secret")
+	ctx := context.Background()
+	if userID != 0 {
+		token, err := jwt.GenerateToken(userID, "viewer", "user")
+		require.NoError(t, err)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/sac.v1.WorkspaceService/GetSharedFileMeta"}
+	resp, err := grpcauth.AuthUnaryInterceptor(jwt)(ctx, &sacv1.GetSharedFileRequest{Code: shareCode}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return srv.GetSharedFileMeta(ctx, req.(*sacv1.GetSharedFileRequest))
+		})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sacv1.SharedFileMeta), nil
+}
+
+func expectSharedFileSize(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT "size_bytes" FROM workspace_files WHERE \(oss_key = 'users/7/agents/3/output/report.txt'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"size_bytes"}).AddRow(42))
+}
+
+func TestGetSharedFileMeta_GroupLink(t *testing.T) {
+	t.Run("owner", func(t *testing.T) {
+		db, mock, cleanup := testutil.NewMockDB(t)
+		defer cleanup()
+		expectShareLink(mock, "", models.ShareAccessGroups, "{4}")
+		expectSharedFileSize(mock)
+
+		meta, err := shareMeta(t, workspace.NewWorkspaceServer(db, nil, nil, nil), 7)
+		require.NoError(t, err)
+		assert.Equal(t, "report.txt", meta.FileName)
+		assert.EqualValues(t, 42, meta.SizeBytes)
+	})
+	t.Run("group member", func(t *testing.T) {
+		db, mock, cleanup := testutil.NewMockDB(t)
+		defer cleanup()
+		expectShareLink(mock, "", models.ShareAccessGroups, "{4}")
+		mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "group_members" .*WHERE \(user_id = 9\) AND \(group_id IN \(4\)\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		expectSharedFileSize(mock)
+
+		meta, err := shareMeta(t, workspace.NewWorkspaceServer(db, nil, nil, nil), 9)
+		require.NoError(t, err)
+		assert.Equal(t, "report.txt", meta.FileName)
+	})
+	t.Run("anonymous", func(t *testing.T) {
+		db, mock, cleanup := testutil.NewMockDB(t)
+		defer cleanup()
+		expectShareLink(mock, "", models.ShareAccessGroups, "{4}")
+
+		_, err := shareMeta(t, workspace.NewWorkspaceServer(db, nil, nil, nil), 0)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestGetSharedFileMeta_PasswordLockout(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	h, mock := newShareHandler(t)
+	db, metaMock, cleanup := testutil.NewMockDB(t)
+	defer cleanup()
+	srv := workspace.NewWorkspaceServer(db, nil, nil, nil)
+	guard := workspace.NewShareGuard(nil)
+	h.SetShareGuard(guard)
+	srv.SetShareGuard(guard)
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusForbidden, downloadShared(h, mock, string(hash), "guess"))
+	}
+	expectShareLink(metaMock, string(hash), models.ShareAccessPublic, "{}")
+
+	_, err = shareMeta(t, srv, 0)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "too many incorrect passwords")
+}
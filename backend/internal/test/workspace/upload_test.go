@@ -0,0 +1,293 @@
+package workspace_test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+
+	"g.echo.tech/dev/sac/internal/test/testutil"
+	"g.echo.tech/dev/sac/internal/workspace"
+)
+
+const (
+	testUserID  = 7
+	testAgentID = 3
+	uploadID    = "5b0e6c1e-upload"
+	chunkPrefix = "users/7/agents/3/uploads/" + uploadID + "/"
+	inputPrefix = "users/7/agents/3/input/"
+)
+
+type uploadEnv struct {
+	h     *workspace.Handler
+	db    *bun.DB
+	mock  sqlmock.Sqlmock
+	store *testutil.MemStorage
+}
+
+func newUploadEnv(t *testing.T) *uploadEnv {
+	gin.SetMode(gin.TestMode)
+	db, mock, cleanup := testutil.NewMockDB(t)
+	t.Cleanup(cleanup)
+	// No container manager: pod pushes report not synced.
+	return &uploadEnv{h: workspace.NewHandler(db, nil, nil, nil, nil), db: db, mock: mock, store: testutil.NewMemStorage()}
+}
+
+// call runs a handler the way the router does after requireOSS.
+func (e *uploadEnv) call(handler gin.HandlerFunc, method, target string, body io.Reader) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, body)
+	c.Set("userID", int64(testUserID))
+	c.Set("storageBackend", e.store)
+	c.Params = gin.Params{{Key: "id", Value: uploadID}}
+	handler(c)
+	return w
+}
+
+func (e *uploadEnv) expectUpload(size, received int64, chunks int) {
+	e.mock.ExpectQuery(`FROM "workspace_uploads" AS "wu" WHERE \(id = '` + uploadID + `' AND user_id = 7\) AND \(updated_at > `).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "agent_id", "file_path", "content_type", "size_bytes", "received_bytes", "chunk_count", "updated_at"}).
+			AddRow(uploadID, testUserID, testAgentID, "input/data.csv", "text/csv", size, received, chunks, time.Now()))
+}
+
+// expectEnsureQuota answers ensureQuota for an agent whose quota row
+// already exists.
+func (e *uploadEnv) expectEnsureQuota() {
+	e.mock.ExpectQuery(`FROM "workspace_quotas" AS "wq" WHERE \(user_id = 7 AND agent_id = 0\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	e.mock.ExpectQuery(`INSERT INTO "workspace_quotas" .*ON CONFLICT \(user_id, agent_id\) DO NOTHING`).
+		WillReturnRows(sqlmock.NewRows([]string{"used_bytes", "file_count"}))
+}
+
+// expectQuotaCheck answers precheckQuota for a new file on an agent with
+// the given usage.
+func (e *uploadEnv) expectQuotaCheck(usedBytes, maxBytes int64) {
+	e.expectEnsureQuota()
+	e.mock.ExpectQuery(`FROM "workspace_quotas" AS "wq" WHERE \(user_id = 7 AND agent_id = 3\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "agent_id", "used_bytes", "max_bytes", "file_count", "max_file_count"}).
+			AddRow(testUserID, testAgentID, usedBytes, maxBytes, 0, 1000))
+	e.mock.ExpectQuery(`SELECT "wf"."size_bytes" FROM "workspace_files" AS "wf" WHERE \(oss_key = '` + inputPrefix + `input/data.csv'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"size_bytes"}))
+}
+
+// expectStoreCheck answers the locked quota check storeFile runs before
+// anything is uploaded, for a file whose existing record holds oldSize
+// bytes (nil for a new file).
+func (e *uploadEnv) expectStoreCheck(ossKey string, usedBytes, maxBytes int64, oldSize any) {
+	e.mock.ExpectBegin()
+	e.expectEnsureQuota()
+	e.mock.ExpectQuery(`FROM "workspace_quotas" AS "wq" WHERE \(user_id = 7 AND agent_id = 3\) FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "agent_id", "used_bytes", "max_bytes", "file_count", "max_file_count"}).
+			AddRow(testUserID, testAgentID, usedBytes, maxBytes, 0, 1000))
+	rows := sqlmock.NewRows([]string{"size_bytes"})
+	if oldSize != nil {
+		rows.AddRow(oldSize)
+	}
+	e.mock.ExpectQuery(`SELECT "wf"."size_bytes" FROM "workspace_files" AS "wf" WHERE \(oss_key = '` + ossKey + `'\)`).
+		WillReturnRows(rows)
+}
+
+type uploadState struct {
+	UploadID string `json:"upload_id"`
+	Offset   string `json:"offset"`
+}
+
+func decodeUpload(t *testing.T, w *httptest.ResponseRecorder) uploadState {
+	t.Helper()
+	var u uploadState
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &u))
+	return u
+}
+
+func TestCreateInputUpload(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectQuotaCheck(0, 1<<30)
+	e.mock.ExpectQuery(`INSERT INTO "workspace_uploads" .*'input/data.csv', 'text/csv', 10,`).
+		WillReturnRows(sqlmock.NewRows([]string{"received_bytes", "chunk_count"}).AddRow(0, 0))
+
+	w := e.call(e.h.CreateInputUpload, http.MethodPost, "/api/workspace/input/uploads",
+		strings.NewReader(`{"agent_id":3,"file_name":"data.csv","size_bytes":10,"content_type":"text/csv"}`))
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.NotEmpty(t, decodeUpload(t, w).UploadID)
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestCreateInputUpload_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing agent", `{"file_name":"a.txt","size_bytes":10}`},
+		{"empty file", `{"agent_id":3,"file_name":"a.txt","size_bytes":0}`},
+		{"too large", `{"agent_id":3,"file_name":"a.txt","size_bytes":3221225472}`},
+		{"platform path", `{"agent_id":3,"path":"output/a.txt","size_bytes":10}`},
+		{"no file name", `{"agent_id":3,"path":"input/","size_bytes":10}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newUploadEnv(t)
+			w := e.call(e.h.CreateInputUpload, http.MethodPost, "/api/workspace/input/uploads", strings.NewReader(tt.body))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestCreateInputUpload_OverQuota(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectQuotaCheck(1<<30-5, 1<<30)
+
+	w := e.call(e.h.CreateInputUpload, http.MethodPost, "/api/workspace/input/uploads",
+		strings.NewReader(`{"agent_id":3,"file_name":"data.csv","size_bytes":10}`))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "quota exceeded")
+}
+
+func TestUploadInputChunk_AdvancesOffset(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectUpload(10, 4, 1)
+	e.mock.ExpectQuery(`UPDATE "workspace_uploads" AS "wu" SET received_bytes = received_bytes \+ 6, chunk_count = chunk_count \+ 1, .*WHERE \(id = '` + uploadID + `' AND received_bytes = 4\) RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "size_bytes", "received_bytes", "chunk_count"}).AddRow(uploadID, 10, 10, 2))
+
+	w := e.call(e.h.UploadInputChunk, http.MethodPut, "/api/workspace/input/uploads/"+uploadID+"?offset=4", strings.NewReader("efghij"))
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "10", decodeUpload(t, w).Offset)
+	chunk, ok := e.store.Get(chunkPrefix + "000001")
+	require.True(t, ok)
+	assert.Equal(t, "efghij", string(chunk))
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestUploadInputChunk_OffsetMismatch(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectUpload(10, 4, 1)
+
+	// The client resends a chunk the server already has.
+	w := e.call(e.h.UploadInputChunk, http.MethodPut, "/api/workspace/input/uploads/"+uploadID+"?offset=0", strings.NewReader("abcd"))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "4", decodeUpload(t, w).Offset, "409 tells the client where to resume")
+	assert.Empty(t, e.store.Keys())
+}
+
+func TestUploadInputChunk_ConcurrentChunkWins(t *testing.T) {
+	e := newUploadEnv(t)
+	e.expectUpload(10, 4, 1)
+	e.mock.ExpectQuery(`UPDATE "workspace_uploads"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	w := e.call(e.h.UploadInputChunk, http.MethodPut, "/api/workspace/input/uploads/"+uploadID+"?offset=4", strings.NewReader("efghij"))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestUploadInputChunk_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		body   string
+	}{
+		{"missing offset", "", "abcd"},
+		{"past declared size", "?offset=4", "efghijk"},
+		{"empty body", "?offset=4", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newUploadEnv(t)
+			e.expectUpload(10, 4, 1)
+			w := e.call(e.h.UploadInputChunk, http.MethodPut, "/api/workspace/input/uploads/"+uploadID+tt.target, strings.NewReader(tt.body))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Empty(t, e.store.Keys())
+		})
+	}
+}
+
+func TestUploadInputChunk_UnknownOrExpired(t *testing.T) {
+	e := newUploadEnv(t)
+	e.mock.ExpectQuery(`FROM "workspace_uploads"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	w := e.call(e.h.UploadInputChunk, http.MethodPut, "/api/workspace/input/uploads/"+uploadID+"?offset=0", strings.NewReader("abcd"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCompleteInputUpload_AssemblesChunks(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(chunkPrefix+"000000", []byte("abcd"))
+	e.store.Put(chunkPrefix+"000001", []byte("efghij"))
+	sum := md5.Sum([]byte("abcdefghij"))
+
+	e.expectUpload(10, 10, 2)
+	// storeFile checks the quota under lock before assembling the file.
+	e.expectStoreCheck(inputPrefix+"input/data.csv", 0, 1<<30, nil)
+	e.mock.ExpectQuery(`INSERT INTO "workspace_files" .*'` + hex.EncodeToString(sum[:]) + `'.*ON CONFLICT \(oss_key\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(31))
+	e.mock.ExpectExec(`UPDATE "workspace_quotas" .*used_bytes = GREATEST\(used_bytes \+ 10, 0\), file_count = GREATEST\(file_count \+ 1, 0\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	e.mock.ExpectCommit()
+	e.mock.ExpectExec(`DELETE FROM "workspace_uploads" .*id = '` + uploadID + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	w := e.call(e.h.CompleteInputUpload, http.MethodPost, "/api/workspace/input/uploads/"+uploadID+"/complete", nil)
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	data, ok := e.store.Get(inputPrefix + "input/data.csv")
+	require.True(t, ok)
+	assert.Equal(t, "abcdefghij", string(data))
+	assert.Equal(t, []string{inputPrefix + "input/data.csv"}, e.store.Keys(), "chunks are removed")
+	assert.NotContains(t, w.Body.String(), `"pod_synced":true`)
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestCompleteInputUpload_Incomplete(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(chunkPrefix+"000000", []byte("abcd"))
+	e.expectUpload(10, 4, 1)
+
+	w := e.call(e.h.CompleteInputUpload, http.MethodPost, "/api/workspace/input/uploads/"+uploadID+"/complete", nil)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "4", decodeUpload(t, w).Offset)
+	assert.Equal(t, []string{chunkPrefix + "000000"}, e.store.Keys())
+}
+
+func TestCompleteInputUpload_MissingChunk(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(chunkPrefix+"000000", []byte("abcd"))
+	e.expectUpload(10, 10, 2)
+	e.expectStoreCheck(inputPrefix+"input/data.csv", 0, 1<<30, nil)
+	e.mock.ExpectRollback()
+
+	w := e.call(e.h.CompleteInputUpload, http.MethodPost, "/api/workspace/input/uploads/"+uploadID+"/complete", nil)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	_, ok := e.store.Get(inputPrefix + "input/data.csv")
+	assert.False(t, ok, "no partial file is stored")
+	assert.NoError(t, e.mock.ExpectationsWereMet())
+}
+
+func TestAbortInputUpload(t *testing.T) {
+	e := newUploadEnv(t)
+	e.store.Put(chunkPrefix+"000000", []byte("abcd"))
+	e.store.Put(inputPrefix+"input/other.txt", []byte("keep"))
+	e.expectUpload(10, 4, 1)
+	e.mock.ExpectExec(`DELETE FROM "workspace_uploads"`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	w := e.call(e.h.AbortInputUpload, http.MethodDelete, "/api/workspace/input/uploads/"+uploadID, nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{inputPrefix + "input/other.txt"}, e.store.Keys())
+}
@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	maxPayloadSize = 1 << 20 // 1MB
+	excerptSize    = 4 << 10
+
+	// rejectLogLimit caps how many unauthenticated deliveries (bad
+	// signature, disabled webhook, unreadable body) are recorded per webhook
+	// and rejectLogWindow on each replica, so anyone who learns a webhook
+	// URL cannot flood webhook_deliveries.
+	rejectLogLimit  = 20
+	rejectLogWindow = time.Minute
+)
+
+// TaskDispatcher starts a headless agent task (implemented by session.Server).
+type TaskDispatcher interface {
+	DispatchTask(ctx context.Context, task *models.AgentTask) (*models.AgentTask, error)
+}
+
+// Handler receives inbound webhook deliveries. The endpoint is public: the
+// unguessable token selects the webhook and the signature authenticates it.
+type Handler struct {
+	db         *bun.DB
+	dispatcher TaskDispatcher
+
+	rejectMu sync.Mutex
+	rejects  map[int64]*rejectWindow
+}
+
+type rejectWindow struct {
+	start time.Time
+	count int
+}
+
+func NewHandler(db *bun.DB, dispatcher TaskDispatcher) *Handler {
+	return &Handler{db: db, dispatcher: dispatcher, rejects: make(map[int64]*rejectWindow)}
+}
+
+// allowRejectLog reports whether another unauthenticated delivery of a
+// webhook may be recorded in the current window.
+func (h *Handler) allowRejectLog(webhookID int64, now time.Time) bool {
+	h.rejectMu.Lock()
+	defer h.rejectMu.Unlock()
+
+	w, ok := h.rejects[webhookID]
+	if !ok || now.Sub(w.start) >= rejectLogWindow {
+		// Drop expired windows of other webhooks while we are here.
+		for id, other := range h.rejects {
+			if now.Sub(other.start) >= rejectLogWindow {
+				delete(h.rejects, id)
+			}
+		}
+		w = &rejectWindow{start: now}
+		h.rejects[webhookID] = w
+	}
+	w.count++
+	return w.count <= rejectLogLimit
+}
+
+// Receive handles POST /api/hooks/:token. Deliveries for a known webhook are
+// recorded whether they were accepted or not; unauthenticated ones only up to
+// rejectLogLimit per window.
+func (h *Handler) Receive(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var wh models.AgentWebhook
+	err := h.db.NewSelect().
+		Model(&wh).
+		Where("token = ?", c.Param("token")).
+		Scan(ctx)
+	if err != nil {
+		response.NotFound(c, "Webhook not found")
+		return
+	}
+
+	delivery := &models.WebhookDelivery{
+		WebhookID:  wh.ID,
+		Event:      eventName(c.Request.Header),
+		RemoteAddr: c.ClientIP(),
+	}
+	authenticated := false
+	finish := func(status string, code int, errMsg string) {
+		delivery.Status = status
+		delivery.StatusCode = code
+		delivery.Error = errMsg
+		var err error
+		switch {
+		case delivery.ID != 0: // claimed by claimDelivery
+			_, err = h.db.NewUpdate().
+				Model(delivery).
+				Column("status", "status_code", "error", "task_id").
+				WherePK().
+				Exec(ctx)
+		case authenticated || h.allowRejectLog(wh.ID, time.Now()):
+			_, err = h.db.NewInsert().Model(delivery).Returning("*").Exec(ctx)
+		}
+		if err != nil {
+			log.Warn().Err(err).Int64("webhook_id", wh.ID).Msg("failed to record webhook delivery")
+		}
+		if status == models.DeliveryAccepted {
+			c.JSON(code, gin.H{"task_id": delivery.TaskID, "delivery_id": delivery.ID})
+			return
+		}
+		response.Error(c, code, errMsg)
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxPayloadSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			finish(models.DeliveryRejected, http.StatusRequestEntityTooLarge, "payload too large")
+			return
+		}
+		finish(models.DeliveryRejected, http.StatusBadRequest, "failed to read payload")
+		return
+	}
+	delivery.PayloadExcerpt = excerpt(body)
+
+	if !wh.Enabled {
+		finish(models.DeliveryRejected, http.StatusForbidden, "webhook is disabled")
+		return
+	}
+
+	if err := VerifySignature(wh.SignatureFormat, wh.Secret, c.Request.Header, body); err != nil {
+		finish(models.DeliveryRejected, http.StatusUnauthorized, err.Error())
+		return
+	}
+	authenticated = true
+
+	var payload any
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			finish(models.DeliveryRejected, http.StatusBadRequest, "payload is not valid JSON")
+			return
+		}
+	}
+
+	prompt, err := renderPrompt(wh.PromptTemplate, payload, delivery.Event)
+	if err != nil {
+		finish(models.DeliveryFailed, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	claimed, err := h.claimDelivery(ctx, &wh, delivery)
+	if err != nil {
+		finish(models.DeliveryFailed, http.StatusInternalServerError, "failed to check rate limit")
+		return
+	}
+	if !claimed {
+		finish(models.DeliveryRateLimited, http.StatusTooManyRequests, "rate limit exceeded")
+		return
+	}
+
+	task, err := h.dispatcher.DispatchTask(ctx, &models.AgentTask{
+		UserID:         wh.UserID,
+		AgentID:        wh.AgentID,
+		Prompt:         prompt,
+		AllowedTools:   wh.AllowedTools,
+		TimeoutSeconds: wh.TimeoutSeconds,
+	})
+	if err != nil {
+		finish(models.DeliveryFailed, http.StatusInternalServerError, "failed to start task")
+		return
+	}
+	delivery.TaskID = task.TaskID
+
+	if task.Status == models.TaskStatusFailed {
+		finish(models.DeliveryFailed, http.StatusServiceUnavailable, task.Error)
+		return
+	}
+
+	log.Info().Int64("webhook_id", wh.ID).Str("task_id", task.TaskID).Str("event", delivery.Event).Msg("webhook accepted")
+	finish(models.DeliveryAccepted, http.StatusAccepted, "")
+}
+
+// claimDelivery checks the hourly rate limit and, if there is room, records
+// the delivery as accepted in the same transaction. The webhook row lock
+// serializes concurrent deliveries, so a burst cannot all pass the count
+// before any of them is stored. A claimed delivery whose dispatch fails is
+// updated afterwards and stops counting against the limit.
+func (h *Handler) claimDelivery(ctx context.Context, wh *models.AgentWebhook, delivery *models.WebhookDelivery) (bool, error) {
+	claimed := false
+	err := h.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var id int64
+		err := tx.NewSelect().
+			Model((*models.AgentWebhook)(nil)).
+			Column("id").
+			Where("id = ?", wh.ID).
+			For("UPDATE").
+			Scan(ctx, &id)
+		if err != nil {
+			return err
+		}
+
+		recent, err := tx.NewSelect().
+			Model((*models.WebhookDelivery)(nil)).
+			Where("webhook_id = ?", wh.ID).
+			Where("status = ?", models.DeliveryAccepted).
+			Where("received_at > ?", time.Now().Add(-time.Hour)).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if recent >= wh.RateLimitPerHour {
+			return nil
+		}
+
+		delivery.Status = models.DeliveryAccepted
+		delivery.StatusCode = http.StatusAccepted
+		if _, err := tx.NewInsert().Model(delivery).Returning("*").Exec(ctx); err != nil {
+			return err
+		}
+		claimed = true
+		return nil
+	})
+	if err != nil {
+		delivery.ID = 0
+		return false, err
+	}
+	return claimed, nil
+}
+
+// excerpt returns the start of the payload for the delivery log, with
+// invalid UTF-8 (including a rune split by the cut) dropped.
+func excerpt(body []byte) string {
+	if len(body) > excerptSize {
+		body = body[:excerptSize]
+	}
+	return strings.ToValidUTF8(string(body), "")
+}
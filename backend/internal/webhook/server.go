@@ -0,0 +1,272 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/ctxkeys"
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+)
+
+const (
+	defaultTimeout   = 600
+	maxTimeout       = 3600
+	defaultRateLimit = 60
+	maxRateLimit     = 3600
+)
+
+type Server struct {
+	sacv1.UnimplementedWebhookServiceServer
+	db *bun.DB
+}
+
+func NewServer(db *bun.DB) *Server {
+	return &Server{db: db}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validate normalizes defaults and rejects invalid settings.
+func validate(wh *models.AgentWebhook) error {
+	if wh.Name == "" {
+		return grpcerr.BadRequest("name is required")
+	}
+	if wh.SignatureFormat == "" {
+		wh.SignatureFormat = models.WebhookSignatureHMACSHA256
+	}
+	if !validFormats[wh.SignatureFormat] {
+		return grpcerr.BadRequest("signature_format must be one of github, gitlab, hmac-sha256, none")
+	}
+	if _, err := parsePromptTemplate(wh.PromptTemplate); err != nil {
+		return grpcerr.BadRequest(err.Error())
+	}
+	if wh.TimeoutSeconds == 0 {
+		wh.TimeoutSeconds = defaultTimeout
+	}
+	if wh.TimeoutSeconds < 0 || wh.TimeoutSeconds > maxTimeout {
+		return grpcerr.BadRequest(fmt.Sprintf("timeout_seconds must be between 1 and %d", maxTimeout))
+	}
+	if wh.RateLimitPerHour == 0 {
+		wh.RateLimitPerHour = defaultRateLimit
+	}
+	if wh.RateLimitPerHour < 0 || wh.RateLimitPerHour > maxRateLimit {
+		return grpcerr.BadRequest(fmt.Sprintf("rate_limit_per_hour must be between 1 and %d", maxRateLimit))
+	}
+	return nil
+}
+
+func (s *Server) CreateWebhook(ctx context.Context, req *sacv1.CreateWebhookRequest) (*sacv1.Webhook, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	exists, err := s.db.NewSelect().
+		Model((*models.Agent)(nil)).
+		Where("id = ?", req.AgentId).
+		Where("created_by = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load agent", err)
+	}
+	if !exists {
+		return nil, grpcerr.NotFound("Agent not found")
+	}
+
+	wh := &models.AgentWebhook{
+		UserID:           userID,
+		AgentID:          req.AgentId,
+		Name:             strings.TrimSpace(req.Name),
+		Token:            randomHex(24),
+		Secret:           randomHex(32),
+		SignatureFormat:  req.SignatureFormat,
+		PromptTemplate:   req.PromptTemplate,
+		AllowedTools:     models.TrimAllowedTools(req.AllowedTools),
+		TimeoutSeconds:   int(req.TimeoutSeconds),
+		RateLimitPerHour: int(req.RateLimitPerHour),
+		Enabled:          true,
+	}
+	if err := validate(wh); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.NewInsert().Model(wh).Returning("*").Exec(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to create webhook", err)
+	}
+
+	pb := convert.WebhookToProto(wh)
+	pb.Secret = wh.Secret
+	return pb, nil
+}
+
+func (s *Server) ListWebhooks(ctx context.Context, req *sacv1.ListWebhooksRequest) (*sacv1.WebhookListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	query := s.db.NewSelect().
+		Model((*models.AgentWebhook)(nil)).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC")
+	if req.AgentId > 0 {
+		query = query.Where("agent_id = ?", req.AgentId)
+	}
+
+	var hooks []models.AgentWebhook
+	if err := query.Scan(ctx, &hooks); err != nil {
+		return nil, grpcerr.Internal("Failed to list webhooks", err)
+	}
+
+	return &sacv1.WebhookListResponse{Webhooks: convert.WebhooksToProto(hooks)}, nil
+}
+
+func (s *Server) GetWebhook(ctx context.Context, req *sacv1.WebhookByIdRequest) (*sacv1.Webhook, error) {
+	wh, err := s.getWebhook(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return convert.WebhookToProto(wh), nil
+}
+
+func (s *Server) UpdateWebhook(ctx context.Context, req *sacv1.UpdateWebhookRequest) (*sacv1.Webhook, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	wh, err := s.getWebhook(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dynamic columns: only update fields that were actually provided
+	columns := []string{"updated_at"}
+
+	if req.Name != nil {
+		wh.Name = strings.TrimSpace(*req.Name)
+		columns = append(columns, "name")
+	}
+	if req.SignatureFormat != nil {
+		wh.SignatureFormat = *req.SignatureFormat
+		columns = append(columns, "signature_format")
+	}
+	if req.PromptTemplate != nil {
+		wh.PromptTemplate = *req.PromptTemplate
+		columns = append(columns, "prompt_template")
+	}
+	if req.AllowedTools != nil {
+		wh.AllowedTools = models.TrimAllowedTools(req.AllowedTools)
+		columns = append(columns, "allowed_tools")
+	}
+	if req.TimeoutSeconds != nil {
+		wh.TimeoutSeconds = int(*req.TimeoutSeconds)
+		columns = append(columns, "timeout_seconds")
+	}
+	if req.RateLimitPerHour != nil {
+		wh.RateLimitPerHour = int(*req.RateLimitPerHour)
+		columns = append(columns, "rate_limit_per_hour")
+	}
+	if req.Enabled != nil {
+		wh.Enabled = *req.Enabled
+		columns = append(columns, "enabled")
+	}
+
+	if err := validate(wh); err != nil {
+		return nil, err
+	}
+	wh.UpdatedAt = time.Now()
+
+	_, err = s.db.NewUpdate().
+		Model(wh).
+		Column(columns...).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to update webhook", err)
+	}
+
+	return convert.WebhookToProto(wh), nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *sacv1.WebhookByIdRequest) (*sacv1.SuccessMessage, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	res, err := s.db.NewDelete().
+		Model((*models.AgentWebhook)(nil)).
+		Where("id = ?", req.Id).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to delete webhook", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, grpcerr.NotFound("Webhook not found")
+	}
+
+	return &sacv1.SuccessMessage{Message: "Webhook deleted"}, nil
+}
+
+// RotateWebhookSecret issues a new signing secret. The old one stops
+// working immediately.
+func (s *Server) RotateWebhookSecret(ctx context.Context, req *sacv1.WebhookByIdRequest) (*sacv1.Webhook, error) {
+	wh, err := s.getWebhook(ctx, req.Id, ctxkeys.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	wh.Secret = randomHex(32)
+	wh.UpdatedAt = time.Now()
+	_, err = s.db.NewUpdate().
+		Model(wh).
+		Column("secret", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to rotate secret", err)
+	}
+
+	pb := convert.WebhookToProto(wh)
+	pb.Secret = wh.Secret
+	return pb, nil
+}
+
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *sacv1.ListWebhookDeliveriesRequest) (*sacv1.WebhookDeliveryListResponse, error) {
+	if _, err := s.getWebhook(ctx, req.Id, ctxkeys.UserID(ctx)); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	var deliveries []models.WebhookDelivery
+	err := s.db.NewSelect().
+		Model(&deliveries).
+		Where("webhook_id = ?", req.Id).
+		OrderExpr("received_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list deliveries", err)
+	}
+
+	return &sacv1.WebhookDeliveryListResponse{Deliveries: convert.WebhookDeliveriesToProto(deliveries)}, nil
+}
+
+func (s *Server) getWebhook(ctx context.Context, id, userID int64) (*models.AgentWebhook, error) {
+	var wh models.AgentWebhook
+	err := s.db.NewSelect().
+		Model(&wh).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.NotFound("Webhook not found", err)
+	}
+	return &wh, nil
+}
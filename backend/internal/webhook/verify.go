@@ -0,0 +1,182 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"g.echo.tech/dev/sac/internal/models"
+)
+
+// maxPromptSize bounds the rendered prompt handed to Claude Code.
+const maxPromptSize = 100 << 10
+
+var validFormats = map[string]bool{
+	models.WebhookSignatureGitHub:     true,
+	models.WebhookSignatureGitLab:     true,
+	models.WebhookSignatureHMACSHA256: true,
+	models.WebhookSignatureNone:       true,
+}
+
+var errBadSignature = errors.New("signature mismatch")
+
+// VerifySignature checks the request against the webhook secret using the
+// convention of the configured sender.
+func VerifySignature(format, secret string, header http.Header, body []byte) error {
+	switch format {
+	case models.WebhookSignatureNone:
+		return nil
+	case models.WebhookSignatureGitLab:
+		token := header.Get("X-Gitlab-Token")
+		if token == "" {
+			return errors.New("missing X-Gitlab-Token header")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return errBadSignature
+		}
+		return nil
+	case models.WebhookSignatureGitHub:
+		sig := header.Get("X-Hub-Signature-256")
+		if sig == "" {
+			return errors.New("missing X-Hub-Signature-256 header")
+		}
+		return checkHMAC(secret, strings.TrimPrefix(sig, "sha256="), body)
+	case models.WebhookSignatureHMACSHA256:
+		sig := header.Get("X-Signature")
+		if sig == "" {
+			return errors.New("missing X-Signature header")
+		}
+		return checkHMAC(secret, strings.TrimPrefix(sig, "sha256="), body)
+	}
+	return fmt.Errorf("unknown signature format %q", format)
+}
+
+func checkHMAC(secret, sigHex string, body []byte) error {
+	got, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return errBadSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errBadSignature
+	}
+	return nil
+}
+
+var templateFuncs = template.FuncMap{
+	// json renders a payload value as compact JSON, e.g. {{json .payload.labels}}.
+	"json": func(v any) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	},
+	// truncate cuts a string to at most n runes, e.g. {{truncate 200 .payload.body}}.
+	"truncate": func(n int, v any) string {
+		s := fmt.Sprint(v)
+		if r := []rune(s); len(r) > n {
+			return string(r[:n]) + "…"
+		}
+		return s
+	},
+	// orEmpty is appended to every printing action by emptyMissing.
+	"orEmpty": func(v any) any {
+		if v == nil {
+			return ""
+		}
+		return v
+	},
+}
+
+// parsePromptTemplate compiles a prompt template. Templates see the decoded
+// JSON body as .payload and the sender's event name as .event, e.g.
+//
+//	Review PR #{{.payload.number}}: {{.payload.pull_request.title}}
+func parsePromptTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("prompt_template is required")
+	}
+	t, err := template.New("prompt").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prompt_template: %w", err)
+	}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			emptyMissing(tt.Tree, tt.Tree.Root)
+		}
+	}
+	return t, nil
+}
+
+// emptyMissing pipes the value of every printing action through orEmpty, so
+// a missing payload key or a JSON null renders as "" instead of
+// text/template's "<no value>", without touching payload text that happens
+// to contain those words.
+func emptyMissing(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			emptyMissing(tree, child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 {
+			return // variable declarations print nothing
+		}
+		ident := parse.NewIdentifier("orEmpty").SetTree(tree).SetPos(n.Pos)
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{NodeType: parse.NodeCommand, Pos: n.Pos, Args: []parse.Node{ident}})
+	case *parse.IfNode:
+		emptyMissing(tree, n.List)
+		emptyMissing(tree, n.ElseList)
+	case *parse.RangeNode:
+		emptyMissing(tree, n.List)
+		emptyMissing(tree, n.ElseList)
+	case *parse.WithNode:
+		emptyMissing(tree, n.List)
+		emptyMissing(tree, n.ElseList)
+	}
+}
+
+// renderPrompt executes the webhook's template against a delivery.
+func renderPrompt(text string, payload any, event string) (string, error) {
+	t, err := parsePromptTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, map[string]any{"payload": payload, "event": event}); err != nil {
+		return "", fmt.Errorf("failed to render prompt: %w", err)
+	}
+	out := strings.TrimSpace(buf.String())
+	if out == "" {
+		return "", errors.New("rendered prompt is empty")
+	}
+	if len(out) > maxPromptSize {
+		return "", fmt.Errorf("rendered prompt exceeds %d bytes", maxPromptSize)
+	}
+	return out, nil
+}
+
+// eventName extracts the sender's event type from well-known headers.
+func eventName(header http.Header) string {
+	for _, h := range []string{"X-GitHub-Event", "X-Gitlab-Event", "X-Event-Type"} {
+		if v := header.Get(h); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,508 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// Participant roles within a shared terminal session.
+const (
+	roleOwner  = "owner"
+	roleEditor = "editor" // read_write share grant
+	roleViewer = "viewer" // read_only share grant
+)
+
+const (
+	// controlIdleTimeout is how long the current input controller keeps the
+	// keyboard after its last keystroke before another editor may take over.
+	// The session owner can always take over immediately.
+	controlIdleTimeout = 3 * time.Second
+
+	// clientSendBuffer bounds the per-client outbound queue. A client that
+	// falls this far behind the ttyd output stream is disconnected rather
+	// than stalling every other participant.
+	clientSendBuffer = 256
+
+	heartbeatInterval = 30 * time.Second
+	pongTimeout       = 60 * time.Second
+)
+
+type wsFrame struct {
+	msgType int
+	data    []byte
+}
+
+// client is one browser connection attached to a session hub.
+type client struct {
+	conn     *websocket.Conn
+	userID   int64
+	username string
+	role     string // guarded by the hub's mu; rechecked by watchAccess
+	joinedAt time.Time
+	send     chan wsFrame
+}
+
+func (c *client) canWrite() bool {
+	return c.role == roleOwner || c.role == roleEditor
+}
+
+// writePump drains the client's outbound queue. It is the only goroutine
+// that writes data frames to the client connection.
+func (c *client) writePump() {
+	defer c.conn.Close()
+	for f := range c.send {
+		if err := c.conn.WriteMessage(f.msgType, f.data); err != nil {
+			log.Debug().Err(err).Int64("user_id", c.userID).Msg("error writing message (ttyd->client)")
+			return
+		}
+		bytesTotal.WithLabelValues("out").Add(float64(len(f.data)))
+	}
+}
+
+// sessionHub multiplexes a single ttyd connection across every client
+// attached to the same session: output is fanned out to all clients and
+// input is arbitrated so only one writer types at a time.
+type sessionHub struct {
+	sessionID string
+	dbID      int64 // sessions.id
+	ownerID   int64
+	db        *bun.DB
+	settings  *admin.SettingsService
+	done      chan struct{} // closed by close()
+
+	// ready is closed once the ttyd dial finished; err holds the dial error.
+	ready chan struct{}
+	err   error
+
+	ttyd   *websocket.Conn
+	ttydMu sync.Mutex // serializes writes to ttyd
+
+	mu         sync.Mutex
+	clients    map[*client]struct{}
+	controller *client
+	lastInput  time.Time
+	lastFlush  time.Time // last last_active write, see recordInput
+	columns    int
+	rows       int
+	closed     bool
+}
+
+// getOrCreateHub returns the live hub for a session, dialing ttyd if none
+// exists yet. Concurrent joiners wait for the first dial instead of opening
+// their own ttyd connection.
+func (h *ProxyHandler) getOrCreateHub(session *models.Session, columns, rows int) (*sessionHub, error) {
+	h.hubsMu.Lock()
+	hub, ok := h.hubs[session.SessionID]
+	if !ok {
+		hub = &sessionHub{
+			sessionID: session.SessionID,
+			dbID:      session.ID,
+			ownerID:   session.UserID,
+			db:        h.db,
+			settings:  h.settings,
+			done:      make(chan struct{}),
+			ready:     make(chan struct{}),
+			clients:   make(map[*client]struct{}),
+			lastFlush: time.Now(), // HandleWebSocket just wrote last_active
+			columns:   columns,
+			rows:      rows,
+		}
+		h.hubs[session.SessionID] = hub
+	}
+	h.hubsMu.Unlock()
+
+	if ok {
+		<-hub.ready
+		if hub.err != nil {
+			return nil, hub.err
+		}
+		return hub, nil
+	}
+
+	hub.err = hub.dial(net.JoinHostPort(session.PodIP, strconv.Itoa(h.ttydPort)))
+	close(hub.ready)
+	if hub.err != nil {
+		h.removeHub(hub)
+		return nil, hub.err
+	}
+
+	go h.runHub(hub)
+	return hub, nil
+}
+
+func (h *ProxyHandler) removeHub(hub *sessionHub) {
+	h.hubsMu.Lock()
+	if h.hubs[hub.sessionID] == hub {
+		delete(h.hubs, hub.sessionID)
+	}
+	h.hubsMu.Unlock()
+}
+
+// dial connects to ttyd in the pod and performs the auth handshake using the
+// first client's terminal dimensions so the PTY starts at the correct size.
+func (hub *sessionHub) dial(addr string) error {
+	ttydURL := fmt.Sprintf("ws://%s/ws", addr)
+	log.Debug().Str("url", ttydURL).Msg("connecting to ttyd")
+
+	// ttyd requires the "tty" WebSocket subprotocol
+	ttydHeaders := http.Header{}
+	ttydHeaders.Set("Sec-WebSocket-Protocol", "tty")
+	start := time.Now()
+	conn, _, err := websocket.DefaultDialer.Dial(ttydURL, ttydHeaders)
+	if err != nil {
+		dialDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
+		return fmt.Errorf("failed to connect to container: %w", err)
+	}
+
+	authMsg := fmt.Sprintf(`{"AuthToken":"","columns":%d,"rows":%d}`, hub.columns, hub.rows)
+	if err := conn.WriteMessage(websocket.BinaryMessage, []byte(authMsg)); err != nil {
+		conn.Close()
+		dialDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
+		return fmt.Errorf("failed to authenticate with container: %w", err)
+	}
+	dialDuration.WithLabelValues("ok").Observe(time.Since(start).Seconds())
+	log.Debug().Int("columns", hub.columns).Int("rows", hub.rows).Msg("sent ttyd auth handshake")
+
+	conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(pongTimeout))
+		return nil
+	})
+
+	hub.ttyd = conn
+	return nil
+}
+
+// runHub pumps ttyd output to every client until the ttyd connection ends,
+// then tears the hub down and disconnects any remaining clients.
+func (h *ProxyHandler) runHub(hub *sessionHub) {
+	go h.StartHeartbeat(hub.ttyd, heartbeatInterval)
+	go hub.watchIdle()
+	go hub.watchAccess()
+
+	hub.forwardTtydToClients()
+
+	h.removeHub(hub)
+	hub.close()
+	log.Info().Str("session_id", hub.sessionID).Msg("ttyd connection closed")
+}
+
+// close shuts down the ttyd connection and all attached clients. Safe to call
+// more than once.
+func (hub *sessionHub) close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if hub.closed {
+		return
+	}
+	hub.closed = true
+	close(hub.done)
+	hub.ttyd.Close()
+	for c := range hub.clients {
+		close(c.send)
+		delete(hub.clients, c)
+	}
+}
+
+// join attaches a client and announces the new participant list. Returns
+// false if the hub shut down in the meantime.
+func (hub *sessionHub) join(c *client) bool {
+	hub.mu.Lock()
+	if hub.closed {
+		hub.mu.Unlock()
+		return false
+	}
+	first := len(hub.clients) == 0
+	hub.clients[c] = struct{}{}
+	hub.broadcastPresenceLocked()
+	columns, rows := hub.columns, hub.rows
+	hub.mu.Unlock()
+
+	// Late joiners missed the screen contents; bounce the PTY size so the
+	// foreground process redraws for everybody.
+	if !first {
+		hub.writeResize(max(columns-1, 1), rows)
+		hub.writeResize(columns, rows)
+	}
+	return true
+}
+
+// leave detaches a client. The last client to leave closes the ttyd
+// connection, which in turn makes runHub unregister the hub.
+func (hub *sessionHub) leave(c *client) {
+	hub.mu.Lock()
+	if _, ok := hub.clients[c]; !ok {
+		hub.mu.Unlock()
+		return
+	}
+	delete(hub.clients, c)
+	close(c.send)
+	if hub.controller == c {
+		hub.controller = nil
+	}
+	empty := len(hub.clients) == 0
+	if !empty {
+		hub.broadcastPresenceLocked()
+	}
+	hub.mu.Unlock()
+
+	if empty {
+		hub.close()
+	}
+}
+
+// enqueueLocked queues a frame for a client without blocking. Slow clients
+// are dropped, in which case it returns false. Caller must hold hub.mu.
+func (hub *sessionHub) enqueueLocked(c *client, f wsFrame) bool {
+	select {
+	case c.send <- f:
+		return true
+	default:
+		log.Warn().Str("session_id", hub.sessionID).Int64("user_id", c.userID).Msg("client too slow, disconnecting")
+		delete(hub.clients, c)
+		close(c.send)
+		if hub.controller == c {
+			hub.controller = nil
+		}
+		return false
+	}
+}
+
+// kickLocked disconnects a client after telling it why. Caller must hold
+// hub.mu.
+func (hub *sessionHub) kickLocked(c *client, reason string) {
+	select {
+	case c.send <- wsFrame{msgType: websocket.TextMessage, data: []byte(reason)}:
+		select {
+		case c.send <- wsFrame{msgType: websocket.CloseMessage, data: websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "access revoked")}:
+		default:
+		}
+	default:
+	}
+	delete(hub.clients, c)
+	close(c.send)
+	if hub.controller == c {
+		hub.controller = nil
+	}
+}
+
+func (hub *sessionHub) broadcast(f wsFrame) {
+	hub.mu.Lock()
+	dropped := false
+	for c := range hub.clients {
+		if !hub.enqueueLocked(c, f) {
+			dropped = true
+		}
+	}
+	empty := len(hub.clients) == 0
+	hub.mu.Unlock()
+
+	// Dropping the last slow client leaves nobody to close the hub.
+	if dropped && empty {
+		hub.close()
+	}
+}
+
+type presenceParticipant struct {
+	UserID   int64     `json:"user_id"`
+	Username string    `json:"username"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type presenceMessage struct {
+	Type         string                `json:"type"`
+	Participants []presenceParticipant `json:"participants"`
+	Controller   int64                 `json:"controller,omitempty"`
+}
+
+// broadcastPresenceLocked sends the participant list (one entry per user,
+// regardless of how many tabs they have open) as a JSON text frame.
+// Caller must hold hub.mu.
+func (hub *sessionHub) broadcastPresenceLocked() {
+	byUser := make(map[int64]presenceParticipant)
+	for c := range hub.clients {
+		if p, ok := byUser[c.userID]; ok && p.JoinedAt.Before(c.joinedAt) {
+			continue
+		}
+		byUser[c.userID] = presenceParticipant{
+			UserID:   c.userID,
+			Username: c.username,
+			Role:     c.role,
+			JoinedAt: c.joinedAt,
+		}
+	}
+
+	msg := presenceMessage{Type: "presence", Participants: make([]presenceParticipant, 0, len(byUser))}
+	for _, p := range byUser {
+		msg.Participants = append(msg.Participants, p)
+	}
+	sort.Slice(msg.Participants, func(i, j int) bool {
+		return msg.Participants[i].JoinedAt.Before(msg.Participants[j].JoinedAt)
+	})
+	if hub.controller != nil {
+		msg.Controller = hub.controller.userID
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	for c := range hub.clients {
+		hub.enqueueLocked(c, wsFrame{msgType: websocket.TextMessage, data: data})
+	}
+}
+
+// acquireInput decides whether a client's input reaches the terminal. Viewers
+// never type. Editors take control when nobody holds it or the holder has
+// been idle for controlIdleTimeout; the owner always wins.
+func (hub *sessionHub) acquireInput(c *client) bool {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if !c.canWrite() {
+		return false
+	}
+
+	now := time.Now()
+	if hub.controller != nil && hub.controller != c && c.role != roleOwner &&
+		now.Sub(hub.lastInput) < controlIdleTimeout {
+		return false
+	}
+
+	changed := hub.controller != c
+	hub.controller = c
+	hub.lastInput = now
+	if changed {
+		hub.broadcastPresenceLocked()
+	}
+	return true
+}
+
+// canResize reports whether a client may change the shared PTY size: the
+// current controller, or any writer while nobody holds control.
+func (hub *sessionHub) canResize(c *client) bool {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if !c.canWrite() {
+		return false
+	}
+	return hub.controller == nil || hub.controller == c || c.role == roleOwner
+}
+
+func (hub *sessionHub) writeTtyd(data []byte) error {
+	hub.ttydMu.Lock()
+	defer hub.ttydMu.Unlock()
+	return hub.ttyd.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func (hub *sessionHub) writeResize(columns, rows int) error {
+	resizeJSON := fmt.Sprintf(`{"columns":%d,"rows":%d}`, columns, rows)
+	return hub.writeTtyd(append([]byte{ttydResizeTerminal}, []byte(resizeJSON)...))
+}
+
+// forwardClientToTtyd reads a client's messages and wraps them as ttyd
+// binary messages. Supports two message types from the frontend:
+//   - JSON with "type":"resize" → ttyd RESIZE_TERMINAL message
+//   - Everything else → ttyd INPUT message
+//
+// Messages the client is not allowed to send (viewer input, resizes while
+// someone else holds control) are dropped silently.
+func (hub *sessionHub) forwardClientToTtyd(c *client) {
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			if err != io.EOF && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Debug().Err(err).Msg("error reading message (client->ttyd)")
+			}
+			countTimeout("client", err)
+			return
+		}
+		// Refresh read deadline on successful read (data = activity)
+		c.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+
+		// Check if this is a resize message from the frontend
+		if len(message) > 0 && message[0] == '{' {
+			var msg struct {
+				Type    string `json:"type"`
+				Columns int    `json:"columns"`
+				Rows    int    `json:"rows"`
+			}
+			if json.Unmarshal(message, &msg) == nil && msg.Type == "resize" {
+				if msg.Columns <= 0 || msg.Rows <= 0 || !hub.canResize(c) {
+					continue
+				}
+				hub.mu.Lock()
+				hub.columns, hub.rows = msg.Columns, msg.Rows
+				hub.mu.Unlock()
+				if err := hub.writeResize(msg.Columns, msg.Rows); err != nil {
+					log.Debug().Err(err).Msg("error writing resize (client->ttyd)")
+					return
+				}
+				continue
+			}
+		}
+
+		if !hub.acquireInput(c) {
+			continue
+		}
+		hub.recordInput()
+
+		// Wrap as ttyd INPUT message: ASCII '0' + data
+		wrapped := make([]byte, len(message)+1)
+		wrapped[0] = ttydInput
+		copy(wrapped[1:], message)
+
+		if err := hub.writeTtyd(wrapped); err != nil {
+			log.Debug().Err(err).Msg("error writing message (client->ttyd)")
+			return
+		}
+		bytesTotal.WithLabelValues("in").Add(float64(len(message)))
+	}
+}
+
+// forwardTtydToClients extracts terminal output from ttyd binary messages and
+// fans it out to every attached client.
+func (hub *sessionHub) forwardTtydToClients() {
+	for {
+		_, message, err := hub.ttyd.ReadMessage()
+		if err != nil {
+			if err != io.EOF && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Debug().Err(err).Msg("error reading message (ttyd->client)")
+			}
+			countTimeout("ttyd", err)
+			return
+		}
+		// Refresh read deadline on successful read (data = activity)
+		hub.ttyd.SetReadDeadline(time.Now().Add(pongTimeout))
+
+		if len(message) < 1 {
+			continue
+		}
+
+		msgType := message[0]
+		payload := message[1:]
+
+		switch msgType {
+		case ttydOutput: // Terminal output - forward as binary to preserve raw PTY bytes
+			hub.broadcast(wsFrame{msgType: websocket.BinaryMessage, data: payload})
+		case ttydSetWindowTitle, ttydSetPreferences:
+			// Ignore window title and preferences messages
+		default:
+			log.Debug().Uint8("type", msgType).Msg("skipping unknown ttyd message type")
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/admin"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// activityFlushInterval throttles last_active writes while the user types.
+	activityFlushInterval = 30 * time.Second
+
+	// idleCheckInterval is how often a hub re-reads its session state to
+	// warn clients about an upcoming idle stop.
+	idleCheckInterval = 30 * time.Second
+
+	// idleConfigTTL is how long a hub caches the owner's idle policy.
+	idleConfigTTL = 5 * time.Minute
+)
+
+// idleMessage is sent as a JSON text frame: "warning" before the idle
+// reaper stops the session, "stopped" once it did.
+type idleMessage struct {
+	Type        string `json:"type"` // always "idle"
+	State       string `json:"state"`
+	SecondsLeft int    `json:"seconds_left,omitempty"`
+}
+
+// recordInput refreshes sessions.last_active, at most once per
+// activityFlushInterval. Input into an idle session marks it running again.
+func (hub *sessionHub) recordInput() {
+	hub.mu.Lock()
+	now := time.Now()
+	if now.Sub(hub.lastFlush) < activityFlushInterval {
+		hub.mu.Unlock()
+		return
+	}
+	hub.lastFlush = now
+	hub.mu.Unlock()
+
+	go func() {
+		_, err := hub.db.NewUpdate().
+			Model((*models.Session)(nil)).
+			Set("last_active = ?", now).
+			Set("status = ?", models.SessionStatusRunning).
+			Where("id = ?", hub.dbID).
+			Where("status IN (?)", bun.In([]models.SessionStatus{models.SessionStatusRunning, models.SessionStatusIdle})).
+			Exec(context.Background())
+		if err != nil {
+			log.Warn().Err(err).Str("session_id", hub.sessionID).Msg("failed to update last_active")
+		}
+	}()
+}
+
+// watchIdle warns attached clients ahead of the idle stop and tells them when
+// the session was stopped. The stop itself is done by the gateway's reaper.
+func (hub *sessionHub) watchIdle() {
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+
+	var (
+		cfg        admin.IdleConfig
+		cfgLoaded  time.Time
+		warnedFor  time.Time // last_active value the warning was sent for
+		stoppedMsg bool
+	)
+	ctx := context.Background()
+
+	for {
+		select {
+		case <-hub.done:
+			return
+		case <-ticker.C:
+		}
+
+		if time.Since(cfgLoaded) > idleConfigTTL {
+			cfg = hub.settings.GetIdleConfig(ctx, hub.ownerID)
+			cfgLoaded = time.Now()
+		}
+		if cfg.Timeout == 0 {
+			continue
+		}
+
+		var sess models.Session
+		err := hub.db.NewSelect().
+			Model(&sess).
+			Column("status", "last_active").
+			Where("id = ?", hub.dbID).
+			Scan(ctx)
+		if err != nil {
+			continue
+		}
+
+		switch sess.Status {
+		case models.SessionStatusIdle:
+			if !stoppedMsg {
+				stoppedMsg = true
+				hub.sendIdle(idleMessage{Type: "idle", State: "stopped"})
+			}
+		case models.SessionStatusRunning:
+			stoppedMsg = false
+			left := time.Until(sess.LastActive.Add(cfg.Timeout))
+			if cfg.Warning > 0 && left > 0 && left <= cfg.Warning && !warnedFor.Equal(sess.LastActive) {
+				warnedFor = sess.LastActive
+				hub.sendIdle(idleMessage{Type: "idle", State: "warning", SecondsLeft: int(left.Seconds())})
+			}
+		}
+	}
+}
+
+func (hub *sessionHub) sendIdle(msg idleMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	hub.broadcast(wsFrame{msgType: websocket.TextMessage, data: data})
+}
@@ -0,0 +1,108 @@
+package websocket
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+// SetLimits configures connection caps of this proxy replica. Zero disables
+// a cap.
+func (h *ProxyHandler) SetLimits(maxConnections, maxPerUser int) {
+	h.connMu.Lock()
+	defer h.connMu.Unlock()
+	h.maxConnections = maxConnections
+	h.maxPerUser = maxPerUser
+}
+
+// acquireConn reserves a connection slot for a user. It returns "" on
+// success or the rejection reason.
+func (h *ProxyHandler) acquireConn(userID int64) string {
+	h.connMu.Lock()
+	defer h.connMu.Unlock()
+
+	switch {
+	case h.draining.Load():
+		return "draining"
+	case h.maxConnections > 0 && h.totalConns >= h.maxConnections:
+		return "global_limit"
+	case h.maxPerUser > 0 && h.userConns[userID] >= h.maxPerUser:
+		return "user_limit"
+	}
+	h.totalConns++
+	h.userConns[userID]++
+	return ""
+}
+
+func (h *ProxyHandler) releaseConn(userID int64) {
+	h.connMu.Lock()
+	defer h.connMu.Unlock()
+	h.totalConns--
+	if h.userConns[userID]--; h.userConns[userID] <= 0 {
+		delete(h.userConns, userID)
+	}
+}
+
+// trackActive updates the active connection gauge of a user/agent pair and
+// drops the series once its last connection closed.
+func (h *ProxyHandler) trackActive(userID, agentID int64, delta int) {
+	key := [2]int64{userID, agentID}
+	labels := []string{strconv.FormatInt(userID, 10), strconv.FormatInt(agentID, 10)}
+
+	h.connMu.Lock()
+	defer h.connMu.Unlock()
+	h.agentConns[key] += delta
+	if h.agentConns[key] <= 0 {
+		delete(h.agentConns, key)
+		activeConnections.DeleteLabelValues(labels...)
+		return
+	}
+	activeConnections.WithLabelValues(labels...).Set(float64(h.agentConns[key]))
+}
+
+// Drain stops accepting connections and waits for open terminals to close
+// on their own until ctx expires. Remaining clients are then closed with
+// 1012 (service restart) so they reconnect to another replica; the terminal
+// session survives in the pod's dtach.
+func (h *ProxyHandler) Drain(ctx context.Context) {
+	h.draining.Store(true)
+	draining.Set(1)
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+wait:
+	for {
+		h.connMu.Lock()
+		open := h.totalConns
+		h.connMu.Unlock()
+		if open == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			break wait
+		case <-ticker.C:
+		}
+	}
+
+	h.hubsMu.Lock()
+	hubs := make([]*sessionHub, 0, len(h.hubs))
+	for _, hub := range h.hubs {
+		hubs = append(hubs, hub)
+	}
+	h.hubsMu.Unlock()
+
+	log.Info().Int("sessions", len(hubs)).Msg("drain timeout reached, closing remaining terminals")
+	msg := websocket.FormatCloseMessage(websocket.CloseServiceRestart, "server restarting")
+	for _, hub := range hubs {
+		hub.mu.Lock()
+		for c := range hub.clients {
+			_ = c.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+		}
+		hub.mu.Unlock()
+		hub.close()
+	}
+}
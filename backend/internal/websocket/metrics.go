@@ -0,0 +1,64 @@
+package websocket
+
+import (
+	"errors"
+	"net"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const metricsNamespace = "sac_ws_proxy"
+
+var (
+	activeConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "active_connections",
+		Help:      "Open terminal WebSocket connections.",
+	}, []string{"user_id", "agent_id"})
+
+	bytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "bytes_total",
+		Help:      "Terminal bytes proxied; in = client to ttyd, out = proxy to client.",
+	}, []string{"direction"})
+
+	dialDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "ttyd_dial_duration_seconds",
+		Help:      "Latency of dialing ttyd in the agent pod, including the auth handshake.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"result"})
+
+	handshakeFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "handshake_failures_total",
+		Help:      "Terminal connections that failed before attaching to ttyd, by reason.",
+	}, []string{"reason"})
+
+	connectionsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "connections_rejected_total",
+		Help:      "Terminal connections refused by connection caps or draining, by reason.",
+	}, []string{"reason"})
+
+	heartbeatTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "heartbeat_timeouts_total",
+		Help:      "Connections closed because the peer stopped answering pings.",
+	}, []string{"peer"})
+
+	draining = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "draining",
+		Help:      "1 while the proxy is draining connections for shutdown.",
+	})
+)
+
+// countTimeout records a heartbeat timeout if err is a read deadline expiry.
+func countTimeout(peer string, err error) {
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		heartbeatTimeouts.WithLabelValues(peer).Inc()
+	}
+}
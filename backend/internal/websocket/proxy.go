@@ -4,12 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"github.com/rs/zerolog/log"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"g.echo.tech/dev/sac/internal/admin"
 	"g.echo.tech/dev/sac/internal/auth"
 	"g.echo.tech/dev/sac/internal/database"
 	"g.echo.tech/dev/sac/internal/models"
@@ -24,18 +25,46 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
+// defaultTtydPort is where ttyd listens inside session pods.
+const defaultTtydPort = 7681
+
 type ProxyHandler struct {
 	db         *bun.DB
 	jwtService *auth.JWTService
+	settings   *admin.SettingsService
+	ttydPort   int
+
+	// hubs holds one shared ttyd connection per live session.
+	hubsMu sync.Mutex
+	hubs   map[string]*sessionHub
+
+	// Connection accounting for caps and metrics (this replica only).
+	connMu         sync.Mutex
+	maxConnections int
+	maxPerUser     int
+	totalConns     int
+	userConns      map[int64]int
+	agentConns     map[[2]int64]int
+	draining       atomic.Bool
 }
 
 func NewProxyHandler(db *bun.DB, jwtService *auth.JWTService) *ProxyHandler {
 	return &ProxyHandler{
 		db:         db,
 		jwtService: jwtService,
+		settings:   admin.NewSettingsService(db),
+		ttydPort:   defaultTtydPort,
+		hubs:       make(map[string]*sessionHub),
+		userConns:  make(map[int64]int),
+		agentConns: make(map[[2]int64]int),
 	}
 }
 
+// SetTtydPort overrides the port ttyd listens on inside session pods.
+func (h *ProxyHandler) SetTtydPort(port int) {
+	h.ttydPort = port
+}
+
 // getConfigKeys returns the keys of the agent config map
 func getConfigKeys(config map[string]interface{}) []string {
 	if config == nil {
@@ -55,12 +84,14 @@ func (h *ProxyHandler) HandleWebSocket(c *gin.Context) {
 	// Authenticate via JWT token in query param
 	token := c.Query("token")
 	if token == "" {
+		handshakeFailures.WithLabelValues("auth").Inc()
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "token query parameter required"})
 		return
 	}
 
 	claims, err := h.jwtService.ValidateToken(token)
 	if err != nil {
+		handshakeFailures.WithLabelValues("auth").Inc()
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
 		return
 	}
@@ -76,11 +107,28 @@ func (h *ProxyHandler) HandleWebSocket(c *gin.Context) {
 	// Upgrade connection to WebSocket
 	clientConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
+		handshakeFailures.WithLabelValues("upgrade").Inc()
 		log.Warn().Err(err).Msg("failed to upgrade WebSocket connection")
 		return
 	}
 	defer clientConn.Close()
 
+	// Connection caps. Rejections are reported in the terminal, since
+	// browsers cannot read the HTTP status of a failed WebSocket upgrade.
+	if reason := h.acquireConn(claims.UserID); reason != "" {
+		connectionsRejected.WithLabelValues(reason).Inc()
+		log.Warn().Str("user_id", userID).Str("reason", reason).Msg("terminal connection rejected")
+		text := "Error: Too many open terminals, close another tab and retry"
+		code := websocket.ClosePolicyViolation
+		if reason == "draining" {
+			text, code = "Error: Server is restarting, reconnecting...", websocket.CloseServiceRestart
+		}
+		clientConn.WriteMessage(websocket.TextMessage, []byte(text))
+		clientConn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+		return
+	}
+	defer h.releaseConn(claims.UserID)
+
 	log.Info().Str("user_id", userID).Str("session_id", sessionID).Str("agent_id", agentIDStr).Msg("client connected")
 
 	// Get Pod IP from database
@@ -109,25 +157,37 @@ func (h *ProxyHandler) HandleWebSocket(c *gin.Context) {
 		Scan(ctx)
 
 	if err != nil {
+		handshakeFailures.WithLabelValues("session_not_found").Inc()
 		log.Warn().Err(err).Msg("failed to find session")
 		clientConn.WriteMessage(websocket.TextMessage, []byte("Error: Session not found"))
 		return
 	}
 
 	if session.PodIP == "" {
+		handshakeFailures.WithLabelValues("pod_not_ready").Inc()
 		log.Warn().Str("session_id", sessionID).Msg("pod IP not available")
 		clientConn.WriteMessage(websocket.TextMessage, []byte("Error: Pod is not ready yet"))
 		return
 	}
 
+	role, err := resolveRole(ctx, h.db, session.SessionID, session.UserID, claims.UserID)
+	if err != nil {
+		handshakeFailures.WithLabelValues("forbidden").Inc()
+		log.Warn().Err(err).Str("user_id", userID).Str("session_id", sessionID).Msg("session access denied")
+		clientConn.WriteMessage(websocket.TextMessage, []byte("Error: Access to this session is not allowed"))
+		return
+	}
+
 	// Step 1: Wait for client's first message to get actual terminal dimensions.
 	// The frontend sends a JSON resize message immediately on connection:
 	//   {"type":"resize","columns":N,"rows":N}
 	// We use these dimensions in the ttyd auth handshake so the PTY starts
-	// at the correct size, preventing output misalignment.
+	// at the correct size, preventing output misalignment. Clients joining
+	// an already shared session reuse the existing PTY size.
 	columns, rows := 100, 30 // sensible defaults
 	_, firstMsg, err := clientConn.ReadMessage()
 	if err != nil {
+		handshakeFailures.WithLabelValues("initial_message").Inc()
 		log.Warn().Err(err).Msg("failed to read initial message from client")
 		return
 	}
@@ -144,31 +204,41 @@ func (h *ProxyHandler) HandleWebSocket(c *gin.Context) {
 		}
 	}
 
-	// Connect to ttyd in the pod
-	ttydURL := fmt.Sprintf("ws://%s:7681/ws", session.PodIP)
-	log.Debug().Str("url", ttydURL).Msg("connecting to ttyd")
+	cl := &client{
+		conn:     clientConn,
+		userID:   claims.UserID,
+		username: claims.Username,
+		role:     role,
+		joinedAt: time.Now(),
+		send:     make(chan wsFrame, clientSendBuffer),
+	}
 
-	// ttyd requires the "tty" WebSocket subprotocol
-	ttydHeaders := http.Header{}
-	ttydHeaders.Set("Sec-WebSocket-Protocol", "tty")
-	ttydConn, _, err := websocket.DefaultDialer.Dial(ttydURL, ttydHeaders)
-	if err != nil {
-		log.Warn().Err(err).Msg("failed to connect to ttyd")
-		clientConn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("Error: Failed to connect to container: %v", err)))
+	// Attach to the session's shared ttyd connection, dialing it if this is
+	// the first client. A hub that is shutting down is retried once.
+	var hub *sessionHub
+	for attempt := 0; attempt < 2 && hub == nil; attempt++ {
+		hub, err = h.getOrCreateHub(&session, columns, rows)
+		if err != nil {
+			handshakeFailures.WithLabelValues("dial").Inc()
+			log.Warn().Err(err).Msg("failed to connect to ttyd")
+			clientConn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("Error: %v", err)))
+			return
+		}
+		if !hub.join(cl) {
+			hub = nil
+		}
+	}
+	if hub == nil {
+		handshakeFailures.WithLabelValues("hub_closed").Inc()
+		clientConn.WriteMessage(websocket.TextMessage, []byte("Error: Session is shutting down"))
 		return
 	}
-	defer ttydConn.Close()
+	defer hub.leave(cl)
 
-	log.Debug().Msg("connected to ttyd")
+	h.trackActive(session.UserID, session.AgentID, 1)
+	defer h.trackActive(session.UserID, session.AgentID, -1)
 
-	// Send authentication handshake with the client's actual terminal dimensions
-	authMsg := fmt.Sprintf(`{"AuthToken":"","columns":%d,"rows":%d}`, columns, rows)
-	if err := ttydConn.WriteMessage(websocket.BinaryMessage, []byte(authMsg)); err != nil {
-		log.Warn().Err(err).Msg("failed to send auth handshake")
-		clientConn.WriteMessage(websocket.TextMessage, []byte("Error: Failed to authenticate with container"))
-		return
-	}
-	log.Debug().Int("columns", columns).Int("rows", rows).Msg("sent ttyd auth handshake")
+	log.Debug().Str("role", role).Msg("attached to ttyd")
 
 	// Update last active time
 	_, err = h.db.NewUpdate().
@@ -183,46 +253,19 @@ func (h *ProxyHandler) HandleWebSocket(c *gin.Context) {
 	// Enable ping/pong heartbeat to prevent idle disconnections (Envoy/NAT timeout).
 	// PongHandler refreshes the read deadline on each pong response so the
 	// connection stays alive as long as the peer is responsive.
-	const heartbeatInterval = 30 * time.Second
-	const pongTimeout = 60 * time.Second
-
 	clientConn.SetReadDeadline(time.Now().Add(pongTimeout))
 	clientConn.SetPongHandler(func(string) error {
 		clientConn.SetReadDeadline(time.Now().Add(pongTimeout))
 		return nil
 	})
 
-	ttydConn.SetReadDeadline(time.Now().Add(pongTimeout))
-	ttydConn.SetPongHandler(func(string) error {
-		ttydConn.SetReadDeadline(time.Now().Add(pongTimeout))
-		return nil
-	})
-
-	// Start heartbeat goroutines for both directions
 	go h.StartHeartbeat(clientConn, heartbeatInterval)
-	go h.StartHeartbeat(ttydConn, heartbeatInterval)
-
-	// Start bidirectional forwarding with ttyd protocol translation
-	var wg sync.WaitGroup
-	wg.Add(2)
-
-	// Forward messages from client to ttyd (wrap as ttyd INPUT messages)
-	go func() {
-		defer wg.Done()
-		defer ttydConn.Close()
-		h.forwardClientToTtyd(clientConn, ttydConn)
-	}()
-
-	// Forward messages from ttyd to client (extract terminal output)
-	go func() {
-		defer wg.Done()
-		defer clientConn.Close()
-		h.forwardTtydToClient(ttydConn, clientConn)
-	}()
-
-	// Wait for both directions to complete
-	wg.Wait()
-	log.Info().Str("session_id", sessionID).Msg("WebSocket proxy closed")
+	go cl.writePump()
+
+	// Forward messages from client to ttyd (wrap as ttyd INPUT messages);
+	// ttyd output reaches this client through the hub's fan-out.
+	hub.forwardClientToTtyd(cl)
+	log.Info().Str("session_id", sessionID).Str("user_id", userID).Msg("WebSocket proxy closed")
 }
 
 // ttyd WebSocket protocol uses ASCII character bytes as message type prefixes.
@@ -245,86 +288,6 @@ const (
 	ttydSetPreferences byte = '2' // Server -> Client: set preferences
 )
 
-// forwardClientToTtyd wraps client messages as ttyd binary messages.
-// Supports two message types from the frontend:
-//   - JSON with "type":"resize" → ttyd RESIZE_TERMINAL message
-//   - Everything else → ttyd INPUT message
-func (h *ProxyHandler) forwardClientToTtyd(src, dst *websocket.Conn) {
-	for {
-		_, message, err := src.ReadMessage()
-		if err != nil {
-			if err != io.EOF && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
-				log.Debug().Err(err).Msg("error reading message (client->ttyd)")
-			}
-			return
-		}
-		// Refresh read deadline on successful read (data = activity)
-		src.SetReadDeadline(time.Now().Add(60 * time.Second))
-
-		// Check if this is a resize message from the frontend
-		if len(message) > 0 && message[0] == '{' {
-			var msg struct {
-				Type    string `json:"type"`
-				Columns int    `json:"columns"`
-				Rows    int    `json:"rows"`
-			}
-			if json.Unmarshal(message, &msg) == nil && msg.Type == "resize" {
-				resizeJSON := fmt.Sprintf(`{"columns":%d,"rows":%d}`, msg.Columns, msg.Rows)
-				wrapped := append([]byte{ttydResizeTerminal}, []byte(resizeJSON)...)
-				if err := dst.WriteMessage(websocket.BinaryMessage, wrapped); err != nil {
-					log.Debug().Err(err).Msg("error writing resize (client->ttyd)")
-					return
-				}
-				continue
-			}
-		}
-
-		// Wrap as ttyd INPUT message: ASCII '0' + data
-		wrapped := make([]byte, len(message)+1)
-		wrapped[0] = ttydInput
-		copy(wrapped[1:], message)
-
-		if err := dst.WriteMessage(websocket.BinaryMessage, wrapped); err != nil {
-			log.Debug().Err(err).Msg("error writing message (client->ttyd)")
-			return
-		}
-	}
-}
-
-// forwardTtydToClient extracts terminal output from ttyd binary messages and forwards as text
-func (h *ProxyHandler) forwardTtydToClient(src, dst *websocket.Conn) {
-	for {
-		_, message, err := src.ReadMessage()
-		if err != nil {
-			if err != io.EOF && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
-				log.Debug().Err(err).Msg("error reading message (ttyd->client)")
-			}
-			return
-		}
-		// Refresh read deadline on successful read (data = activity)
-		src.SetReadDeadline(time.Now().Add(60 * time.Second))
-
-		if len(message) < 1 {
-			continue
-		}
-
-		msgType := message[0]
-		payload := message[1:]
-
-		switch msgType {
-		case ttydOutput: // Terminal output - forward as binary to preserve raw PTY bytes
-			if err := dst.WriteMessage(websocket.BinaryMessage, payload); err != nil {
-				log.Debug().Err(err).Msg("error writing message (ttyd->client)")
-				return
-			}
-		case ttydSetWindowTitle, ttydSetPreferences:
-			// Ignore window title and preferences messages
-		default:
-			log.Debug().Uint8("type", msgType).Msg("skipping unknown ttyd message type")
-		}
-	}
-}
-
 // StartHeartbeat starts a heartbeat goroutine to keep connection alive
 func (h *ProxyHandler) StartHeartbeat(conn *websocket.Conn, interval time.Duration) {
 	ticker := time.NewTicker(interval)
@@ -338,8 +301,14 @@ func (h *ProxyHandler) StartHeartbeat(conn *websocket.Conn, interval time.Durati
 	}
 }
 
-// HealthCheck checks if the proxy service is healthy
+// HealthCheck checks if the proxy service is healthy. A draining proxy
+// reports unhealthy so it is taken out of the load balancer.
 func (h *ProxyHandler) HealthCheck(c *gin.Context) {
+	if h.draining.Load() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "draining"})
+		return
+	}
+
 	ctx := context.Background()
 	if err := database.HealthCheck(ctx); err != nil {
 		c.JSON(http.StatusServiceUnavailable, gin.H{
@@ -0,0 +1,171 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// ShareRevokedChannel is the Redis Pub/Sub channel the API gateway
+// publishes a session ID on when one of its share grants is revoked.
+const ShareRevokedChannel = "sac:session-share-revoked"
+
+var errNoShareGrant = errors.New("no active share grant for user")
+
+// resolveRole determines how a user participates in a session: the owner has
+// full control, everyone else needs an unexpired share grant addressed to
+// them directly or to one of their groups. A read_write grant outranks a
+// read_only one when both apply.
+func resolveRole(ctx context.Context, db *bun.DB, sessionID string, ownerID, userID int64) (string, error) {
+	if ownerID == userID {
+		return roleOwner, nil
+	}
+
+	var modes []string
+	err := db.NewSelect().
+		Model((*models.SessionShare)(nil)).
+		Column("mode").
+		Where("session_id = ?", sessionID).
+		Where("owner_id = ?", ownerID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("? = ANY(user_ids)", userID).
+				WhereOr("group_id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID)
+		}).
+		Scan(ctx, &modes)
+	if err != nil {
+		return "", err
+	}
+	if len(modes) == 0 {
+		return "", errNoShareGrant
+	}
+
+	for _, m := range modes {
+		if models.SessionShareMode(m) == models.SessionShareReadWrite {
+			return roleEditor, nil
+		}
+	}
+	return roleViewer, nil
+}
+
+// accessCheckInterval is how often a hub re-resolves the role of every
+// attached participant, so revoked or expired share grants take effect on
+// open terminals and not only on the next connect.
+const accessCheckInterval = 15 * time.Second
+
+// watchAccess periodically rechecks the share grants of attached clients
+// until the hub closes.
+func (hub *sessionHub) watchAccess() {
+	ticker := time.NewTicker(accessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-hub.done:
+			return
+		case <-ticker.C:
+		}
+		hub.recheckAccess(context.Background())
+	}
+}
+
+// RecheckAccess re-resolves the participants of a live session right away
+// instead of waiting for the next periodic check. It is a no-op when the
+// session has no open terminal on this replica.
+func (h *ProxyHandler) RecheckAccess(ctx context.Context, sessionID string) {
+	h.hubsMu.Lock()
+	hub, ok := h.hubs[sessionID]
+	h.hubsMu.Unlock()
+	if !ok {
+		return
+	}
+	<-hub.ready
+	if hub.err == nil {
+		hub.recheckAccess(ctx)
+	}
+}
+
+// WatchShareRevocations rechecks the participants of a session as soon as
+// one of its share grants is revoked, until ctx is done. The periodic check
+// still covers grants that expire or messages lost while Redis is down.
+func (h *ProxyHandler) WatchShareRevocations(ctx context.Context, rdb *redis.Client) {
+	pubsub := rdb.Subscribe(ctx, ShareRevokedChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			h.RecheckAccess(ctx, msg.Payload)
+		}
+	}
+}
+
+// recheckAccess re-resolves the role of every shared participant. Clients
+// whose grant is gone are disconnected, downgraded editors lose the
+// keyboard. Lookup errors keep the current role so a database hiccup does
+// not kick everybody out.
+func (hub *sessionHub) recheckAccess(ctx context.Context) {
+	hub.mu.Lock()
+	users := make(map[int64]struct{})
+	for c := range hub.clients {
+		if c.userID != hub.ownerID {
+			users[c.userID] = struct{}{}
+		}
+	}
+	hub.mu.Unlock()
+	if len(users) == 0 {
+		return
+	}
+
+	roles := make(map[int64]string, len(users))
+	for userID := range users {
+		role, err := resolveRole(ctx, hub.db, hub.sessionID, hub.ownerID, userID)
+		switch {
+		case errors.Is(err, errNoShareGrant):
+			roles[userID] = ""
+		case err != nil:
+			log.Warn().Err(err).Str("session_id", hub.sessionID).Int64("user_id", userID).Msg("failed to recheck session access")
+		default:
+			roles[userID] = role
+		}
+	}
+
+	hub.mu.Lock()
+	changed := false
+	for c := range hub.clients {
+		role, ok := roles[c.userID]
+		if !ok || role == c.role {
+			continue
+		}
+		changed = true
+		if role == "" {
+			log.Info().Str("session_id", hub.sessionID).Int64("user_id", c.userID).Msg("session access revoked, disconnecting client")
+			hub.kickLocked(c, "Error: Access to this session was revoked")
+			continue
+		}
+		c.role = role
+		if hub.controller == c && !c.canWrite() {
+			hub.controller = nil
+		}
+	}
+	empty := len(hub.clients) == 0
+	if changed && !empty {
+		hub.broadcastPresenceLocked()
+	}
+	hub.mu.Unlock()
+
+	if changed && empty {
+		hub.close()
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/auth"
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/internal/storage"
 	"g.echo.tech/dev/sac/pkg/protobind"
 	"g.echo.tech/dev/sac/pkg/response"
@@ -28,17 +30,39 @@ func contentTypeByFilename(fileName string) string {
 	return "application/octet-stream"
 }
 
-// Handler serves workspace HTTP endpoints (output download, internal upload, shared files).
+// attachmentDisposition builds a Content-Disposition header for downloading
+// a file under name. Quotes and control characters are escaped and
+// non-ASCII names are encoded per RFC 2231.
+func attachmentDisposition(name string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": name})
+}
+
+// Handler serves workspace HTTP endpoints (output download, input upload, internal upload, shared files).
 type Handler struct {
-	db       *bun.DB
-	provider *storage.StorageProvider
-	hub      *OutputHub
-	jwt      *auth.JWTService
+	db           *bun.DB
+	provider     *storage.StorageProvider
+	hub          *OutputHub
+	jwt          *auth.JWTService
+	containerMgr *container.Manager
+	notifier     *notify.Notifier
+
+	shareGuard *ShareGuard
 }
 
 // NewHandler creates a new workspace handler.
-func NewHandler(db *bun.DB, provider *storage.StorageProvider, hub *OutputHub, jwt *auth.JWTService) *Handler {
-	return &Handler{db: db, provider: provider, hub: hub, jwt: jwt}
+func NewHandler(db *bun.DB, provider *storage.StorageProvider, hub *OutputHub, jwt *auth.JWTService, containerMgr *container.Manager) *Handler {
+	return &Handler{db: db, provider: provider, hub: hub, jwt: jwt, containerMgr: containerMgr, shareGuard: NewShareGuard(nil)}
+}
+
+// SetShareGuard replaces the handler's own share password guard, so it can
+// be shared with the gRPC server and across replicas.
+func (h *Handler) SetShareGuard(guard *ShareGuard) {
+	h.shareGuard = guard
+}
+
+// SetNotifier enables user notifications for agent-side output changes.
+func (h *Handler) SetNotifier(notifier *notify.Notifier) {
+	h.notifier = notifier
 }
 
 // requireOSS is a middleware that checks if storage is configured.
@@ -0,0 +1,362 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/convert"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/pkg/protobind"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxResumableUploadSize = 2 << 30  // 2GB
+	maxUploadChunkSize     = 16 << 20 // 16MB
+	uploadExpiry           = 24 * time.Hour
+)
+
+// uploadChunkPrefix returns the OSS key prefix holding the chunks of a
+// resumable upload until it is completed.
+func uploadChunkPrefix(userID, agentID int64, uploadID string) string {
+	return fmt.Sprintf("users/%d/agents/%d/uploads/%s/", userID, agentID, uploadID)
+}
+
+// RegisterInputRoutes registers the input workspace upload routes (JWT required).
+func (h *Handler) RegisterInputRoutes(ws *gin.RouterGroup) {
+	in := ws.Group("/input", h.requireOSS())
+	{
+		in.POST("/files", h.UploadInputFile)
+		in.POST("/uploads", h.CreateInputUpload)
+		in.GET("/uploads/:id", h.GetInputUpload)
+		in.PUT("/uploads/:id", h.UploadInputChunk)
+		in.POST("/uploads/:id/complete", h.CompleteInputUpload)
+		in.DELETE("/uploads/:id", h.AbortInputUpload)
+	}
+}
+
+// UploadInputFile handles a single multipart upload into the input workspace
+// and streams it into the running agent pod.
+func (h *Handler) UploadInputFile(c *gin.Context) {
+	oss := h.getOSS(c)
+	userID, _ := c.Get("userID")
+	userIDInt := userID.(int64)
+
+	agentID, ok := parseAgentID(c)
+	if !ok {
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		response.BadRequest(c, "No file provided", err)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxUploadSize {
+		response.BadRequest(c, fmt.Sprintf("File too large: %d bytes (max %d), use a resumable upload", header.Size, maxUploadSize))
+		return
+	}
+
+	filePath, err := inputFilePath(c.PostForm("path"), header.Filename)
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = contentTypeByFilename(filePath)
+	}
+
+	ctx := context.Background()
+	wf, err := saveInputFile(ctx, h.db, oss, userIDInt, agentID, filePath, contentType, header.Size, file)
+	if err != nil {
+		quotaHTTPError(c, "Failed to upload file", err)
+		return
+	}
+
+	// The multipart file is spooled locally, so rewind it instead of
+	// reading the object back from storage.
+	podSynced := false
+	if _, err := file.Seek(0, io.SeekStart); err == nil {
+		podSynced = h.pushToPod(ctx, userIDInt, agentID, filePath, header.Size, file)
+	}
+
+	protobind.Created(c, &sacv1.InputFileResponse{File: convert.WorkspaceFileToProto(wf), PodSynced: podSynced})
+}
+
+// pushToPod streams an input file into the agent pod. A pod that is not
+// running is not an error: the file is restored when the pod next starts.
+func (h *Handler) pushToPod(ctx context.Context, userID, agentID int64, filePath string, size int64, r io.Reader) bool {
+	if err := pushInputFile(ctx, h.containerMgr, userID, agentID, filePath, size, r); err != nil {
+		log.Info().Err(err).Int64("user_id", userID).Int64("agent_id", agentID).Str("path", filePath).
+			Msg("input file stored but not pushed to pod")
+		return false
+	}
+	return true
+}
+
+// getUpload loads a live resumable upload owned by the current user.
+func (h *Handler) getUpload(c *gin.Context) (*models.WorkspaceUpload, bool) {
+	userID, _ := c.Get("userID")
+
+	var upload models.WorkspaceUpload
+	err := h.db.NewSelect().Model(&upload).
+		Where("id = ? AND user_id = ?", c.Param("id"), userID.(int64)).
+		Where("updated_at > ?", time.Now().Add(-uploadExpiry)).
+		Scan(c.Request.Context())
+	if err != nil {
+		response.NotFound(c, "Upload not found or expired")
+		return nil, false
+	}
+	return &upload, true
+}
+
+func uploadToProto(u *models.WorkspaceUpload) *sacv1.InputUpload {
+	return &sacv1.InputUpload{
+		UploadId:      u.ID,
+		AgentId:       u.AgentID,
+		Path:          u.FilePath,
+		SizeBytes:     u.SizeBytes,
+		Offset:        u.ReceivedBytes,
+		MaxChunkBytes: maxUploadChunkSize,
+		ExpiresAt:     timestamppb.New(u.UpdatedAt.Add(uploadExpiry)),
+	}
+}
+
+// CreateInputUpload starts a resumable upload. The client then PUTs chunks
+// at the returned offset and calls complete once all bytes are sent.
+func (h *Handler) CreateInputUpload(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDInt := userID.(int64)
+
+	req := &sacv1.CreateInputUploadRequest{}
+	if !protobind.Bind(c, req) {
+		return
+	}
+	if req.AgentId <= 0 {
+		response.BadRequest(c, "invalid agent_id")
+		return
+	}
+	if req.SizeBytes <= 0 || req.SizeBytes > maxResumableUploadSize {
+		response.BadRequest(c, fmt.Sprintf("size_bytes must be between 1 and %d", int64(maxResumableUploadSize)))
+		return
+	}
+	filePath, err := inputFilePath(req.Path, req.FileName)
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Fail before any chunk is sent; completion checks again.
+	ossKey := inputOSSKeyPrefix(userIDInt, req.AgentId) + filePath
+	if err := precheckQuota(c.Request.Context(), h.db, userIDInt, req.AgentId, nil, ossKey, req.SizeBytes); err != nil {
+		quotaHTTPError(c, "Failed to check workspace quota", err)
+		return
+	}
+
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = contentTypeByFilename(filePath)
+	}
+
+	upload := &models.WorkspaceUpload{
+		ID:          uuid.New().String(),
+		UserID:      userIDInt,
+		AgentID:     req.AgentId,
+		FilePath:    filePath,
+		ContentType: contentType,
+		SizeBytes:   req.SizeBytes,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if _, err := h.db.NewInsert().Model(upload).Exec(c.Request.Context()); err != nil {
+		response.InternalError(c, "Failed to create upload", err)
+		return
+	}
+
+	protobind.Created(c, uploadToProto(upload))
+}
+
+// GetInputUpload returns the current offset of a resumable upload so the
+// client can resume after a dropped connection.
+func (h *Handler) GetInputUpload(c *gin.Context) {
+	upload, ok := h.getUpload(c)
+	if !ok {
+		return
+	}
+	protobind.OK(c, uploadToProto(upload))
+}
+
+// UploadInputChunk stores the raw request body as the next chunk of a
+// resumable upload. The offset query parameter must match the bytes already
+// received; on mismatch 409 is returned with the current offset.
+func (h *Handler) UploadInputChunk(c *gin.Context) {
+	oss := h.getOSS(c)
+	upload, ok := h.getUpload(c)
+	if !ok {
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.Query("offset"), 10, 64)
+	if err != nil || offset < 0 {
+		response.BadRequest(c, "offset query parameter required")
+		return
+	}
+	if offset != upload.ReceivedBytes {
+		protobind.JSON(c, http.StatusConflict, uploadToProto(upload))
+		return
+	}
+
+	n := c.Request.ContentLength
+	if n <= 0 {
+		response.BadRequest(c, "Content-Length is required")
+		return
+	}
+	if n > maxUploadChunkSize {
+		response.BadRequest(c, fmt.Sprintf("Chunk too large: %d bytes (max %d)", n, maxUploadChunkSize))
+		return
+	}
+	if offset+n > upload.SizeBytes {
+		response.BadRequest(c, "Chunk exceeds the declared upload size")
+		return
+	}
+
+	ctx := c.Request.Context()
+	key := fmt.Sprintf("%s%06d", uploadChunkPrefix(upload.UserID, upload.AgentID, upload.ID), upload.ChunkCount)
+	if err := oss.Upload(ctx, key, io.LimitReader(c.Request.Body, n), n, "application/octet-stream"); err != nil {
+		response.InternalError(c, "Failed to store chunk", err)
+		return
+	}
+
+	// Only advance if no concurrent chunk was accepted at this offset.
+	res, err := h.db.NewUpdate().Model(upload).
+		Set("received_bytes = received_bytes + ?", n).
+		Set("chunk_count = chunk_count + 1").
+		Set("updated_at = ?", time.Now()).
+		Where("id = ? AND received_bytes = ?", upload.ID, offset).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		response.InternalError(c, "Failed to record chunk", err)
+		return
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		response.Conflict(c, "Upload offset changed, fetch the upload and resume")
+		return
+	}
+
+	protobind.OK(c, uploadToProto(upload))
+}
+
+// CompleteInputUpload assembles the chunks into the final input file,
+// records it and streams it into the running agent pod.
+func (h *Handler) CompleteInputUpload(c *gin.Context) {
+	oss := h.getOSS(c)
+	upload, ok := h.getUpload(c)
+	if !ok {
+		return
+	}
+	if upload.ReceivedBytes != upload.SizeBytes {
+		protobind.JSON(c, http.StatusConflict, uploadToProto(upload))
+		return
+	}
+
+	ctx := context.Background()
+	chunkPrefix := uploadChunkPrefix(upload.UserID, upload.AgentID, upload.ID)
+	keys := make([]string, upload.ChunkCount)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%s%06d", chunkPrefix, i)
+	}
+
+	chunks := &chunkReader{ctx: ctx, oss: oss, keys: keys}
+	wf, err := saveInputFile(ctx, h.db, oss, upload.UserID, upload.AgentID, upload.FilePath, upload.ContentType, upload.SizeBytes, chunks)
+	chunks.Close()
+	if err != nil {
+		quotaHTTPError(c, "Failed to assemble upload", err)
+		return
+	}
+
+	_ = oss.DeletePrefix(ctx, chunkPrefix)
+	_, _ = h.db.NewDelete().Model((*models.WorkspaceUpload)(nil)).Where("id = ?", upload.ID).Exec(ctx)
+
+	podSynced := false
+	if body, err := oss.Download(ctx, wf.OSSKey); err == nil {
+		podSynced = h.pushToPod(ctx, upload.UserID, upload.AgentID, upload.FilePath, upload.SizeBytes, body)
+		body.Close()
+	}
+
+	protobind.Created(c, &sacv1.InputFileResponse{File: convert.WorkspaceFileToProto(wf), PodSynced: podSynced})
+}
+
+// AbortInputUpload cancels a resumable upload and discards its chunks.
+func (h *Handler) AbortInputUpload(c *gin.Context) {
+	oss := h.getOSS(c)
+	upload, ok := h.getUpload(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	if err := oss.DeletePrefix(ctx, uploadChunkPrefix(upload.UserID, upload.AgentID, upload.ID)); err != nil {
+		response.InternalError(c, "Failed to delete upload chunks", err)
+		return
+	}
+	_, _ = h.db.NewDelete().Model((*models.WorkspaceUpload)(nil)).Where("id = ?", upload.ID).Exec(ctx)
+
+	protobind.OK(c, &sacv1.SuccessMessage{Message: "Upload cancelled"})
+}
+
+// chunkReader reads a sequence of stored chunks as one stream, opening each
+// object only when the previous one is exhausted.
+type chunkReader struct {
+	ctx  context.Context
+	oss  storage.StorageBackend
+	keys []string
+	cur  io.ReadCloser
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			body, err := r.oss.Download(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, fmt.Errorf("open chunk %s: %w", path.Base(r.keys[0]), err)
+			}
+			r.cur, r.keys = body, r.keys[1:]
+		}
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *chunkReader) Close() error {
+	if r.cur != nil {
+		return r.cur.Close()
+	}
+	return nil
+}
@@ -2,7 +2,6 @@ package workspace
 
 import (
 	"context"
-	"crypto/md5"
 	"encoding/json"
 	"fmt"
 	"github.com/rs/zerolog/log"
@@ -16,10 +15,10 @@ import (
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
 	"g.echo.tech/dev/sac/internal/convert"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/pkg/protobind"
 	"g.echo.tech/dev/sac/pkg/response"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 )
 
@@ -30,6 +29,26 @@ func outputOSSKeyPrefix(userID, agentID int64) string {
 	return fmt.Sprintf("users/%d/agents/%d/%s/", userID, agentID, outputOSSPrefix)
 }
 
+// notifyOutput sends a user notification for an output change made by the
+// agent (sidecar). Changes made from the browser are not notified.
+func notifyOutput(n *notify.Notifier, userID, agentID int64, eventType, filePath string, size int64) {
+	ev := notify.Event{
+		Type:    eventType,
+		UserID:  userID,
+		AgentID: agentID,
+		Data:    map[string]any{"path": filePath},
+	}
+	if eventType == notify.EventOutputUploaded {
+		ev.Title = "New output file: " + path.Base(filePath)
+		ev.Message = fmt.Sprintf("The agent wrote %s (%d bytes) to its output workspace.", filePath, size)
+		ev.Data["size"] = size
+	} else {
+		ev.Title = "Output file deleted: " + path.Base(filePath)
+		ev.Message = fmt.Sprintf("%s was removed from the output workspace.", filePath)
+	}
+	n.Notify(ev)
+}
+
 // RegisterInternalRoutes registers internal routes (no JWT, sidecar calls).
 func (h *Handler) RegisterInternalRoutes(rg *gin.RouterGroup) {
 	out := rg.Group("/output")
@@ -78,23 +97,12 @@ func (h *Handler) InternalOutputUpload(c *gin.Context) {
 	ctx := context.Background()
 	ossKey := outputOSSKeyPrefix(userID, agentID) + filePath
 
-	// Compute MD5 checksum while uploading
-	hasher := md5.New()
-	tee := io.TeeReader(file, hasher)
-
 	contentType := header.Header.Get("Content-Type")
 	if contentType == "" {
 		contentType = "application/octet-stream"
 	}
 
-	if err := oss.Upload(ctx, ossKey, tee, header.Size, contentType); err != nil {
-		response.InternalError(c, "Failed to upload file", err)
-		return
-	}
-
-	checksum := fmt.Sprintf("%x", hasher.Sum(nil))
-
-	// Upsert workspace_files record
+	// Upload the file and charge it to the agent's quota
 	wf := &models.WorkspaceFile{
 		UserID:        userID,
 		AgentID:       agentID,
@@ -104,20 +112,12 @@ func (h *Handler) InternalOutputUpload(c *gin.Context) {
 		FilePath:      filePath,
 		ContentType:   contentType,
 		SizeBytes:     header.Size,
-		Checksum:      checksum,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 
-	_, err = h.db.NewInsert().Model(wf).
-		On("CONFLICT (oss_key) DO UPDATE").
-		Set("size_bytes = EXCLUDED.size_bytes").
-		Set("checksum = EXCLUDED.checksum").
-		Set("content_type = EXCLUDED.content_type").
-		Set("updated_at = EXCLUDED.updated_at").
-		Exec(ctx)
-	if err != nil {
-		response.InternalError(c, "Failed to save file record", err)
+	if err := storeFile(ctx, h.db, oss, wf, file); err != nil {
+		quotaHTTPError(c, "Failed to upload file", err)
 		return
 	}
 
@@ -130,6 +130,7 @@ func (h *Handler) InternalOutputUpload(c *gin.Context) {
 			Size:   header.Size,
 		})
 	}
+	notifyOutput(h.notifier, userID, agentID, notify.EventOutputUploaded, filePath, header.Size)
 
 	protobind.Created(c, convert.WorkspaceFileToProto(wf))
 }
@@ -169,21 +170,11 @@ func (h *Handler) UploadOutputFile(c *gin.Context) {
 	ctx := context.Background()
 	ossKey := outputOSSKeyPrefix(userIDInt, agentID) + filePath
 
-	hasher := md5.New()
-	tee := io.TeeReader(file, hasher)
-
 	contentType := header.Header.Get("Content-Type")
 	if contentType == "" {
 		contentType = "application/octet-stream"
 	}
 
-	if err := oss.Upload(ctx, ossKey, tee, header.Size, contentType); err != nil {
-		response.InternalError(c, "Failed to upload file", err)
-		return
-	}
-
-	checksum := fmt.Sprintf("%x", hasher.Sum(nil))
-
 	wf := &models.WorkspaceFile{
 		UserID:        userIDInt,
 		AgentID:       agentID,
@@ -193,20 +184,12 @@ func (h *Handler) UploadOutputFile(c *gin.Context) {
 		FilePath:      filePath,
 		ContentType:   contentType,
 		SizeBytes:     header.Size,
-		Checksum:      checksum,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 
-	_, err = h.db.NewInsert().Model(wf).
-		On("CONFLICT (oss_key) DO UPDATE").
-		Set("size_bytes = EXCLUDED.size_bytes").
-		Set("checksum = EXCLUDED.checksum").
-		Set("content_type = EXCLUDED.content_type").
-		Set("updated_at = EXCLUDED.updated_at").
-		Exec(ctx)
-	if err != nil {
-		response.InternalError(c, "Failed to save file record", err)
+	if err := storeFile(ctx, h.db, oss, wf, file); err != nil {
+		quotaHTTPError(c, "Failed to upload file", err)
 		return
 	}
 
@@ -246,17 +229,17 @@ func (h *Handler) InternalOutputDelete(c *gin.Context) {
 			response.InternalError(c, "Failed to delete directory", err)
 			return
 		}
-		_, _ = h.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("user_id = ? AND agent_id = ? AND workspace_type = 'output' AND oss_key LIKE ?", req.UserId, req.AgentId, ossKey+"%").
-			Exec(ctx)
+		if err := forgetPath(ctx, h.db, req.UserId, req.AgentId, "output", ossKey, true); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted files")
+		}
 	} else {
 		if err := oss.Delete(ctx, ossKey); err != nil {
 			response.InternalError(c, "Failed to delete file", err)
 			return
 		}
-		_, _ = h.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("oss_key = ?", ossKey).
-			Exec(ctx)
+		if err := forgetPath(ctx, h.db, req.UserId, req.AgentId, "output", ossKey, false); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted file")
+		}
 	}
 
 	// Notify subscribers via Redis
@@ -267,6 +250,7 @@ func (h *Handler) InternalOutputDelete(c *gin.Context) {
 			Name:   path.Base(filePath),
 		})
 	}
+	notifyOutput(h.notifier, req.UserId, req.AgentId, notify.EventOutputDeleted, filePath, 0)
 
 	protobind.OK(c, &sacv1.SuccessMessage{Message: "File deleted"})
 }
@@ -359,17 +343,17 @@ func (h *Handler) DeleteOutputFile(c *gin.Context) {
 			response.InternalError(c, "Failed to delete directory", err)
 			return
 		}
-		_, _ = h.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("user_id = ? AND agent_id = ? AND workspace_type = 'output' AND oss_key LIKE ?", userIDInt, agentID, ossKey+"%").
-			Exec(ctx)
+		if err := forgetPath(ctx, h.db, userIDInt, agentID, "output", ossKey, true); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted files")
+		}
 	} else {
 		if err := oss.Delete(ctx, ossKey); err != nil {
 			response.InternalError(c, "Failed to delete file", err)
 			return
 		}
-		_, _ = h.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("oss_key = ?", ossKey).
-			Exec(ctx)
+		if err := forgetPath(ctx, h.db, userIDInt, agentID, "output", ossKey, false); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted file")
+		}
 	}
 
 	// Also clean up any shared links for this file
@@ -391,110 +375,11 @@ func (h *Handler) DeleteOutputFile(c *gin.Context) {
 
 // ---- Shared Links ----
 
-// CreateShare creates a short link for an output workspace file.
-func (h *Handler) CreateShare(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	userIDInt := userID.(int64)
-
-	req := &sacv1.CreateShareRequest{}
-	if !protobind.Bind(c, req) {
-		return
-	}
-
-	if req.AgentId == 0 || req.Path == "" {
-		response.BadRequest(c, "agent_id and path are required")
-		return
-	}
-
-	filePath := sanitizePath(req.Path)
-	ossKey := outputOSSKeyPrefix(userIDInt, req.AgentId) + filePath
-	fileName := path.Base(filePath)
-
-	ctx := context.Background()
-
-	// Check if already shared
-	var existing models.SharedLink
-	err := h.db.NewSelect().Model(&existing).
-		Where("user_id = ? AND agent_id = ? AND file_path = ?", userIDInt, req.AgentId, filePath).
-		Scan(ctx)
-	if err == nil {
-		// Already shared, return existing link
-		protobind.OK(c, &sacv1.ShareResponse{
-			ShortCode: existing.ShortCode,
-			Url:       "/s/" + existing.ShortCode,
-		})
-		return
-	}
-
-	// Verify file exists in OSS
-	backend := h.provider.GetClient(ctx)
-	if backend == nil {
-		response.ServiceUnavailable(c, "Storage not configured")
-		return
-	}
-	body, err := backend.Download(ctx, ossKey)
-	if err != nil {
-		response.NotFound(c, "File not found in output workspace", err)
-		return
-	}
-	body.Close()
-
-	// Generate short code
-	shortCode := uuid.New().String()[:8]
-
-	link := &models.SharedLink{
-		ShortCode: shortCode,
-		UserID:    userIDInt,
-		AgentID:   req.AgentId,
-		FilePath:  filePath,
-		OSSKey:    ossKey,
-		FileName:  fileName,
-		CreatedAt: time.Now(),
-	}
-
-	_, err = h.db.NewInsert().Model(link).Exec(ctx)
-	if err != nil {
-		response.InternalError(c, "Failed to create share link", err)
-		return
-	}
-
-	protobind.Created(c, &sacv1.ShareResponse{
-		ShortCode: shortCode,
-		Url:       "/s/" + shortCode,
-	})
-}
-
-// DeleteShare removes a shared link.
-func (h *Handler) DeleteShare(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	userIDInt := userID.(int64)
-
-	code := c.Param("code")
-	if code == "" {
-		response.BadRequest(c, "code parameter required")
-		return
-	}
-
-	ctx := context.Background()
-	result, err := h.db.NewDelete().Model((*models.SharedLink)(nil)).
-		Where("short_code = ? AND user_id = ?", code, userIDInt).
-		Exec(ctx)
-	if err != nil {
-		response.InternalError(c, "Failed to delete share link", err)
-		return
-	}
-
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		response.NotFound(c, "Share link not found")
-		return
-	}
-
-	protobind.OK(c, &sacv1.SuccessMessage{Message: "Share link deleted"})
-}
-
-// GetSharedFileMeta returns metadata for a shared file (public, no auth).
-func (h *Handler) GetSharedFileMeta(c *gin.Context) {
+// DownloadSharedFile streams a shared file. Public links need no auth;
+// restricted links need a bearer token and protected ones the password
+// in the X-Share-Password header. Every download is counted; repeated wrong
+// passwords lock the link for a while.
+func (h *Handler) DownloadSharedFile(c *gin.Context) {
 	code := c.Param("code")
 	if code == "" {
 		response.BadRequest(c, "code parameter required")
@@ -502,43 +387,15 @@ func (h *Handler) GetSharedFileMeta(c *gin.Context) {
 	}
 
 	ctx := context.Background()
-	var link models.SharedLink
-	err := h.db.NewSelect().Model(&link).Where("short_code = ?", code).Scan(ctx)
-	if err != nil {
-		response.NotFound(c, "Link not found or expired")
-		return
+	link, err := loadShare(ctx, h.db, code)
+	if err == nil {
+		err = authorizeShare(ctx, h.db, link, h.optionalUserID(c))
 	}
-
-	contentType := contentTypeByFilename(link.FileName)
-
-	// Try to get file size from workspace_files
-	var sizeBytes int64
-	_ = h.db.NewSelect().
-		TableExpr("workspace_files").
-		Column("size_bytes").
-		Where("oss_key = ?", link.OSSKey).
-		Scan(ctx, &sizeBytes)
-
-	protobind.OK(c, &sacv1.SharedFileMeta{
-		FileName:    link.FileName,
-		ContentType: contentType,
-		SizeBytes:   sizeBytes,
-	})
-}
-
-// DownloadSharedFile streams a shared file (public, no auth).
-func (h *Handler) DownloadSharedFile(c *gin.Context) {
-	code := c.Param("code")
-	if code == "" {
-		response.BadRequest(c, "code parameter required")
-		return
+	if err == nil {
+		err = checkSharePassword(ctx, h.shareGuard, link, c.GetHeader(SharePasswordHeader))
 	}
-
-	ctx := context.Background()
-	var link models.SharedLink
-	err := h.db.NewSelect().Model(&link).Where("short_code = ?", code).Scan(ctx)
 	if err != nil {
-		response.NotFound(c, "Link not found or expired")
+		shareHTTPError(c, err)
 		return
 	}
 
@@ -555,8 +412,14 @@ func (h *Handler) DownloadSharedFile(c *gin.Context) {
 	}
 	defer body.Close()
 
+	if err := claimShareDownload(ctx, h.db, link); err != nil {
+		shareHTTPError(c, err)
+		return
+	}
+
 	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, link.FileName))
 	c.Header("Content-Type", contentTypeByFilename(link.FileName))
+	c.Header("Cache-Control", "private, no-store")
 	io.Copy(c.Writer, body)
 }
 
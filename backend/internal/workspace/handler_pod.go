@@ -0,0 +1,81 @@
+package workspace
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// DownloadPodFile streams a file from the agent's live /workspace, or a
+// directory (or any path with archive=zip) as a zip archive.
+func (h *Handler) DownloadPodFile(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDInt := userID.(int64)
+
+	agentID, ok := parseAgentID(c)
+	if !ok {
+		return
+	}
+	if h.containerMgr == nil {
+		response.ServiceUnavailable(c, "Container manager not available")
+		return
+	}
+	ctx := c.Request.Context()
+	if !agentOwnedBy(ctx, h.db, userIDInt, agentID) {
+		response.NotFound(c, "Agent not found")
+		return
+	}
+
+	rel := workspaceRelPath(c.Query("path"))
+	podName := agentPodName(userIDInt, agentID)
+
+	isDir, size, err := statPodPath(ctx, h.containerMgr, podName, rel)
+	if err != nil {
+		podFileHTTPError(c, err)
+		return
+	}
+
+	name := path.Base(rel)
+	if rel == "" {
+		name = "workspace"
+	}
+
+	if isDir || c.Query("archive") == "zip" {
+		c.Header("Content-Disposition", attachmentDisposition(name+".zip"))
+		c.Header("Content-Type", "application/zip")
+		c.Status(http.StatusOK)
+		if err := streamPodZip(ctx, h.containerMgr, podName, rel, c.Writer); err != nil {
+			// Headers are already sent; the truncated archive fails to open.
+			log.Warn().Err(err).Str("pod", podName).Str("path", rel).Msg("pod zip download aborted")
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", attachmentDisposition(name))
+	c.Header("Content-Type", contentTypeByFilename(name))
+	c.Header("Content-Length", strconv.FormatInt(size, 10))
+	c.Status(http.StatusOK)
+	if err := streamPodFile(ctx, h.containerMgr, podName, rel, c.Writer); err != nil {
+		log.Warn().Err(err).Str("pod", podName).Str("path", rel).Msg("pod file download aborted")
+	}
+}
+
+// podFileHTTPError writes the HTTP response for a pod file helper error.
+func podFileHTTPError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, errPodPathNotFound):
+		response.NotFound(c, "Path not found in workspace")
+	case errors.Is(err, errPodPathOutside):
+		response.Forbidden(c, "Path is outside /workspace")
+	case errors.Is(err, errPodNotDirectory), errors.Is(err, errPodNotFile):
+		response.BadRequest(c, err.Error())
+	default:
+		log.Debug().Err(err).Msg("pod file operation failed")
+		response.ServiceUnavailable(c, "Agent pod is not running. Start a session to browse its workspace.")
+	}
+}
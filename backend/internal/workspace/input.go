@@ -0,0 +1,163 @@
+package workspace
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"github.com/uptrace/bun"
+)
+
+const (
+	inputOSSPrefix = "input"
+	inputDir       = "input" // default upload directory, relative to /workspace
+	workspaceRoot  = "/workspace"
+
+	// podPushTimeout bounds streaming a single upload into the agent pod.
+	podPushTimeout = 10 * time.Minute
+)
+
+// inputOSSKeyPrefix returns the OSS key prefix for a user's agent input
+// workspace. Keys below it mirror paths relative to /workspace.
+func inputOSSKeyPrefix(userID, agentID int64) string {
+	return fmt.Sprintf("users/%d/agents/%d/%s/", userID, agentID, inputOSSPrefix)
+}
+
+// agentPodName returns the name of the agent's StatefulSet pod.
+func agentPodName(userID, agentID int64) string {
+	return fmt.Sprintf("claude-code-%d-%d-0", userID, agentID)
+}
+
+// inputFilePath resolves an upload destination to a clean path relative to
+// /workspace. An empty destination means the input directory; a trailing
+// slash uploads into that directory under fileName. Paths owned by the
+// platform (output sync, skills) are rejected.
+func inputFilePath(dest, fileName string) (string, error) {
+	dest = sanitizePath(dest)
+	if dest == "" {
+		dest = inputDir + "/"
+	}
+	if strings.HasSuffix(dest, "/") {
+		name := path.Base(sanitizePath(fileName))
+		if name == "" || name == "." || name == "/" {
+			return "", errors.New("file name is required")
+		}
+		dest += name
+	}
+	dest = path.Clean(dest)
+	if dest == "." || dest == "/" {
+		return "", errors.New("invalid destination path")
+	}
+	switch top := strings.SplitN(dest, "/", 2)[0]; top {
+	case outputOSSPrefix, ".claude":
+		return "", fmt.Errorf("%s/ is managed by the platform and cannot receive uploads", top)
+	}
+	return dest, nil
+}
+
+// saveInputFile stores an uploaded input file and charges it to the
+// agent's quota.
+func saveInputFile(ctx context.Context, db *bun.DB, oss storage.StorageBackend, userID, agentID int64, filePath, contentType string, size int64, r io.Reader) (*models.WorkspaceFile, error) {
+	wf := &models.WorkspaceFile{
+		UserID:        userID,
+		AgentID:       agentID,
+		WorkspaceType: "input",
+		OSSKey:        inputOSSKeyPrefix(userID, agentID) + filePath,
+		FileName:      path.Base(filePath),
+		FilePath:      filePath,
+		ContentType:   contentType,
+		SizeBytes:     size,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	if err := storeFile(ctx, db, oss, wf, r); err != nil {
+		return wf, err
+	}
+	return wf, nil
+}
+
+// tarEntry is a single regular file written into a streamed tar archive.
+type tarEntry struct {
+	path string // relative to the extraction directory
+	size int64
+	open func() (io.ReadCloser, error)
+}
+
+// streamTarToPod builds a tar archive of entries on the fly and extracts it
+// under /workspace in the agent pod. Nothing is buffered beyond the pipe.
+// Entries whose open fails are skipped; a short read aborts the archive.
+func streamTarToPod(ctx context.Context, cm *container.Manager, podName string, entries []tarEntry) (int, error) {
+	pr, pw := io.Pipe()
+	written := 0
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		tw := tar.NewWriter(pw)
+		var err error
+		for _, e := range entries {
+			var body io.ReadCloser
+			body, err = e.open()
+			if err != nil {
+				err = nil
+				continue
+			}
+			err = tw.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeReg,
+				Name:     e.path,
+				Size:     e.size,
+				Mode:     0o644,
+				ModTime:  time.Now(),
+			})
+			if err == nil {
+				_, err = io.Copy(tw, body)
+			}
+			body.Close()
+			if err != nil {
+				err = fmt.Errorf("write %s: %w", e.path, err)
+				break
+			}
+			written++
+		}
+		if err == nil {
+			err = tw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	err := cm.ExtractTarInPod(ctx, podName, workspaceRoot, pr)
+	// Unblock the writer if the exec ended before consuming the archive.
+	pr.CloseWithError(err)
+	<-done
+	if err != nil {
+		return 0, err
+	}
+	return written, nil
+}
+
+// pushInputFile streams one input file into the running agent pod.
+func pushInputFile(ctx context.Context, cm *container.Manager, userID, agentID int64, filePath string, size int64, r io.Reader) error {
+	if cm == nil {
+		return errors.New("container manager not available")
+	}
+	ctx, cancel := context.WithTimeout(ctx, podPushTimeout)
+	defer cancel()
+
+	n, err := streamTarToPod(ctx, cm, agentPodName(userID, agentID), []tarEntry{{
+		path: filePath,
+		size: size,
+		open: func() (io.ReadCloser, error) { return io.NopCloser(r), nil },
+	}})
+	if err == nil && n == 0 {
+		err = errors.New("file was not written")
+	}
+	return err
+}
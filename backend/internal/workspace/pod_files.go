@@ -0,0 +1,319 @@
+package workspace
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/models"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Limits for browsing the live /workspace of an agent pod.
+const (
+	podListLimit       = 2000
+	podReadDefault     = 256 << 10 // 256KB
+	podReadMax         = 2 << 20   // 2MB
+	podZipMaxBytes     = 1 << 30   // 1GB uncompressed
+	binarySniffLen     = 8000
+	podFileExecTimeout = 30 * time.Second
+)
+
+var (
+	errPodPathNotFound = errors.New("path not found")
+	errPodPathOutside  = errors.New("path is outside /workspace")
+	errPodNotDirectory = errors.New("path is not a directory")
+	errPodNotFile      = errors.New("path is not a regular file")
+	errPodTooLarge     = errors.New("directory exceeds the download size limit")
+)
+
+// Scripts run in the pod with the absolute target path as $1. podResolve
+// follows symlinks so a link inside /workspace cannot be used to reach
+// files outside it. Failures are reported with markers on stderr.
+const (
+	podResolve = `p=$(realpath -e -- "$1" 2>/dev/null) || { echo "sac:notfound" >&2; exit 2; }
+case "$p" in /workspace|/workspace/*) ;; *) echo "sac:outside" >&2; exit 3 ;; esac
+`
+	podListScript = podResolve + `[ -d "$p" ] || { echo "sac:notdir" >&2; exit 4; }
+find "$p" -mindepth 1 -maxdepth 1 -printf '%Y\t%s\t%T@\t%f\0' | head -z -n "$2"
+`
+	podReadScript = podResolve + `[ -f "$p" ] || { echo "sac:notfile" >&2; exit 4; }
+stat -c %s -- "$p"
+head -c "$2" -- "$p"
+`
+	podStatScript = podResolve + `if [ -d "$p" ]; then echo "d 0"; elif [ -f "$p" ]; then echo "f $(stat -c %s -- "$p")"; else echo "sac:notfile" >&2; exit 4; fi
+`
+	podCatScript = podResolve + `[ -f "$p" ] || { echo "sac:notfile" >&2; exit 4; }
+cat -- "$p"
+`
+	podTarScript = podResolve + `if [ -d "$p" ]; then cd "$p" && tar -cf - .
+else cd "$(dirname -- "$p")" && tar -cf - -- "$(basename -- "$p")"; fi
+`
+	// Deleting resolves only the parent so a symlink is removed itself
+	// rather than the file it points to.
+	podDeleteScript = `d=$(realpath -e -- "$(dirname -- "$1")" 2>/dev/null) || { echo "sac:notfound" >&2; exit 2; }
+t="$d/$(basename -- "$1")"
+case "$t" in /workspace/?*) ;; *) echo "sac:outside" >&2; exit 3 ;; esac
+[ -e "$t" ] || [ -L "$t" ] || { echo "sac:notfound" >&2; exit 2; }
+rm -rf -- "$t"
+`
+)
+
+// workspaceRelPath normalises a user-supplied path to a clean path relative
+// to /workspace ("" is the workspace root). A leading /workspace is accepted.
+func workspaceRelPath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == workspaceRoot || strings.HasPrefix(p, workspaceRoot+"/") {
+		p = strings.TrimPrefix(p, workspaceRoot)
+	}
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+func podAbsPath(rel string) string {
+	if rel == "" {
+		return workspaceRoot
+	}
+	return workspaceRoot + "/" + rel
+}
+
+// agentOwnedBy reports whether the agent exists and belongs to the user.
+func agentOwnedBy(ctx context.Context, db *bun.DB, userID, agentID int64) bool {
+	exists, err := db.NewSelect().Model((*models.Agent)(nil)).
+		Where("id = ? AND created_by = ?", agentID, userID).
+		Exists(ctx)
+	return err == nil && exists
+}
+
+// podScriptError maps the stderr markers of the pod scripts to errors.
+func podScriptError(err error, stderr string) error {
+	switch {
+	case strings.Contains(stderr, "sac:notfound"):
+		return errPodPathNotFound
+	case strings.Contains(stderr, "sac:outside"):
+		return errPodPathOutside
+	case strings.Contains(stderr, "sac:notdir"):
+		return errPodNotDirectory
+	case strings.Contains(stderr, "sac:notfile"):
+		return errPodNotFile
+	}
+	return fmt.Errorf("pod exec failed: %w (stderr: %s)", err, strings.TrimSpace(stderr))
+}
+
+func runPodScript(ctx context.Context, cm *container.Manager, podName, script string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, podFileExecTimeout)
+	defer cancel()
+
+	cmd := append([]string{"sh", "-c", script, "sh"}, args...)
+	stdout, stderr, err := cm.ExecInPod(ctx, podName, cmd, nil)
+	if err != nil {
+		return "", podScriptError(err, stderr)
+	}
+	return stdout, nil
+}
+
+// listPodDir lists one directory level of the live workspace, directories first.
+func listPodDir(ctx context.Context, cm *container.Manager, podName, rel string) ([]*sacv1.FileItem, error) {
+	out, err := runPodScript(ctx, cm, podName, podListScript, podAbsPath(rel), strconv.Itoa(podListLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*sacv1.FileItem
+	for _, rec := range strings.Split(out, "\x00") {
+		fields := strings.SplitN(rec, "\t", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[1], 10, 64)
+		fi := &sacv1.FileItem{
+			Name:        fields[3],
+			Path:        path.Join(rel, fields[3]),
+			Size:        size,
+			IsDirectory: fields[0] == "d",
+		}
+		if fi.IsDirectory {
+			fi.Path += "/"
+			fi.Size = 0
+		}
+		if secs, err := strconv.ParseFloat(fields[2], 64); err == nil {
+			fi.LastModified = timestamppb.New(time.Unix(0, int64(secs*float64(time.Second))))
+		}
+		files = append(files, fi)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].IsDirectory != files[j].IsDirectory {
+			return files[i].IsDirectory
+		}
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// readPodFile returns up to maxBytes of a file. Binary files (NUL bytes or
+// invalid UTF-8) are reported without content.
+func readPodFile(ctx context.Context, cm *container.Manager, podName, rel string, maxBytes int64) (*sacv1.PodFileContent, error) {
+	if maxBytes <= 0 {
+		maxBytes = podReadDefault
+	}
+	if maxBytes > podReadMax {
+		maxBytes = podReadMax
+	}
+
+	out, err := runPodScript(ctx, cm, podName, podReadScript, podAbsPath(rel), strconv.FormatInt(maxBytes, 10))
+	if err != nil {
+		return nil, err
+	}
+	sizeLine, data, _ := strings.Cut(out, "\n")
+	size, _ := strconv.ParseInt(strings.TrimSpace(sizeLine), 10, 64)
+
+	res := &sacv1.PodFileContent{
+		Path:        rel,
+		Size:        size,
+		Truncated:   size > int64(len(data)),
+		ContentType: contentTypeByFilename(rel),
+	}
+	text, binary := detectText([]byte(data), res.Truncated)
+	res.IsBinary = binary
+	if !binary {
+		res.Content = string(text)
+		if res.ContentType == "application/octet-stream" {
+			res.ContentType = "text/plain; charset=utf-8"
+		}
+	}
+	return res, nil
+}
+
+// detectText reports whether b is text. A truncated read may end inside a
+// multi-byte rune, so up to utf8.UTFMax-1 trailing bytes are dropped first.
+func detectText(b []byte, truncated bool) ([]byte, bool) {
+	sniff := b
+	if len(sniff) > binarySniffLen {
+		sniff = sniff[:binarySniffLen]
+	}
+	if bytes.IndexByte(sniff, 0) >= 0 {
+		return nil, true
+	}
+	if truncated {
+		for i := 0; i < utf8.UTFMax-1 && len(b) > 0 && !utf8.Valid(b); i++ {
+			b = b[:len(b)-1]
+		}
+	}
+	if !utf8.Valid(b) {
+		return nil, true
+	}
+	return b, false
+}
+
+// statPodPath returns whether the path is a directory and, for files, its size.
+func statPodPath(ctx context.Context, cm *container.Manager, podName, rel string) (bool, int64, error) {
+	out, err := runPodScript(ctx, cm, podName, podStatScript, podAbsPath(rel))
+	if err != nil {
+		return false, 0, err
+	}
+	kind, sizeStr, _ := strings.Cut(strings.TrimSpace(out), " ")
+	size, _ := strconv.ParseInt(sizeStr, 10, 64)
+	return kind == "d", size, nil
+}
+
+// deletePodPath removes a file or directory below /workspace from the pod.
+func deletePodPath(ctx context.Context, cm *container.Manager, podName, rel string) error {
+	_, err := runPodScript(ctx, cm, podName, podDeleteScript, podAbsPath(rel))
+	return err
+}
+
+// streamPodFile copies a file from the pod to w.
+func streamPodFile(ctx context.Context, cm *container.Manager, podName, rel string, w io.Writer) error {
+	var stderr bytes.Buffer
+	cmd := []string{"sh", "-c", podCatScript, "sh", podAbsPath(rel)}
+	if err := cm.ExecInPodStream(ctx, podName, cmd, nil, w, &stderr); err != nil {
+		return podScriptError(err, stderr.String())
+	}
+	return nil
+}
+
+// streamPodZip archives a directory's contents (or a single file) in the pod
+// with tar and re-encodes the stream as a zip into w, without buffering.
+func streamPodZip(ctx context.Context, cm *container.Manager, podName, rel string, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	var stderr bytes.Buffer
+	execDone := make(chan error, 1)
+	go func() {
+		cmd := []string{"sh", "-c", podTarScript, "sh", podAbsPath(rel)}
+		err := cm.ExecInPodStream(ctx, podName, cmd, nil, pw, &stderr)
+		pw.CloseWithError(err)
+		execDone <- err
+	}()
+
+	err := tarToZip(pr, w, podZipMaxBytes)
+	pr.CloseWithError(err)
+	if err != nil {
+		cancel()
+	}
+	if execErr := <-execDone; execErr != nil && err == nil {
+		return podScriptError(execErr, stderr.String())
+	}
+	return err
+}
+
+// tarToZip converts regular files and directories of a tar stream into a
+// zip archive. Other entry types (symlinks, devices) are skipped.
+func tarToZip(r io.Reader, w io.Writer, maxBytes int64) error {
+	tr := tar.NewReader(r)
+	zw := zip.NewWriter(w)
+	var total int64
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(hdr.Name, "./")
+		if name == "" || name == "." {
+			continue
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			fh := &zip.FileHeader{Name: strings.TrimSuffix(name, "/") + "/", Modified: hdr.ModTime}
+			fh.SetMode(hdr.FileInfo().Mode())
+			if _, err := zw.CreateHeader(fh); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			total += hdr.Size
+			if total > maxBytes {
+				return errPodTooLarge
+			}
+			fh := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: hdr.ModTime}
+			fh.SetMode(hdr.FileInfo().Mode())
+			fw, err := zw.CreateHeader(fh)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(fw, tr); err != nil {
+				return err
+			}
+		}
+	}
+	return zw.Close()
+}
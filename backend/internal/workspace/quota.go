@@ -0,0 +1,353 @@
+package workspace
+
+import (
+	"context"
+	"crypto/md5"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/storage"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+)
+
+// Defaults for quota rows created on first use, matching the column
+// defaults of workspace_quotas.
+const (
+	DefaultQuotaBytes = 1 << 30
+	DefaultQuotaFiles = 1000
+)
+
+// QuotaExceededError is returned when a write would take a workspace over
+// its byte or file limit.
+type QuotaExceededError struct {
+	Scope        string // "agent" or "group"
+	UsedBytes    int64
+	MaxBytes     int64
+	AddBytes     int64
+	FileCount    int
+	MaxFileCount int
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.AddBytes > 0 && e.UsedBytes+e.AddBytes > e.MaxBytes {
+		return fmt.Sprintf("%s workspace quota exceeded: %s used of %s, upload needs %s more",
+			e.Scope, formatBytes(e.UsedBytes), formatBytes(e.MaxBytes), formatBytes(e.AddBytes))
+	}
+	return fmt.Sprintf("%s workspace quota exceeded: %d of %d files", e.Scope, e.FileCount, e.MaxFileCount)
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// CheckQuota reports whether adding addBytes and addFiles stays within the
+// limits. Shrinking writes are always allowed so an over-quota workspace
+// can still be cleaned up.
+func CheckQuota(scope string, usedBytes, maxBytes int64, fileCount, maxFileCount int, addBytes int64, addFiles int) error {
+	overBytes := addBytes > 0 && usedBytes+addBytes > maxBytes
+	overFiles := addFiles > 0 && fileCount+addFiles > maxFileCount
+	if !overBytes && !overFiles {
+		return nil
+	}
+	return &QuotaExceededError{
+		Scope:        scope,
+		UsedBytes:    usedBytes,
+		MaxBytes:     maxBytes,
+		AddBytes:     addBytes,
+		FileCount:    fileCount,
+		MaxFileCount: maxFileCount,
+	}
+}
+
+// quotaHTTPError writes a 413 for quota errors and a 500 otherwise.
+func quotaHTTPError(c *gin.Context, msg string, err error) {
+	var qe *QuotaExceededError
+	if errors.As(err, &qe) {
+		response.Error(c, http.StatusRequestEntityTooLarge, qe.Error())
+		return
+	}
+	response.InternalError(c, msg, err)
+}
+
+// ensureQuota creates the agent's quota row if missing, taking its limits
+// from the user's default row (agent_id 0) when an admin has set one.
+func ensureQuota(ctx context.Context, db bun.IDB, userID, agentID int64) error {
+	q := &models.WorkspaceQuota{
+		UserID:       userID,
+		AgentID:      agentID,
+		MaxBytes:     DefaultQuotaBytes,
+		MaxFileCount: DefaultQuotaFiles,
+		UpdatedAt:    time.Now(),
+	}
+	var def models.WorkspaceQuota
+	err := db.NewSelect().Model(&def).
+		Where("user_id = ? AND agent_id = 0", userID).
+		Scan(ctx)
+	if err == nil {
+		q.MaxBytes, q.MaxFileCount = def.MaxBytes, def.MaxFileCount
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	_, err = db.NewInsert().Model(q).On("CONFLICT (user_id, agent_id) DO NOTHING").Exec(ctx)
+	return err
+}
+
+// precheckQuota rejects an upload of size bytes to ossKey before anything
+// is written to storage, such as at the start of a resumable upload.
+// storeFile checks again under lock.
+func precheckQuota(ctx context.Context, db *bun.DB, userID, agentID int64, groupID *int64, ossKey string, size int64) error {
+	if err := ensureQuota(ctx, db, userID, agentID); err != nil {
+		return err
+	}
+	_, _, err := checkFileQuota(ctx, db, userID, agentID, groupID, ossKey, size, false)
+	return err
+}
+
+// checkFileQuota returns how much usage grows when ossKey is written with
+// size bytes and fails when that exceeds the agent quota or, for group
+// files, the group quota. With lock the quota rows stay locked until the
+// enclosing transaction ends.
+func checkFileQuota(ctx context.Context, db bun.IDB, userID, agentID int64, groupID *int64, ossKey string, size int64, lock bool) (int64, int, error) {
+	forUpdate := func(q *bun.SelectQuery) *bun.SelectQuery {
+		if lock {
+			return q.For("UPDATE")
+		}
+		return q
+	}
+
+	var q models.WorkspaceQuota
+	err := db.NewSelect().Model(&q).
+		Where("user_id = ? AND agent_id = ?", userID, agentID).
+		Apply(forUpdate).
+		Scan(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	addBytes, addFiles, err := fileDelta(ctx, db, ossKey, size)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := CheckQuota("agent", q.UsedBytes, q.MaxBytes, q.FileCount, q.MaxFileCount, addBytes, addFiles); err != nil {
+		return 0, 0, err
+	}
+
+	if groupID != nil {
+		var gq models.GroupWorkspaceQuota
+		err := db.NewSelect().Model(&gq).Where("group_id = ?", *groupID).Apply(forUpdate).Scan(ctx)
+		if err == nil {
+			if err := CheckQuota("group", gq.UsedBytes, gq.MaxBytes, gq.FileCount, gq.MaxFileCount, addBytes, addFiles); err != nil {
+				return 0, 0, err
+			}
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, err
+		}
+	}
+	return addBytes, addFiles, nil
+}
+
+// fileDelta returns how much usage grows when ossKey is written with size
+// bytes, accounting for the record it replaces.
+func fileDelta(ctx context.Context, db bun.IDB, ossKey string, size int64) (int64, int, error) {
+	var old models.WorkspaceFile
+	err := db.NewSelect().Model(&old).Column("size_bytes").Where("oss_key = ?", ossKey).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return size, 1, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return size - old.SizeBytes, 0, nil
+}
+
+// storeFile uploads r to wf.OSSKey, upserts the workspace_files row and
+// charges the change in size and file count to the agent (and group, for
+// group files) quota in one transaction. The quota rows are locked and
+// checked before the upload starts and stay locked until the row is saved,
+// so a refused write never reaches storage and cannot replace an existing
+// file. wf.Checksum is set from the uploaded bytes.
+func storeFile(ctx context.Context, db *bun.DB, oss storage.StorageBackend, wf *models.WorkspaceFile, r io.Reader) error {
+	created := false
+	err := db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := ensureQuota(ctx, tx, wf.UserID, wf.AgentID); err != nil {
+			return err
+		}
+		addBytes, addFiles, err := checkFileQuota(ctx, tx, wf.UserID, wf.AgentID, wf.GroupID, wf.OSSKey, wf.SizeBytes, true)
+		if err != nil {
+			return err
+		}
+
+		hasher := md5.New()
+		if err := oss.Upload(ctx, wf.OSSKey, io.TeeReader(r, hasher), wf.SizeBytes, wf.ContentType); err != nil {
+			return err
+		}
+		created = addFiles == 1
+		wf.Checksum = fmt.Sprintf("%x", hasher.Sum(nil))
+
+		_, err = tx.NewInsert().Model(wf).
+			On("CONFLICT (oss_key) DO UPDATE").
+			Set("size_bytes = EXCLUDED.size_bytes").
+			Set("checksum = EXCLUDED.checksum").
+			Set("content_type = EXCLUDED.content_type").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return chargeQuota(ctx, tx, wf.UserID, wf.AgentID, wf.GroupID, addBytes, addFiles)
+	})
+	// A new object whose record was not saved would never be charged, so
+	// remove it. An overwritten one keeps its old record; the reconcile
+	// task corrects the usage.
+	if err != nil && created {
+		if derr := oss.Delete(ctx, wf.OSSKey); derr != nil {
+			log.Warn().Err(derr).Str("oss_key", wf.OSSKey).Msg("failed to delete unrecorded upload")
+		}
+	}
+	return err
+}
+
+// chargeQuota adds to the usage counters. Counters never go below zero;
+// drift is corrected by the maintenance reconcile task.
+func chargeQuota(ctx context.Context, tx bun.IDB, userID, agentID int64, groupID *int64, addBytes int64, addFiles int) error {
+	if addBytes == 0 && addFiles == 0 {
+		return nil
+	}
+	_, err := tx.NewUpdate().Model((*models.WorkspaceQuota)(nil)).
+		Set("used_bytes = GREATEST(used_bytes + ?, 0)", addBytes).
+		Set("file_count = GREATEST(file_count + ?, 0)", addFiles).
+		Set("updated_at = now()").
+		Where("user_id = ? AND agent_id = ?", userID, agentID).
+		Exec(ctx)
+	if err != nil || groupID == nil {
+		return err
+	}
+	_, err = tx.NewUpdate().Model((*models.GroupWorkspaceQuota)(nil)).
+		Set("used_bytes = GREATEST(used_bytes + ?, 0)", addBytes).
+		Set("file_count = GREATEST(file_count + ?, 0)", addFiles).
+		Set("updated_at = now()").
+		Where("group_id = ?", *groupID).
+		Exec(ctx)
+	return err
+}
+
+// forgetFiles deletes the workspace_files rows selected by where and
+// releases their usage from the quotas in one transaction.
+func forgetFiles(ctx context.Context, db *bun.DB, where func(*bun.DeleteQuery) *bun.DeleteQuery) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var removed []models.WorkspaceFile
+		_, err := where(tx.NewDelete().Model(&removed)).
+			Returning("user_id, agent_id, group_id, size_bytes").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		type key struct {
+			userID, agentID int64
+			groupID         int64 // 0 for none
+		}
+		type usage struct {
+			bytes int64
+			files int
+		}
+		totals := map[key]*usage{}
+		for _, f := range removed {
+			k := key{userID: f.UserID, agentID: f.AgentID}
+			if f.GroupID != nil {
+				k.groupID = *f.GroupID
+			}
+			if totals[k] == nil {
+				totals[k] = &usage{}
+			}
+			totals[k].bytes += f.SizeBytes
+			totals[k].files++
+		}
+		for k, u := range totals {
+			var groupID *int64
+			if k.groupID != 0 {
+				groupID = &k.groupID
+			}
+			if err := chargeQuota(ctx, tx, k.userID, k.agentID, groupID, -u.bytes, -u.files); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// forgetPath releases the record for ossKey, or every record below it when
+// the path is a directory.
+func forgetPath(ctx context.Context, db *bun.DB, userID, agentID int64, workspaceType, ossKey string, dir bool) error {
+	return forgetFiles(ctx, db, func(q *bun.DeleteQuery) *bun.DeleteQuery {
+		if dir {
+			return q.Where("user_id = ? AND agent_id = ? AND workspace_type = ? AND oss_key LIKE ?", userID, agentID, workspaceType, ossKey+"%")
+		}
+		return q.Where("oss_key = ?", ossKey)
+	})
+}
+
+// ReconcileQuota recounts an agent's usage from the objects actually in
+// storage under its input and output prefixes and corrects the quota row,
+// creating it for agents that have never uploaded through the gateway.
+// It returns the previous and new byte counts when they differed.
+func ReconcileQuota(ctx context.Context, db *bun.DB, backend storage.StorageBackend, userID, agentID int64) (old, actual int64, changed bool, err error) {
+	var files int
+	for _, prefix := range []string{inputOSSKeyPrefix(userID, agentID), outputOSSKeyPrefix(userID, agentID)} {
+		objects, err := backend.ListAll(ctx, prefix)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		for _, o := range objects {
+			if o.IsDirectory {
+				continue
+			}
+			actual += o.Size
+			files++
+		}
+	}
+
+	if err := ensureQuota(ctx, db, userID, agentID); err != nil {
+		return 0, 0, false, err
+	}
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var q models.WorkspaceQuota
+		err := tx.NewSelect().Model(&q).
+			Where("user_id = ? AND agent_id = ?", userID, agentID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		old = q.UsedBytes
+		if q.UsedBytes == actual && q.FileCount == files {
+			return nil
+		}
+		changed = true
+		_, err = tx.NewUpdate().Model((*models.WorkspaceQuota)(nil)).
+			Set("used_bytes = ?", actual).
+			Set("file_count = ?", files).
+			Set("updated_at = now()").
+			Where("user_id = ? AND agent_id = ?", userID, agentID).
+			Exec(ctx)
+		return err
+	})
+	return old, actual, changed, err
+}
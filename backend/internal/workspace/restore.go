@@ -12,7 +12,8 @@ import (
 	"github.com/uptrace/bun"
 )
 
-// RestoreOutputFiles downloads output files from S3 and writes them back into the pod.
+// RestoreOutputFiles streams output files from S3 back into the pod as a
+// single tar archive.
 // Called during session creation to restore workspace state after pod restart.
 func RestoreOutputFiles(ctx context.Context, db *bun.DB, provider *storage.StorageProvider, cm *container.Manager, userID, agentID int64) error {
 	backend := provider.GetClient(ctx)
@@ -27,6 +28,7 @@ func RestoreOutputFiles(ctx context.Context, db *bun.DB, provider *storage.Stora
 		Where("agent_id = ?", agentID).
 		Where("workspace_type = ?", "output").
 		Where("is_directory = ?", false).
+		OrderExpr("file_path ASC").
 		Scan(ctx)
 	if err != nil {
 		return fmt.Errorf("query output files: %w", err)
@@ -36,34 +38,79 @@ func RestoreOutputFiles(ctx context.Context, db *bun.DB, provider *storage.Stora
 		return nil
 	}
 
-	userIDStr := fmt.Sprintf("%d", userID)
-	podName := fmt.Sprintf("claude-code-%s-%d-0", userIDStr, agentID)
-
 	log.Info().Int64("user_id", userID).Int64("agent_id", agentID).Int("count", len(files)).Msg("restoring output files to pod")
 
-	var restored int
+	entries := make([]tarEntry, 0, len(files))
 	for _, f := range files {
-		body, err := backend.Download(ctx, f.OSSKey)
-		if err != nil {
-			log.Warn().Err(err).Str("key", f.OSSKey).Msg("skip: failed to download output file")
-			continue
-		}
-
-		data, err := io.ReadAll(body)
-		body.Close()
-		if err != nil {
-			log.Warn().Err(err).Str("key", f.OSSKey).Msg("skip: failed to read output file")
-			continue
-		}
-
-		podPath := fmt.Sprintf("/workspace/output/%s", f.FilePath)
-		if err := cm.WriteFileInPod(ctx, podName, podPath, string(data)); err != nil {
-			log.Warn().Err(err).Str("path", podPath).Msg("skip: failed to write output file to pod")
-			continue
-		}
-		restored++
+		entries = append(entries, tarEntry{
+			path: outputOSSPrefix + "/" + f.FilePath,
+			size: f.SizeBytes,
+			open: func() (io.ReadCloser, error) {
+				body, err := backend.Download(ctx, f.OSSKey)
+				if err != nil {
+					log.Warn().Err(err).Str("key", f.OSSKey).Msg("skip: failed to download output file")
+				}
+				return body, err
+			},
+		})
+	}
+
+	restored, err := streamTarToPod(ctx, cm, agentPodName(userID, agentID), entries)
+	if err != nil {
+		return fmt.Errorf("stream output files: %w", err)
 	}
 
 	log.Info().Int("restored", restored).Int("total", len(files)).Msg("output file restore complete")
 	return nil
 }
+
+// RestoreInputFiles streams the user's uploaded input files from S3 back into
+// a freshly created pod as a single tar archive.
+func RestoreInputFiles(ctx context.Context, db *bun.DB, provider *storage.StorageProvider, cm *container.Manager, userID, agentID int64) error {
+	backend := provider.GetClient(ctx)
+	if backend == nil {
+		return nil // storage not configured, nothing to restore
+	}
+
+	var files []models.WorkspaceFile
+	err := db.NewSelect().
+		Model(&files).
+		Where("user_id = ?", userID).
+		Where("agent_id = ?", agentID).
+		Where("workspace_type = ?", "input").
+		Where("is_directory = ?", false).
+		OrderExpr("file_path ASC").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("query input files: %w", err)
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	log.Info().Int64("user_id", userID).Int64("agent_id", agentID).Int("count", len(files)).Msg("restoring input files to pod")
+
+	entries := make([]tarEntry, 0, len(files))
+	for _, f := range files {
+		entries = append(entries, tarEntry{
+			path: f.FilePath,
+			size: f.SizeBytes,
+			open: func() (io.ReadCloser, error) {
+				body, err := backend.Download(ctx, f.OSSKey)
+				if err != nil {
+					log.Warn().Err(err).Str("key", f.OSSKey).Msg("skip: failed to download input file")
+				}
+				return body, err
+			},
+		})
+	}
+
+	restored, err := streamTarToPod(ctx, cm, agentPodName(userID, agentID), entries)
+	if err != nil {
+		return fmt.Errorf("stream input files: %w", err)
+	}
+
+	log.Info().Int("restored", restored).Int("total", len(files)).Msg("input file restore complete")
+	return nil
+}
@@ -2,29 +2,49 @@ package workspace
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path"
 	"strings"
 	"time"
 
 	sacv1 "g.echo.tech/dev/sac/gen/sac/v1"
+	"g.echo.tech/dev/sac/internal/container"
+	"g.echo.tech/dev/sac/internal/convert"
 	"g.echo.tech/dev/sac/internal/ctxkeys"
 	"g.echo.tech/dev/sac/internal/grpcerr"
 	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/internal/notify"
 	"g.echo.tech/dev/sac/internal/storage"
-	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Server implements WorkspaceServiceServer for output and sharing operations.
 type Server struct {
 	sacv1.UnimplementedWorkspaceServiceServer
-	db       *bun.DB
-	provider *storage.StorageProvider
-	hub      *OutputHub
+	db           *bun.DB
+	provider     *storage.StorageProvider
+	hub          *OutputHub
+	containerMgr *container.Manager
+	notifier     *notify.Notifier
+	shareGuard   *ShareGuard
 }
 
-func NewWorkspaceServer(db *bun.DB, provider *storage.StorageProvider, hub *OutputHub) *Server {
-	return &Server{db: db, provider: provider, hub: hub}
+func NewWorkspaceServer(db *bun.DB, provider *storage.StorageProvider, hub *OutputHub, containerMgr *container.Manager) *Server {
+	return &Server{db: db, provider: provider, hub: hub, containerMgr: containerMgr, shareGuard: NewShareGuard(nil)}
+}
+
+// SetNotifier enables user notifications for agent-side output changes.
+func (s *Server) SetNotifier(notifier *notify.Notifier) {
+	s.notifier = notifier
+}
+
+// SetShareGuard replaces the server's own share password guard, so it sees
+// the failures counted by the download route.
+func (s *Server) SetShareGuard(guard *ShareGuard) {
+	s.shareGuard = guard
 }
 
 func (s *Server) getOSS(ctx context.Context) (storage.StorageBackend, error) {
@@ -40,6 +60,25 @@ func (s *Server) GetStatus(ctx context.Context, _ *sacv1.Empty) (*sacv1.Workspac
 	return &sacv1.WorkspaceStatusResponse{Configured: configured}, nil
 }
 
+// GetWorkspaceQuota returns the caller's usage and limits for an agent.
+func (s *Server) GetWorkspaceQuota(ctx context.Context, req *sacv1.GetWorkspaceQuotaRequest) (*sacv1.WorkspaceQuota, error) {
+	userID := ctxkeys.UserID(ctx)
+	if !agentOwnedBy(ctx, s.db, userID, req.AgentId) {
+		return nil, grpcerr.NotFound("Agent not found")
+	}
+	if err := ensureQuota(ctx, s.db, userID, req.AgentId); err != nil {
+		return nil, grpcerr.Internal("Failed to load workspace quota", err)
+	}
+	var quota models.WorkspaceQuota
+	err := s.db.NewSelect().Model(&quota).
+		Where("user_id = ? AND agent_id = ?", userID, req.AgentId).
+		Scan(ctx)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to load workspace quota", err)
+	}
+	return convert.WorkspaceQuotaToProto(&quota), nil
+}
+
 // --- Output workspace ---
 
 func (s *Server) ListOutputFiles(ctx context.Context, req *sacv1.ListOutputFilesRequest) (*sacv1.FileListResponse, error) {
@@ -78,16 +117,16 @@ func (s *Server) DeleteOutputFile(ctx context.Context, req *sacv1.DeleteOutputFi
 		if err := oss.DeletePrefix(ctx, ossKey); err != nil {
 			return nil, grpcerr.Internal("Failed to delete directory", err)
 		}
-		_, _ = s.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("user_id = ? AND agent_id = ? AND workspace_type = 'output' AND oss_key LIKE ?", userID, req.AgentId, ossKey+"%").
-			Exec(ctx)
+		if err := forgetPath(ctx, s.db, userID, req.AgentId, "output", ossKey, true); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted files")
+		}
 	} else {
 		if err := oss.Delete(ctx, ossKey); err != nil {
 			return nil, grpcerr.Internal("Failed to delete file", err)
 		}
-		_, _ = s.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("oss_key = ?", ossKey).
-			Exec(ctx)
+		if err := forgetPath(ctx, s.db, userID, req.AgentId, "output", ossKey, false); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted file")
+		}
 	}
 
 	_, _ = s.db.NewDelete().Model((*models.SharedLink)(nil)).
@@ -105,6 +144,166 @@ func (s *Server) DeleteOutputFile(ctx context.Context, req *sacv1.DeleteOutputFi
 	return &sacv1.SuccessMessage{Message: "File deleted"}, nil
 }
 
+// --- Input workspace ---
+
+func (s *Server) ListInputFiles(ctx context.Context, req *sacv1.ListInputFilesRequest) (*sacv1.FileListResponse, error) {
+	oss, err := s.getOSS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := ctxkeys.UserID(ctx)
+
+	reqPath := sanitizePath(req.Path)
+	basePrefix := inputOSSKeyPrefix(userID, req.AgentId)
+
+	items, err := oss.List(ctx, basePrefix+reqPath, "/", 1000)
+	if err != nil {
+		return nil, grpcerr.Internal("Failed to list input files", err)
+	}
+
+	return &sacv1.FileListResponse{Path: reqPath, Files: storageItemsToProto(items, basePrefix)}, nil
+}
+
+func (s *Server) DeleteInputFile(ctx context.Context, req *sacv1.DeleteInputFileRequest) (*sacv1.SuccessMessage, error) {
+	oss, err := s.getOSS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := ctxkeys.UserID(ctx)
+
+	filePath := sanitizePath(req.Path)
+	if filePath == "" || path.Clean(filePath) == "." {
+		return nil, grpcerr.BadRequest("path is required")
+	}
+	ossKey := inputOSSKeyPrefix(userID, req.AgentId) + filePath
+
+	if strings.HasSuffix(filePath, "/") {
+		if err := oss.DeletePrefix(ctx, ossKey); err != nil {
+			return nil, grpcerr.Internal("Failed to delete directory", err)
+		}
+		if err := forgetPath(ctx, s.db, userID, req.AgentId, "input", ossKey, true); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted files")
+		}
+	} else {
+		if err := oss.Delete(ctx, ossKey); err != nil {
+			return nil, grpcerr.Internal("Failed to delete file", err)
+		}
+		if err := forgetPath(ctx, s.db, userID, req.AgentId, "input", ossKey, false); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted file")
+		}
+	}
+
+	// Best-effort removal from the running pod; a stopped pod simply won't
+	// get the file back on restore.
+	if s.containerMgr != nil {
+		podPath := workspaceRoot + "/" + path.Clean(filePath)
+		if err := s.containerMgr.RemovePathInPod(ctx, agentPodName(userID, req.AgentId), podPath); err != nil {
+			log.Debug().Err(err).Str("path", podPath).Msg("input file not removed from pod")
+		}
+	}
+
+	return &sacv1.SuccessMessage{Message: "File deleted"}, nil
+}
+
+// --- Live pod workspace ---
+
+// podTarget validates ownership and returns the agent's pod name.
+func (s *Server) podTarget(ctx context.Context, agentID int64) (string, error) {
+	if s.containerMgr == nil {
+		return "", grpcerr.Unavailable("Container manager not available")
+	}
+	userID := ctxkeys.UserID(ctx)
+	if !agentOwnedBy(ctx, s.db, userID, agentID) {
+		return "", grpcerr.NotFound("Agent not found")
+	}
+	return agentPodName(userID, agentID), nil
+}
+
+// podFileError converts pod file helper errors into gRPC errors.
+func podFileError(err error) error {
+	switch {
+	case errors.Is(err, errPodPathNotFound):
+		return grpcerr.NotFound("Path not found in workspace")
+	case errors.Is(err, errPodPathOutside):
+		return grpcerr.Forbidden("Path is outside /workspace")
+	case errors.Is(err, errPodNotDirectory), errors.Is(err, errPodNotFile):
+		return grpcerr.BadRequest(err.Error())
+	}
+	log.Debug().Err(err).Msg("pod file operation failed")
+	return grpcerr.Unavailable("Agent pod is not running. Start a session to browse its workspace.")
+}
+
+func (s *Server) ListPodFiles(ctx context.Context, req *sacv1.PodFileRequest) (*sacv1.FileListResponse, error) {
+	podName, err := s.podTarget(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+	rel := workspaceRelPath(req.Path)
+	files, err := listPodDir(ctx, s.containerMgr, podName, rel)
+	if err != nil {
+		return nil, podFileError(err)
+	}
+	return &sacv1.FileListResponse{Path: rel, Files: files}, nil
+}
+
+func (s *Server) ReadPodFile(ctx context.Context, req *sacv1.ReadPodFileRequest) (*sacv1.PodFileContent, error) {
+	podName, err := s.podTarget(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+	rel := workspaceRelPath(req.Path)
+	if rel == "" {
+		return nil, grpcerr.BadRequest("path is required")
+	}
+	content, err := readPodFile(ctx, s.containerMgr, podName, rel, req.MaxBytes)
+	if err != nil {
+		return nil, podFileError(err)
+	}
+	return content, nil
+}
+
+func (s *Server) DeletePodFile(ctx context.Context, req *sacv1.PodFileRequest) (*sacv1.SuccessMessage, error) {
+	podName, err := s.podTarget(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+	rel := workspaceRelPath(req.Path)
+	if rel == "" {
+		return nil, grpcerr.BadRequest("Refusing to delete the workspace root")
+	}
+	if err := deletePodPath(ctx, s.containerMgr, podName, rel); err != nil {
+		return nil, podFileError(err)
+	}
+
+	// Uploaded input files would otherwise come back on the next restore.
+	// Output files are removed from storage by the sidecar watcher.
+	userID := ctxkeys.UserID(ctx)
+	var inputs []models.WorkspaceFile
+	_ = s.db.NewSelect().Model(&inputs).
+		Where("user_id = ? AND agent_id = ? AND workspace_type = 'input'", userID, req.AgentId).
+		Where("(file_path = ? OR file_path LIKE ?)", rel, rel+"/%").
+		Scan(ctx)
+	if len(inputs) > 0 {
+		if backend := s.provider.GetClient(ctx); backend != nil {
+			for _, f := range inputs {
+				_ = backend.Delete(ctx, f.OSSKey)
+			}
+		}
+		ids := make([]int64, len(inputs))
+		for i, f := range inputs {
+			ids[i] = f.ID
+		}
+		err := forgetFiles(ctx, s.db, func(q *bun.DeleteQuery) *bun.DeleteQuery {
+			return q.Where("id IN (?)", bun.In(ids))
+		})
+		if err != nil {
+			log.Warn().Err(err).Msg("failed to release deleted input files")
+		}
+	}
+
+	return &sacv1.SuccessMessage{Message: "Deleted"}, nil
+}
+
 // --- Sharing ---
 
 func (s *Server) CreateShare(ctx context.Context, req *sacv1.CreateShareRequest) (*sacv1.ShareResponse, error) {
@@ -116,44 +315,138 @@ func (s *Server) CreateShare(ctx context.Context, req *sacv1.CreateShareRequest)
 
 	filePath := sanitizePath(req.Path)
 	ossKey := outputOSSKeyPrefix(userID, req.AgentId) + filePath
+	now := time.Now()
 
-	var existing models.SharedLink
-	err := s.db.NewSelect().Model(&existing).
-		Where("user_id = ? AND agent_id = ? AND file_path = ?", userID, req.AgentId, filePath).
-		Scan(ctx)
-	if err == nil {
-		return &sacv1.ShareResponse{ShortCode: existing.ShortCode, Url: "/s/" + existing.ShortCode}, nil
+	link := &models.SharedLink{
+		UserID:       userID,
+		AgentID:      req.AgentId,
+		FilePath:     filePath,
+		OSSKey:       ossKey,
+		FileName:     path.Base(filePath),
+		MaxDownloads: int(req.MaxDownloads),
+		Access:       req.Access,
+		GroupIDs:     []int64{},
+		CreatedAt:    now,
+	}
+	if err := s.applyShareOptions(ctx, link, req, now); err != nil {
+		return nil, err
+	}
+
+	// A plain share reuses the file's live unrestricted link.
+	if link.Access == models.ShareAccessPublic && link.ExpiresAt == nil && link.MaxDownloads == 0 && req.Password == "" {
+		var existing models.SharedLink
+		err := s.db.NewSelect().Model(&existing).
+			Where("user_id = ? AND agent_id = ? AND file_path = ?", userID, req.AgentId, filePath).
+			Where("access = ? AND expires_at IS NULL AND max_downloads = 0 AND password_hash = ''", models.ShareAccessPublic).
+			Limit(1).
+			Scan(ctx)
+		if err == nil {
+			return shareResponse(&existing, now), nil
+		}
 	}
 
 	backend := s.provider.GetClient(ctx)
 	if backend == nil {
 		return nil, grpcerr.Unavailable("Storage not configured")
 	}
-	body, err := backend.Download(ctx, ossKey)
-	if err != nil {
+	if _, err := backend.GetObjectSize(ctx, ossKey); err != nil {
 		return nil, grpcerr.NotFound("File not found in output workspace", err)
 	}
-	body.Close()
 
-	shortCode := uuid.New().String()[:8]
-	fileName := path.Base(filePath)
-
-	link := &models.SharedLink{
-		ShortCode: shortCode,
-		UserID:    userID,
-		AgentID:   req.AgentId,
-		FilePath:  filePath,
-		OSSKey:    ossKey,
-		FileName:  fileName,
-		CreatedAt: time.Now(),
+	hash, err := hashSharePassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	link.PasswordHash = hash
+	if link.ShortCode, err = newShareCode(); err != nil {
+		return nil, grpcerr.Internal("Failed to generate share code", err)
 	}
 
-	_, err = s.db.NewInsert().Model(link).Exec(ctx)
-	if err != nil {
+	if _, err := s.db.NewInsert().Model(link).Exec(ctx); err != nil {
 		return nil, grpcerr.Internal("Failed to create share link", err)
 	}
 
-	return &sacv1.ShareResponse{ShortCode: shortCode, Url: "/s/" + shortCode}, nil
+	return shareResponse(link, now), nil
+}
+
+// applyShareOptions validates the expiry, download limit and audience of a
+// new link.
+func (s *Server) applyShareOptions(ctx context.Context, link *models.SharedLink, req *sacv1.CreateShareRequest, now time.Time) error {
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if !expiresAt.After(now) {
+			return grpcerr.BadRequest("expires_at must be in the future")
+		}
+		link.ExpiresAt = &expiresAt
+	}
+	if req.MaxDownloads < 0 {
+		return grpcerr.BadRequest("max_downloads must not be negative")
+	}
+
+	switch link.Access {
+	case "":
+		link.Access = models.ShareAccessPublic
+	case models.ShareAccessPublic, models.ShareAccessLogin:
+	case models.ShareAccessGroups:
+		seen := map[int64]bool{}
+		for _, id := range req.GroupIds {
+			if id > 0 && !seen[id] {
+				seen[id] = true
+				link.GroupIDs = append(link.GroupIDs, id)
+			}
+		}
+		if len(link.GroupIDs) == 0 || len(link.GroupIDs) > maxShareGroups {
+			return grpcerr.BadRequest(fmt.Sprintf("access groups needs between 1 and %d group_ids", maxShareGroups))
+		}
+		count, err := s.db.NewSelect().Model((*models.GroupMember)(nil)).
+			Where("user_id = ?", link.UserID).
+			Where("group_id IN (?)", bun.In(link.GroupIDs)).
+			Count(ctx)
+		if err != nil {
+			return grpcerr.Internal("Failed to check group membership", err)
+		}
+		if count != len(link.GroupIDs) {
+			return grpcerr.Forbidden("You can only share with groups you are a member of")
+		}
+	default:
+		return grpcerr.BadRequest("access must be public, login or groups")
+	}
+	if link.Access != models.ShareAccessGroups && len(req.GroupIds) > 0 {
+		return grpcerr.BadRequest("group_ids require access groups")
+	}
+	return nil
+}
+
+func shareResponse(link *models.SharedLink, now time.Time) *sacv1.ShareResponse {
+	return &sacv1.ShareResponse{
+		ShortCode: link.ShortCode,
+		Url:       "/s/" + link.ShortCode,
+		Link:      convert.SharedLinkToProto(link, now),
+	}
+}
+
+// ListMyShares lists the caller's links, newest first, including expired
+// ones so their download history stays visible.
+func (s *Server) ListMyShares(ctx context.Context, req *sacv1.ListMySharesRequest) (*sacv1.SharedLinkListResponse, error) {
+	userID := ctxkeys.UserID(ctx)
+
+	var links []models.SharedLink
+	q := s.db.NewSelect().Model(&links).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC")
+	if req.AgentId != 0 {
+		q = q.Where("agent_id = ?", req.AgentId)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, grpcerr.Internal("Failed to list share links", err)
+	}
+
+	now := time.Now()
+	out := make([]*sacv1.SharedLink, len(links))
+	for i := range links {
+		out[i] = convert.SharedLinkToProto(&links[i], now)
+	}
+	return &sacv1.SharedLinkListResponse{Links: out}, nil
 }
 
 func (s *Server) DeleteShare(ctx context.Context, req *sacv1.DeleteShareRequest) (*sacv1.SuccessMessage, error) {
@@ -178,18 +471,25 @@ func (s *Server) DeleteShare(ctx context.Context, req *sacv1.DeleteShareRequest)
 	return &sacv1.SuccessMessage{Message: "Share link deleted"}, nil
 }
 
+// GetSharedFileMeta describes a shared file. It is public; restricted
+// links are checked against the optional bearer token. A protected link
+// locked out by wrong passwords is reported as such.
 func (s *Server) GetSharedFileMeta(ctx context.Context, req *sacv1.GetSharedFileRequest) (*sacv1.SharedFileMeta, error) {
 	if req.Code == "" {
 		return nil, grpcerr.BadRequest("code is required")
 	}
 
-	var link models.SharedLink
-	err := s.db.NewSelect().Model(&link).Where("short_code = ?", req.Code).Scan(ctx)
+	link, err := loadShare(ctx, s.db, req.Code)
+	if err == nil {
+		err = authorizeShare(ctx, s.db, link, ctxkeys.UserID(ctx))
+	}
+	if err == nil && link.PasswordHash != "" && s.shareGuard.locked(ctx, link.ShortCode, time.Now()) {
+		err = errSharePasswordLocked
+	}
 	if err != nil {
-		return nil, grpcerr.NotFound("Link not found or expired")
+		return nil, shareGRPCError(err)
 	}
-
-	contentType := contentTypeByFilename(link.FileName)
+	go touchShare(s.db, link.ID)
 
 	var sizeBytes int64
 	_ = s.db.NewSelect().
@@ -198,11 +498,20 @@ func (s *Server) GetSharedFileMeta(ctx context.Context, req *sacv1.GetSharedFile
 		Where("oss_key = ?", link.OSSKey).
 		Scan(ctx, &sizeBytes)
 
-	return &sacv1.SharedFileMeta{
-		FileName:    link.FileName,
-		ContentType: contentType,
-		SizeBytes:   sizeBytes,
-	}, nil
+	meta := &sacv1.SharedFileMeta{
+		FileName:         link.FileName,
+		ContentType:      contentTypeByFilename(link.FileName),
+		SizeBytes:        sizeBytes,
+		RequiresPassword: link.PasswordHash != "",
+	}
+	if link.ExpiresAt != nil {
+		meta.ExpiresAt = timestamppb.New(*link.ExpiresAt)
+	}
+	if link.MaxDownloads > 0 {
+		remaining := int32(link.MaxDownloads - link.DownloadCount)
+		meta.DownloadsRemaining = &remaining
+	}
+	return meta, nil
 }
 
 func (s *Server) InternalOutputDelete(ctx context.Context, req *sacv1.InternalOutputDeleteRequest) (*sacv1.SuccessMessage, error) {
@@ -222,16 +531,16 @@ func (s *Server) InternalOutputDelete(ctx context.Context, req *sacv1.InternalOu
 		if err := oss.DeletePrefix(ctx, ossKey); err != nil {
 			return nil, grpcerr.Internal("Failed to delete directory", err)
 		}
-		_, _ = s.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("user_id = ? AND agent_id = ? AND workspace_type = 'output' AND oss_key LIKE ?", req.UserId, req.AgentId, ossKey+"%").
-			Exec(ctx)
+		if err := forgetPath(ctx, s.db, req.UserId, req.AgentId, "output", ossKey, true); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted files")
+		}
 	} else {
 		if err := oss.Delete(ctx, ossKey); err != nil {
 			return nil, grpcerr.Internal("Failed to delete file", err)
 		}
-		_, _ = s.db.NewDelete().Model((*models.WorkspaceFile)(nil)).
-			Where("oss_key = ?", ossKey).
-			Exec(ctx)
+		if err := forgetPath(ctx, s.db, req.UserId, req.AgentId, "output", ossKey, false); err != nil {
+			log.Warn().Err(err).Str("oss_key", ossKey).Msg("failed to release deleted file")
+		}
 	}
 
 	if s.hub != nil {
@@ -241,6 +550,7 @@ func (s *Server) InternalOutputDelete(ctx context.Context, req *sacv1.InternalOu
 			Name:   path.Base(filePath),
 		})
 	}
+	notifyOutput(s.notifier, req.UserId, req.AgentId, notify.EventOutputDeleted, filePath, 0)
 
 	return &sacv1.SuccessMessage{Message: "File deleted"}, nil
 }
@@ -0,0 +1,292 @@
+package workspace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"g.echo.tech/dev/sac/internal/grpcerr"
+	"g.echo.tech/dev/sac/internal/models"
+	"g.echo.tech/dev/sac/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SharePasswordHeader carries the password when downloading a protected link.
+const SharePasswordHeader = "X-Share-Password"
+
+const (
+	shareCodeBytes    = 16 // 22 URL-safe characters
+	maxShareGroups    = 20
+	minSharePassword  = 8
+	maxSharePassword  = 72 // bcrypt limit
+	shareTouchTimeout = 5 * time.Second
+
+	// A link refuses passwords after maxSharePasswordFailures wrong ones
+	// until sharePasswordLockout has passed without another attempt.
+	maxSharePasswordFailures = 5
+	sharePasswordLockout     = 15 * time.Minute
+)
+
+var (
+	ErrShareNotFound          = errors.New("link not found or expired")
+	errShareLoginRequired     = errors.New("log in to open this link")
+	errShareForbidden         = errors.New("this link is restricted to members of specific groups")
+	errSharePasswordRequired  = errors.New("this link is password protected")
+	errSharePasswordIncorrect = errors.New("incorrect password")
+	errSharePasswordLocked    = errors.New("too many incorrect passwords, try again later")
+)
+
+// newShareCode returns an unguessable URL-safe code.
+func newShareCode() (string, error) {
+	b := make([]byte, shareCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSharePassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	if len(password) < minSharePassword || len(password) > maxSharePassword {
+		return "", grpcerr.BadRequest(fmt.Sprintf("password must be between %d and %d characters", minSharePassword, maxSharePassword))
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", grpcerr.Internal("Failed to hash password", err)
+	}
+	return string(hash), nil
+}
+
+// loadShare returns a usable link; expired and exhausted links are
+// reported as not found.
+func loadShare(ctx context.Context, db *bun.DB, code string) (*models.SharedLink, error) {
+	var link models.SharedLink
+	if err := db.NewSelect().Model(&link).Where("short_code = ?", code).Scan(ctx); err != nil {
+		return nil, ErrShareNotFound
+	}
+	if link.Expired(time.Now()) {
+		return nil, ErrShareNotFound
+	}
+	return &link, nil
+}
+
+// authorizeShare checks the link's audience for the viewer (0 when not
+// logged in). Owners can always open their own links.
+func authorizeShare(ctx context.Context, db *bun.DB, link *models.SharedLink, userID int64) error {
+	if userID != 0 && userID == link.UserID {
+		return nil
+	}
+	switch link.Access {
+	case models.ShareAccessLogin:
+		if userID == 0 {
+			return errShareLoginRequired
+		}
+	case models.ShareAccessGroups:
+		if userID == 0 {
+			return errShareLoginRequired
+		}
+		member, err := db.NewSelect().Model((*models.GroupMember)(nil)).
+			Where("user_id = ?", userID).
+			Where("group_id IN (?)", bun.In(link.GroupIDs)).
+			Exists(ctx)
+		if err != nil || !member {
+			return errShareForbidden
+		}
+	}
+	return nil
+}
+
+// checkSharePassword verifies the password of a protected link, refusing
+// further guesses once the link is locked out.
+func checkSharePassword(ctx context.Context, failures *ShareGuard, link *models.SharedLink, password string) error {
+	if link.PasswordHash == "" {
+		return nil
+	}
+	if password == "" {
+		return errSharePasswordRequired
+	}
+	now := time.Now()
+	if failures.locked(ctx, link.ShortCode, now) {
+		return errSharePasswordLocked
+	}
+	if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+		failures.fail(ctx, link.ShortCode, now)
+		return errSharePasswordIncorrect
+	}
+	failures.reset(ctx, link.ShortCode)
+	return nil
+}
+
+// ShareGuard counts wrong passwords per link code. The counts live in
+// Redis when it is configured so every gateway replica sees them, and in
+// the guard otherwise, so the HTTP handler and gRPC server of a gateway
+// share one guard. Only codes of existing protected links are counted,
+// which bounds the local map.
+type ShareGuard struct {
+	rdb *redis.Client
+
+	mu    sync.Mutex
+	local map[string]*shareFailureWindow
+}
+
+type shareFailureWindow struct {
+	last  time.Time
+	count int
+}
+
+// NewShareGuard returns a guard counting in rdb, or locally when rdb is nil.
+func NewShareGuard(rdb *redis.Client) *ShareGuard {
+	return &ShareGuard{rdb: rdb, local: make(map[string]*shareFailureWindow)}
+}
+
+func shareFailureKey(code string) string {
+	return "sac:share:failures:" + code
+}
+
+// locked reports whether code has used up its attempts. Redis errors let
+// the attempt through; bcrypt still makes each guess slow.
+func (f *ShareGuard) locked(ctx context.Context, code string, now time.Time) bool {
+	if f.rdb != nil {
+		n, err := f.rdb.Get(ctx, shareFailureKey(code)).Int()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			log.Warn().Err(err).Msg("failed to read share password failures")
+		}
+		return n >= maxSharePasswordFailures
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w, ok := f.local[code]
+	return ok && now.Sub(w.last) < sharePasswordLockout && w.count >= maxSharePasswordFailures
+}
+
+// fail records a wrong password for code and restarts its lockout period.
+func (f *ShareGuard) fail(ctx context.Context, code string, now time.Time) {
+	if f.rdb != nil {
+		key := shareFailureKey(code)
+		pipe := f.rdb.TxPipeline()
+		pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, sharePasswordLockout)
+		if _, err := pipe.Exec(ctx); err != nil {
+			log.Warn().Err(err).Msg("failed to record share password failure")
+		}
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w, ok := f.local[code]
+	if !ok || now.Sub(w.last) >= sharePasswordLockout {
+		// Drop expired windows of other links while we are here.
+		for c, other := range f.local {
+			if now.Sub(other.last) >= sharePasswordLockout {
+				delete(f.local, c)
+			}
+		}
+		w = &shareFailureWindow{}
+		f.local[code] = w
+	}
+	w.last = now
+	w.count++
+}
+
+// reset forgets the failures of code after a correct password.
+func (f *ShareGuard) reset(ctx context.Context, code string) {
+	if f.rdb != nil {
+		if err := f.rdb.Del(ctx, shareFailureKey(code)).Err(); err != nil {
+			log.Warn().Err(err).Msg("failed to reset share password failures")
+		}
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.local, code)
+}
+
+// claimShareDownload counts a download, failing when a concurrent request
+// used up the last allowed download or the link expired meanwhile.
+func claimShareDownload(ctx context.Context, db *bun.DB, link *models.SharedLink) error {
+	res, err := db.NewUpdate().Model((*models.SharedLink)(nil)).
+		Set("download_count = download_count + 1").
+		Set("last_accessed_at = now()").
+		Where("id = ?", link.ID).
+		Where("max_downloads = 0 OR download_count < max_downloads").
+		Where("expires_at IS NULL OR expires_at > now()").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// touchShare records a metadata view without counting a download.
+func touchShare(db *bun.DB, linkID int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), shareTouchTimeout)
+	defer cancel()
+	_, _ = db.NewUpdate().Model((*models.SharedLink)(nil)).
+		Set("last_accessed_at = now()").
+		Where("id = ?", linkID).
+		Exec(ctx)
+}
+
+// shareGRPCError maps share access errors to gRPC errors.
+func shareGRPCError(err error) error {
+	switch {
+	case errors.Is(err, ErrShareNotFound):
+		return grpcerr.NotFound("Link not found or expired")
+	case errors.Is(err, errShareLoginRequired):
+		return grpcerr.Unauthorized(err.Error())
+	case errors.Is(err, errShareForbidden), errors.Is(err, errSharePasswordIncorrect):
+		return grpcerr.Forbidden(err.Error())
+	case errors.Is(err, errSharePasswordRequired):
+		return grpcerr.Unauthorized(err.Error())
+	case errors.Is(err, errSharePasswordLocked):
+		return grpcerr.TooManyRequests(err.Error())
+	}
+	return grpcerr.Internal("Failed to open shared link", err)
+}
+
+// shareHTTPError writes share access errors for the Gin download route.
+func shareHTTPError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrShareNotFound):
+		response.NotFound(c, "Link not found or expired")
+	case errors.Is(err, errShareLoginRequired), errors.Is(err, errSharePasswordRequired):
+		response.Unauthorized(c, err.Error())
+	case errors.Is(err, errShareForbidden), errors.Is(err, errSharePasswordIncorrect):
+		response.Forbidden(c, err.Error())
+	case errors.Is(err, errSharePasswordLocked):
+		response.Error(c, http.StatusTooManyRequests, err.Error())
+	default:
+		response.InternalError(c, "Failed to open shared link", err)
+	}
+}
+
+// optionalUserID returns the user of a valid bearer token, or 0.
+func (h *Handler) optionalUserID(c *gin.Context) int64 {
+	tokenStr, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || tokenStr == "" || h.jwt == nil {
+		return 0
+	}
+	claims, err := h.jwt.ValidateToken(tokenStr)
+	if err != nil {
+		return 0
+	}
+	return claims.UserID
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating session_shares table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS session_shares (
+				id         BIGSERIAL PRIMARY KEY,
+				session_id VARCHAR(255) NOT NULL,
+				owner_id   BIGINT NOT NULL,
+				mode       VARCHAR(20) NOT NULL DEFAULT 'read_only',
+				user_ids   BIGINT[] NOT NULL DEFAULT '{}',
+				group_id   BIGINT REFERENCES groups(id) ON DELETE CASCADE,
+				expires_at TIMESTAMPTZ,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_session_shares_session ON session_shares (session_id);
+			CREATE INDEX IF NOT EXISTS idx_session_shares_user_ids ON session_shares USING GIN (user_ids);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create session_shares table: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping session_shares table...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS session_shares`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
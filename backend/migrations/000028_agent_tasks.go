@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating agent_tasks table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS agent_tasks (
+				id                BIGSERIAL PRIMARY KEY,
+				task_id           VARCHAR(64) NOT NULL UNIQUE,
+				user_id           BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				agent_id          BIGINT NOT NULL REFERENCES agents(id) ON DELETE CASCADE,
+				prompt            TEXT NOT NULL,
+				allowed_tools     TEXT[] NOT NULL DEFAULT '{}',
+				timeout_seconds   INT NOT NULL DEFAULT 600,
+				status            VARCHAR(20) NOT NULL DEFAULT 'pending',
+				result            TEXT NOT NULL DEFAULT '',
+				error             TEXT NOT NULL DEFAULT '',
+				output_files      TEXT[] NOT NULL DEFAULT '{}',
+				cost_usd          DOUBLE PRECISION NOT NULL DEFAULT 0,
+				num_turns         INT NOT NULL DEFAULT 0,
+				claude_session_id VARCHAR(255) NOT NULL DEFAULT '',
+				started_at        TIMESTAMPTZ,
+				finished_at       TIMESTAMPTZ,
+				created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_agent_tasks_user_agent ON agent_tasks (user_id, agent_id, created_at DESC);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create agent_tasks table: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping agent_tasks table...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS agent_tasks`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
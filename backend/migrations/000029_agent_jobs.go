@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating agent_jobs table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS agent_jobs (
+				id              BIGSERIAL PRIMARY KEY,
+				user_id         BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				agent_id        BIGINT NOT NULL REFERENCES agents(id) ON DELETE CASCADE,
+				name            VARCHAR(255) NOT NULL,
+				schedule        VARCHAR(100) NOT NULL,
+				timezone        VARCHAR(64) NOT NULL DEFAULT 'UTC',
+				prompt          TEXT NOT NULL DEFAULT '',
+				skill_id        BIGINT REFERENCES skills(id) ON DELETE SET NULL,
+				skill_args      TEXT NOT NULL DEFAULT '',
+				allowed_tools   TEXT[] NOT NULL DEFAULT '{}',
+				timeout_seconds INT NOT NULL DEFAULT 600,
+				enabled         BOOLEAN NOT NULL DEFAULT true,
+				next_run_at     TIMESTAMPTZ,
+				last_run_at     TIMESTAMPTZ,
+				created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_agent_jobs_due ON agent_jobs (next_run_at) WHERE enabled;
+			CREATE INDEX IF NOT EXISTS idx_agent_jobs_user_agent ON agent_jobs (user_id, agent_id);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create agent_jobs table: %w", err)
+		}
+
+		fmt.Println("done")
+
+		fmt.Print(" [up migration] adding job_id and stdout columns to agent_tasks...")
+
+		_, err = db.ExecContext(ctx, `
+			ALTER TABLE agent_tasks ADD COLUMN IF NOT EXISTS job_id BIGINT REFERENCES agent_jobs(id) ON DELETE CASCADE;
+			ALTER TABLE agent_tasks ADD COLUMN IF NOT EXISTS stdout TEXT NOT NULL DEFAULT '';
+			CREATE INDEX IF NOT EXISTS idx_agent_tasks_job ON agent_tasks (job_id, created_at DESC) WHERE job_id IS NOT NULL;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add job columns to agent_tasks: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing job_id and stdout columns from agent_tasks...")
+
+		_, _ = db.ExecContext(ctx, `
+			ALTER TABLE agent_tasks DROP COLUMN IF EXISTS job_id;
+			ALTER TABLE agent_tasks DROP COLUMN IF EXISTS stdout;
+		`)
+
+		fmt.Println("done")
+
+		fmt.Print(" [down migration] dropping agent_jobs table...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS agent_jobs`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
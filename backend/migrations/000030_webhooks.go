@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating agent_webhooks and webhook_deliveries tables...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS agent_webhooks (
+				id                  BIGSERIAL PRIMARY KEY,
+				user_id             BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				agent_id            BIGINT NOT NULL REFERENCES agents(id) ON DELETE CASCADE,
+				name                VARCHAR(255) NOT NULL,
+				token               VARCHAR(64) NOT NULL UNIQUE,
+				secret              VARCHAR(128) NOT NULL,
+				signature_format    VARCHAR(20) NOT NULL DEFAULT 'hmac-sha256',
+				prompt_template     TEXT NOT NULL,
+				allowed_tools       TEXT[] NOT NULL DEFAULT '{}',
+				timeout_seconds     INT NOT NULL DEFAULT 600,
+				rate_limit_per_hour INT NOT NULL DEFAULT 60,
+				enabled             BOOLEAN NOT NULL DEFAULT true,
+				created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_agent_webhooks_user_agent ON agent_webhooks (user_id, agent_id);
+
+			CREATE TABLE IF NOT EXISTS webhook_deliveries (
+				id              BIGSERIAL PRIMARY KEY,
+				webhook_id      BIGINT NOT NULL REFERENCES agent_webhooks(id) ON DELETE CASCADE,
+				status          VARCHAR(20) NOT NULL,
+				status_code     INT NOT NULL,
+				event           VARCHAR(255) NOT NULL DEFAULT '',
+				error           TEXT NOT NULL DEFAULT '',
+				task_id         VARCHAR(64) NOT NULL DEFAULT '',
+				payload_excerpt TEXT NOT NULL DEFAULT '',
+				remote_addr     VARCHAR(255) NOT NULL DEFAULT '',
+				received_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries (webhook_id, received_at DESC);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create webhook tables: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping webhook tables...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP TABLE IF EXISTS webhook_deliveries;
+			DROP TABLE IF EXISTS agent_webhooks;
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating notification_channels table and seeding SMTP settings...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS notification_channels (
+				id           BIGSERIAL PRIMARY KEY,
+				user_id      BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				name         VARCHAR(255) NOT NULL,
+				type         VARCHAR(20) NOT NULL,
+				target       TEXT NOT NULL,
+				secret       VARCHAR(255) NOT NULL DEFAULT '',
+				events       TEXT[] NOT NULL DEFAULT '{}',
+				enabled      BOOLEAN NOT NULL DEFAULT true,
+				last_sent_at TIMESTAMPTZ,
+				last_error   TEXT NOT NULL DEFAULT '',
+				created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_notification_channels_user ON notification_channels (user_id);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create notification_channels: %w", err)
+		}
+
+		for _, kv := range []struct{ key, value, desc string }{
+			{"smtp_host", `""`, "SMTP server host for email notifications (empty = email disabled)"},
+			{"smtp_port", `"587"`, "SMTP server port (465 = implicit TLS, otherwise STARTTLS when offered)"},
+			{"smtp_username", `""`, "SMTP username (empty = no authentication)"},
+			{"smtp_password", `""`, "SMTP password"},
+			{"smtp_from", `""`, "Sender address for email notifications"},
+		} {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO system_settings (key, value, description)
+				VALUES (?, ?::jsonb, ?)
+				ON CONFLICT (key) DO NOTHING
+			`, kv.key, kv.value, kv.desc)
+			if err != nil {
+				return fmt.Errorf("failed to seed %s: %w", kv.key, err)
+			}
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping notification_channels and SMTP settings...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP TABLE IF EXISTS notification_channels;
+			DELETE FROM system_settings
+			WHERE key IN ('smtp_host', 'smtp_port', 'smtp_username', 'smtp_password', 'smtp_from');
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding session idle timeout settings...")
+
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE groups ADD COLUMN IF NOT EXISTS session_idle_timeout_minutes INT;
+			CREATE INDEX IF NOT EXISTS idx_sessions_status_last_active ON sessions (status, last_active);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add session_idle_timeout_minutes: %w", err)
+		}
+
+		for _, kv := range []struct{ key, value, desc string }{
+			{"session_idle_timeout_minutes", `"0"`, "Stop a session after this many minutes without terminal input (0 = never)"},
+			{"session_idle_warning_minutes", `"5"`, "Warn connected terminals this many minutes before the idle stop (0 = no warning)"},
+			{"session_idle_action", `"stop_process"`, "What to do with an idle session: stop_process | scale_down | none"},
+		} {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO system_settings (key, value, description)
+				VALUES (?, ?::jsonb, ?)
+				ON CONFLICT (key) DO NOTHING
+			`, kv.key, kv.value, kv.desc)
+			if err != nil {
+				return fmt.Errorf("failed to seed %s: %w", kv.key, err)
+			}
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing session idle timeout settings...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP INDEX IF EXISTS idx_sessions_status_last_active;
+			ALTER TABLE groups DROP COLUMN IF EXISTS session_idle_timeout_minutes;
+			DELETE FROM system_settings
+			WHERE key IN ('session_idle_timeout_minutes', 'session_idle_warning_minutes', 'session_idle_action');
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating workspace_uploads table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS workspace_uploads (
+				id TEXT PRIMARY KEY,
+				user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				agent_id BIGINT NOT NULL,
+				file_path TEXT NOT NULL,
+				content_type TEXT NOT NULL DEFAULT '',
+				size_bytes BIGINT NOT NULL,
+				received_bytes BIGINT NOT NULL DEFAULT 0,
+				chunk_count INT NOT NULL DEFAULT 0,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_workspace_uploads_user ON workspace_uploads (user_id, agent_id);
+			CREATE INDEX IF NOT EXISTS idx_workspace_uploads_updated ON workspace_uploads (updated_at);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create workspace_uploads table: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping workspace_uploads table...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS workspace_uploads`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
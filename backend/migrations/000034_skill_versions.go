@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating skill_versions table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS skill_versions (
+				id BIGSERIAL PRIMARY KEY,
+				skill_id BIGINT NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+				version INT NOT NULL,
+				skill_md TEXT NOT NULL DEFAULT '',
+				prompt TEXT NOT NULL DEFAULT '',
+				frontmatter JSONB NOT NULL DEFAULT '{}',
+				files JSONB NOT NULL DEFAULT '[]',
+				bundle_key TEXT NOT NULL DEFAULT '',
+				content_checksum TEXT NOT NULL DEFAULT '',
+				note TEXT NOT NULL DEFAULT '',
+				created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				UNIQUE (skill_id, version)
+			)
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create skill_versions table: %w", err)
+		}
+
+		// Seed one revision per existing skill from its current state. The
+		// bundle is attached on the next rebuild, before it is overwritten.
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO skill_versions (skill_id, version, prompt, frontmatter, files, content_checksum, note, created_by, created_at)
+			SELECT sk.id, sk.version, sk.prompt, sk.frontmatter,
+				COALESCE((
+					SELECT jsonb_agg(jsonb_build_object(
+						'filepath', sf.filepath, 'checksum', sf.checksum,
+						'size', sf.size, 'content_type', sf.content_type) ORDER BY sf.filepath)
+					FROM skill_files sf WHERE sf.skill_id = sk.id
+				), '[]'::jsonb),
+				sk.content_checksum, 'Initial revision', sk.created_by, sk.updated_at
+			FROM skills sk
+			ON CONFLICT (skill_id, version) DO NOTHING
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to seed skill_versions: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping skill_versions table...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS skill_versions`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding pinned_version to agent_skills...")
+
+		// NULL follows the latest revision; otherwise the agent stays on the
+		// pinned skill_versions entry until upgraded.
+		_, err := db.ExecContext(ctx, `ALTER TABLE agent_skills ADD COLUMN IF NOT EXISTS pinned_version INT`)
+		if err != nil {
+			return fmt.Errorf("failed to add agent_skills.pinned_version: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping agent_skills.pinned_version...")
+
+		_, _ = db.ExecContext(ctx, `ALTER TABLE agent_skills DROP COLUMN IF EXISTS pinned_version`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating skill_sources table...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS skill_sources (
+				id BIGSERIAL PRIMARY KEY,
+				name VARCHAR(255) NOT NULL,
+				repo_url TEXT NOT NULL,
+				branch VARCHAR(255) NOT NULL DEFAULT 'main',
+				subdir TEXT NOT NULL DEFAULT '',
+				auth_type VARCHAR(20) NOT NULL DEFAULT 'none',
+				credential TEXT NOT NULL DEFAULT '',
+				webhook_token VARCHAR(64) NOT NULL UNIQUE,
+				webhook_secret VARCHAR(128) NOT NULL DEFAULT '',
+				sync_interval_minutes INT NOT NULL DEFAULT 60,
+				is_public BOOLEAN NOT NULL DEFAULT false,
+				group_id BIGINT REFERENCES groups(id) ON DELETE SET NULL,
+				last_commit VARCHAR(64) NOT NULL DEFAULT '',
+				last_synced_at TIMESTAMPTZ,
+				last_error TEXT NOT NULL DEFAULT '',
+				sync_started_at TIMESTAMPTZ,
+				created_by BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			)
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create skill_sources table: %w", err)
+		}
+
+		// Skills pulled from a source are read-only in SAC. Deleting the
+		// source detaches them, after which they can be edited again.
+		_, err = db.ExecContext(ctx, `
+			ALTER TABLE skills
+				ADD COLUMN IF NOT EXISTS source_id BIGINT REFERENCES skill_sources(id) ON DELETE SET NULL,
+				ADD COLUMN IF NOT EXISTS source_path TEXT NOT NULL DEFAULT '',
+				ADD COLUMN IF NOT EXISTS source_commit VARCHAR(64) NOT NULL DEFAULT ''
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add skills source columns: %w", err)
+		}
+
+		_, _ = db.ExecContext(ctx, `CREATE UNIQUE INDEX IF NOT EXISTS idx_skills_source_path ON skills(source_id, source_path) WHERE source_id IS NOT NULL`)
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping skill_sources table...")
+
+		_, _ = db.ExecContext(ctx, `DROP INDEX IF EXISTS idx_skills_source_path`)
+		_, _ = db.ExecContext(ctx, `ALTER TABLE skills DROP COLUMN IF EXISTS source_id, DROP COLUMN IF EXISTS source_path, DROP COLUMN IF EXISTS source_commit`)
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS skill_sources`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating skill_reviews and skill_audit_events tables...")
+
+		// published_version is the approved revision other users see. Skills
+		// that were already shared keep their current version published.
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE skills ADD COLUMN IF NOT EXISTS published_version INT;
+			UPDATE skills SET published_version = version
+			WHERE published_version IS NULL
+				AND (is_official OR is_public OR group_id IS NOT NULL);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add skills.published_version: %w", err)
+		}
+
+		_, err = db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS skill_reviews (
+				id             BIGSERIAL PRIMARY KEY,
+				skill_id       BIGINT NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+				version        INT NOT NULL,
+				target         VARCHAR(20) NOT NULL,
+				group_id       BIGINT REFERENCES groups(id) ON DELETE CASCADE,
+				status         VARCHAR(20) NOT NULL DEFAULT 'pending',
+				comment        TEXT NOT NULL DEFAULT '',
+				submitted_by   BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				reviewed_by    BIGINT REFERENCES users(id) ON DELETE SET NULL,
+				review_comment TEXT NOT NULL DEFAULT '',
+				reviewed_at    TIMESTAMPTZ,
+				created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE UNIQUE INDEX IF NOT EXISTS idx_skill_reviews_pending ON skill_reviews (skill_id) WHERE status = 'pending';
+			CREATE INDEX IF NOT EXISTS idx_skill_reviews_status ON skill_reviews (status, created_at);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create skill_reviews table: %w", err)
+		}
+
+		_, err = db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS skill_audit_events (
+				id         BIGSERIAL PRIMARY KEY,
+				skill_id   BIGINT NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+				review_id  BIGINT REFERENCES skill_reviews(id) ON DELETE SET NULL,
+				actor_id   BIGINT REFERENCES users(id) ON DELETE SET NULL,
+				action     VARCHAR(30) NOT NULL,
+				version    INT NOT NULL DEFAULT 0,
+				comment    TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_skill_audit_events_skill ON skill_audit_events (skill_id, created_at);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create skill_audit_events table: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping skill_reviews and skill_audit_events tables...")
+
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS skill_audit_events`)
+		_, _ = db.ExecContext(ctx, `DROP TABLE IF EXISTS skill_reviews`)
+		_, _ = db.ExecContext(ctx, `ALTER TABLE skills DROP COLUMN IF EXISTS published_version`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
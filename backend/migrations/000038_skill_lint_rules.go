@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] seeding skill lint rules setting...")
+
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO system_settings (key, value, description)
+			VALUES ('skill_lint_rules', ?::jsonb, ?)
+			ON CONFLICT (key) DO NOTHING
+		`, `{"rules":{},"extra_tools":[],"allowed_models":[],"max_skill_md_bytes":102400,"max_file_bytes":10485760}`,
+			"Skill validation: per-rule severity overrides (error | warning | off), extra allowed tools, allowed models and size limits")
+		if err != nil {
+			return fmt.Errorf("failed to seed skill_lint_rules: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing skill lint rules setting...")
+
+		_, _ = db.ExecContext(ctx, `DELETE FROM system_settings WHERE key = 'skill_lint_rules'`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
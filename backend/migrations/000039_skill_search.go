@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding skill search, tags and invocation counts...")
+
+		// The 'simple' configuration does no stemming, so it works for
+		// skills written in any language.
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE skills ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+			ALTER TABLE skills ADD COLUMN IF NOT EXISTS invocation_count INT NOT NULL DEFAULT 0;
+			ALTER TABLE skills ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+				setweight(to_tsvector('simple', coalesce(description, '')), 'B') ||
+				setweight(to_tsvector('simple', coalesce(prompt, '')), 'C')
+			) STORED;
+			CREATE INDEX IF NOT EXISTS idx_skills_search_vector ON skills USING GIN (search_vector);
+			CREATE INDEX IF NOT EXISTS idx_skills_tags ON skills USING GIN (tags);
+			CREATE INDEX IF NOT EXISTS idx_skills_forked_from ON skills (forked_from);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add skill search columns: %w", err)
+		}
+
+		// Backfill invocation counts from the slash commands already in the
+		// conversation history.
+		_, err = db.ExecContext(ctx, `
+			UPDATE skills SET invocation_count = c.n
+			FROM (
+				SELECT substring(content FROM '<command-name>/?([A-Za-z0-9_:-]+)</command-name>') AS cmd, COUNT(*) AS n
+				FROM conversation_histories
+				WHERE role = 'user' AND content LIKE '%<command-name>%'
+				GROUP BY 1
+			) c
+			WHERE c.cmd = skills.command_name
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to backfill skill invocation counts: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing skill search, tags and invocation counts...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP INDEX IF EXISTS idx_skills_forked_from;
+			DROP INDEX IF EXISTS idx_skills_tags;
+			DROP INDEX IF EXISTS idx_skills_search_vector;
+			ALTER TABLE skills DROP COLUMN IF EXISTS search_vector;
+			ALTER TABLE skills DROP COLUMN IF EXISTS invocation_count;
+			ALTER TABLE skills DROP COLUMN IF EXISTS tags;
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding skills.upstream_version...")
+
+		// upstream_version is the revision of the forked_from skill a fork is
+		// based on. Existing forks are based on the newest upstream revision
+		// recorded before they were created.
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE skills ADD COLUMN IF NOT EXISTS upstream_version INT;
+			UPDATE skills AS f SET upstream_version = (
+				SELECT MAX(v.version) FROM skill_versions AS v
+				WHERE v.skill_id = f.forked_from AND v.created_at <= f.created_at
+			)
+			WHERE f.forked_from IS NOT NULL AND f.upstream_version IS NULL;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add skills.upstream_version: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping skills.upstream_version...")
+
+		_, _ = db.ExecContext(ctx, `ALTER TABLE skills DROP COLUMN IF EXISTS upstream_version`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
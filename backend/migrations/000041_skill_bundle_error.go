@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding skills.bundle_error...")
+
+		// bundle_error records why the last bundle build failed. The skill
+		// keeps serving its previous bundle and version until a build succeeds.
+		_, err := db.ExecContext(ctx, `ALTER TABLE skills ADD COLUMN IF NOT EXISTS bundle_error TEXT NOT NULL DEFAULT ''`)
+		if err != nil {
+			return fmt.Errorf("failed to add skills.bundle_error: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping skills.bundle_error...")
+
+		_, _ = db.ExecContext(ctx, `ALTER TABLE skills DROP COLUMN IF EXISTS bundle_error`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
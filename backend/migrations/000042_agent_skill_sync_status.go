@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding sync status to agent_skills...")
+
+		// sync_attempts counts consecutive failures; the maintenance job does
+		// not retry a failed installation before next_sync_retry_at.
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE agent_skills
+				ADD COLUMN IF NOT EXISTS last_sync_attempt_at TIMESTAMPTZ,
+				ADD COLUMN IF NOT EXISTS last_synced_at TIMESTAMPTZ,
+				ADD COLUMN IF NOT EXISTS sync_error TEXT NOT NULL DEFAULT '',
+				ADD COLUMN IF NOT EXISTS sync_attempts INT NOT NULL DEFAULT 0,
+				ADD COLUMN IF NOT EXISTS next_sync_retry_at TIMESTAMPTZ;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add agent_skills sync status: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping sync status from agent_skills...")
+
+		_, _ = db.ExecContext(ctx, `
+			ALTER TABLE agent_skills
+				DROP COLUMN IF EXISTS last_sync_attempt_at,
+				DROP COLUMN IF EXISTS last_synced_at,
+				DROP COLUMN IF EXISTS sync_error,
+				DROP COLUMN IF EXISTS sync_attempts,
+				DROP COLUMN IF EXISTS next_sync_retry_at;
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating mcp_servers, agent_mcp_servers and user_secrets tables...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS mcp_servers (
+				id          BIGSERIAL PRIMARY KEY,
+				name        VARCHAR(64) NOT NULL,
+				description TEXT NOT NULL DEFAULT '',
+				transport   VARCHAR(10) NOT NULL,
+				command     TEXT NOT NULL DEFAULT '',
+				args        TEXT[] NOT NULL DEFAULT '{}',
+				url         TEXT NOT NULL DEFAULT '',
+				env         JSONB NOT NULL DEFAULT '{}',
+				headers     JSONB NOT NULL DEFAULT '{}',
+				is_official BOOLEAN NOT NULL DEFAULT false,
+				created_by  BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_mcp_servers_created_by ON mcp_servers (created_by);
+
+			CREATE TABLE IF NOT EXISTS agent_mcp_servers (
+				id            BIGSERIAL PRIMARY KEY,
+				agent_id      BIGINT NOT NULL REFERENCES agents(id) ON DELETE CASCADE,
+				mcp_server_id BIGINT NOT NULL REFERENCES mcp_servers(id) ON DELETE CASCADE,
+				created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				UNIQUE (agent_id, mcp_server_id)
+			);
+
+			CREATE TABLE IF NOT EXISTS user_secrets (
+				id         BIGSERIAL PRIMARY KEY,
+				user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				name       VARCHAR(64) NOT NULL,
+				value      TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				UNIQUE (user_id, name)
+			);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create mcp server tables: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping mcp server tables...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP TABLE IF EXISTS user_secrets;
+			DROP TABLE IF EXISTS agent_mcp_servers;
+			DROP TABLE IF EXISTS mcp_servers;
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] creating subagents and agent_subagents tables...")
+
+		_, err := db.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS subagents (
+				id            BIGSERIAL PRIMARY KEY,
+				name          VARCHAR(64) NOT NULL,
+				description   TEXT NOT NULL DEFAULT '',
+				tools         TEXT[] NOT NULL DEFAULT '{}',
+				model         VARCHAR(100) NOT NULL DEFAULT '',
+				system_prompt TEXT NOT NULL DEFAULT '',
+				is_official   BOOLEAN NOT NULL DEFAULT false,
+				is_public     BOOLEAN NOT NULL DEFAULT false,
+				group_id      BIGINT REFERENCES groups(id) ON DELETE SET NULL,
+				created_by    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+				created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX IF NOT EXISTS idx_subagents_created_by ON subagents (created_by);
+			CREATE INDEX IF NOT EXISTS idx_subagents_group_id ON subagents (group_id);
+
+			CREATE TABLE IF NOT EXISTS agent_subagents (
+				id          BIGSERIAL PRIMARY KEY,
+				agent_id    BIGINT NOT NULL REFERENCES agents(id) ON DELETE CASCADE,
+				subagent_id BIGINT NOT NULL REFERENCES subagents(id) ON DELETE CASCADE,
+				created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				UNIQUE (agent_id, subagent_id)
+			);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create subagent tables: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] dropping subagent tables...")
+
+		_, _ = db.ExecContext(ctx, `
+			DROP TABLE IF EXISTS agent_subagents;
+			DROP TABLE IF EXISTS subagents;
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
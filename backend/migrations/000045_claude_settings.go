@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding layered claude settings...")
+
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE agents ADD COLUMN IF NOT EXISTS claude_settings JSONB NOT NULL DEFAULT '{}';
+			ALTER TABLE groups ADD COLUMN IF NOT EXISTS claude_settings JSONB NOT NULL DEFAULT '{}';
+			INSERT INTO system_settings (key, value, description)
+			VALUES ('claude_settings', '{}'::jsonb, 'Platform default Claude Code settings (permissions, model, env, hooks) for all agents')
+			ON CONFLICT (key) DO NOTHING;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to add claude_settings: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing layered claude settings...")
+
+		_, _ = db.ExecContext(ctx, `
+			ALTER TABLE agents DROP COLUMN IF EXISTS claude_settings;
+			ALTER TABLE groups DROP COLUMN IF EXISTS claude_settings;
+			DELETE FROM system_settings WHERE key = 'claude_settings';
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] adding shared link expiry, password, limits and access control...")
+
+		_, err := db.ExecContext(ctx, `
+			ALTER TABLE shared_links ALTER COLUMN short_code TYPE VARCHAR(32);
+			ALTER TABLE shared_links
+				ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ,
+				ADD COLUMN IF NOT EXISTS password_hash TEXT NOT NULL DEFAULT '',
+				ADD COLUMN IF NOT EXISTS max_downloads INT NOT NULL DEFAULT 0,
+				ADD COLUMN IF NOT EXISTS download_count INT NOT NULL DEFAULT 0,
+				ADD COLUMN IF NOT EXISTS access VARCHAR(16) NOT NULL DEFAULT 'public',
+				ADD COLUMN IF NOT EXISTS group_ids BIGINT[] NOT NULL DEFAULT '{}',
+				ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to alter shared_links: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] removing shared link options...")
+
+		// Long codes do not fit the old column.
+		_, _ = db.ExecContext(ctx, `
+			DELETE FROM shared_links WHERE length(short_code) > 10;
+			ALTER TABLE shared_links
+				DROP COLUMN IF EXISTS expires_at,
+				DROP COLUMN IF EXISTS password_hash,
+				DROP COLUMN IF EXISTS max_downloads,
+				DROP COLUMN IF EXISTS download_count,
+				DROP COLUMN IF EXISTS access,
+				DROP COLUMN IF EXISTS group_ids,
+				DROP COLUMN IF EXISTS last_accessed_at;
+			ALTER TABLE shared_links ALTER COLUMN short_code TYPE VARCHAR(10);
+		`)
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [up migration] expiring legacy shared link codes...")
+
+		// Codes minted before 000046 are 8 characters and short enough to
+		// guess. Give the ones that never expire 30 days to be replaced.
+		_, err := db.ExecContext(ctx, `
+			UPDATE shared_links
+			SET expires_at = now() + INTERVAL '30 days'
+			WHERE length(short_code) <= 10 AND expires_at IS NULL;
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to expire legacy shared links: %w", err)
+		}
+
+		fmt.Println("done")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] keeping legacy shared link expiry...")
+
+		// The backfilled expiry cannot be told apart from one set by the
+		// owner, so it is left in place.
+
+		fmt.Println("done")
+		return nil
+	})
+}
@@ -12,6 +12,11 @@ type Config struct {
 	APIGatewayPort string
 	WSProxyPort    string
 
+	// WebSocket proxy limits (per replica, 0 = unlimited)
+	WSMaxConnections        int
+	WSMaxConnectionsPerUser int
+	WSDrainTimeoutSeconds   int
+
 	// Database
 	DBHost     string
 	DBPort     string
@@ -34,6 +39,9 @@ type Config struct {
 	// Redis
 	RedisURL string
 
+	// Notifications: let webhook/Slack channels target private addresses
+	NotifyAllowPrivateTargets bool
+
 	// Logging
 	LogLevel  string
 	LogFormat string
@@ -48,6 +56,11 @@ func Load() (*Config, error) {
 		APIGatewayPort: getEnv("API_GATEWAY_PORT", "8080"),
 		WSProxyPort:    getEnv("WS_PROXY_PORT", "8081"),
 
+		// WebSocket proxy limits
+		WSMaxConnections:        getEnvAsInt("WS_MAX_CONNECTIONS", 0),
+		WSMaxConnectionsPerUser: getEnvAsInt("WS_MAX_CONNECTIONS_PER_USER", 10),
+		WSDrainTimeoutSeconds:   getEnvAsInt("WS_DRAIN_TIMEOUT_SECONDS", 25),
+
 		// Database
 		DBHost:     getEnv("DB_HOST", "localhost"),
 		DBPort:     getEnv("DB_PORT", "5432"),
@@ -70,6 +83,9 @@ func Load() (*Config, error) {
 		// Redis
 		RedisURL: getEnv("REDIS_URL", ""),
 
+		// Notifications
+		NotifyAllowPrivateTargets: getEnvAsBool("NOTIFY_ALLOW_PRIVATE_TARGETS", false),
+
 		// Logging
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
@@ -83,6 +99,13 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value, err := strconv.ParseBool(getEnv(key, "")); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	valueStr := getEnv(key, "")
 	if value, err := strconv.Atoi(valueStr); err == nil {
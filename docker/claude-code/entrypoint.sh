@@ -54,12 +54,33 @@ fi
 # dtach -A: attach to session, create if not exists; -r winch: redraw on attach.
 DTACH_SOCKET="/tmp/claude.sock"
 
-# Wrapper script that dtach will run — auto-restarts claude on exit
+# Wrapper script that dtach will run — auto-restarts claude on exit.
+# When the platform stops an idle session it creates /tmp/claude-idle first;
+# the loop then waits until the marker is removed (session reopened) or the
+# user presses Enter, and resumes the previous conversation.
+# MCP servers installed on the agent are synced into sac-mcp.json by the
+# platform, which restarts claude whenever the file changes.
 cat > /tmp/claude-loop.sh <<'LOOP'
 #!/bin/bash
 cd /workspace
+args=()
 while true; do
-  claude
+  mcp=()
+  [ -f /root/.claude/sac-mcp.json ] && mcp=(--mcp-config /root/.claude/sac-mcp.json)
+  claude "${mcp[@]}" "${args[@]}"
+  args=()
+  if [ -f /tmp/claude-idle ]; then
+    echo
+    echo "Session stopped after inactivity. Press Enter to resume."
+    while [ -f /tmp/claude-idle ]; do
+      read -r -t 2 _; rc=$?
+      [ $rc -eq 0 ] && break
+      [ $rc -le 128 ] && sleep 2 # EOF, not a timeout
+    done
+    rm -f /tmp/claude-idle
+    args=(--continue)
+    continue
+  fi
   echo "Claude exited. Restarting in 2s..."
   sleep 2
 done
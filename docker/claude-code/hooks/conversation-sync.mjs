@@ -91,6 +91,7 @@ const payload = {
   user_id: USER_ID,
   agent_id: AGENT_ID,
   session_id: sessionId,
+  hook_event: input.hook_event_name || '',
   messages,
 };
 
@@ -15,6 +15,7 @@ import type {
 } from '../generated/sac/v1/admin'
 import type { GroupWithMemberCount, GroupMember, GroupListResponse, GroupMemberListResponse } from '../generated/sac/v1/group'
 import { normalizeInt64, normalizeInt64Array } from '../utils/proto'
+import { WORKSPACE_QUOTA_I64, type WorkspaceQuota } from './workspaceAPI'
 
 export type { SystemSetting, UserSetting, AdminUser, AdminConversation }
 export type AdminUserGroup = AdminGroupBrief
@@ -281,3 +282,50 @@ export async function updateAdminGroupTemplate(groupId: number, template: string
 export async function resetUserPassword(userId: number, newPassword: string): Promise<void> {
   await api.put(`/admin/users/${userId}/password`, { new_password: newPassword })
 }
+
+// ---- Workspace quotas ----
+
+export interface GroupWorkspaceQuota {
+  group_id: number
+  used_bytes: number
+  max_bytes: number
+  file_count: number
+  max_file_count: number
+  updated_at?: string
+}
+
+// The agent_id 0 entry, if present, is the user's default for new agents.
+export async function getUserWorkspaceQuotas(userId: number): Promise<WorkspaceQuota[]> {
+  const response = await api.get<{ quotas?: WorkspaceQuota[] }>(`/admin/users/${userId}/workspace-quotas`)
+  return normalizeInt64Array(response.data.quotas ?? [], [...WORKSPACE_QUOTA_I64])
+}
+
+// Sets the user's default and applies it to all of the user's agents.
+export async function setUserWorkspaceQuota(userId: number, maxBytes: number, maxFileCount: number): Promise<WorkspaceQuota> {
+  const response = await api.put<WorkspaceQuota>(`/admin/users/${userId}/workspace-quota`, {
+    max_bytes: maxBytes,
+    max_file_count: maxFileCount,
+  })
+  return normalizeInt64(response.data, [...WORKSPACE_QUOTA_I64])
+}
+
+export async function setAgentWorkspaceQuota(userId: number, agentId: number, maxBytes: number, maxFileCount: number): Promise<WorkspaceQuota> {
+  const response = await api.put<WorkspaceQuota>(`/admin/users/${userId}/agents/${agentId}/workspace-quota`, {
+    max_bytes: maxBytes,
+    max_file_count: maxFileCount,
+  })
+  return normalizeInt64(response.data, [...WORKSPACE_QUOTA_I64])
+}
+
+export async function getGroupWorkspaceQuota(groupId: number): Promise<GroupWorkspaceQuota> {
+  const response = await api.get<GroupWorkspaceQuota>(`/admin/groups/${groupId}/workspace-quota`)
+  return normalizeInt64(response.data, ['group_id', 'used_bytes', 'max_bytes'])
+}
+
+export async function setGroupWorkspaceQuota(groupId: number, maxBytes: number, maxFileCount: number): Promise<GroupWorkspaceQuota> {
+  const response = await api.put<GroupWorkspaceQuota>(`/admin/groups/${groupId}/workspace-quota`, {
+    max_bytes: maxBytes,
+    max_file_count: maxFileCount,
+  })
+  return normalizeInt64(response.data, ['group_id', 'used_bytes', 'max_bytes'])
+}
@@ -83,8 +83,51 @@ export const previewClaudeMD = async (id: number): Promise<ClaudeMDPreview> => {
 }
 
 // Install a skill to an agent
-export const installSkill = async (agentId: number, skillId: number): Promise<void> => {
-  await api.post(`/agents/${agentId}/skills`, { skill_id: skillId })
+export const installSkill = async (agentId: number, skillId: number, pinnedVersion?: number): Promise<void> => {
+  await api.post(`/agents/${agentId}/skills`, { skill_id: skillId, pinned_version: pinnedVersion })
+}
+
+// Installed skill with its version channel. pinned_version is unset when the
+// installation follows the latest version.
+export type PinnedAgentSkill = AgentSkill & { pinned_version?: number; update_available?: boolean }
+
+// Pin an installed skill to a version, or pass undefined to follow the latest
+export const setAgentSkillVersion = async (agentId: number, skillId: number, pinnedVersion?: number): Promise<PinnedAgentSkill> => {
+  const response = await api.put<PinnedAgentSkill>(`/agents/${agentId}/skills/${skillId}/version`, { pinned_version: pinnedVersion })
+  return normalizeInt64(response.data, [...AGENT_SKILL_I64])
+}
+
+// Upgrade an installed skill to its latest version
+export const upgradeAgentSkill = async (agentId: number, skillId: number): Promise<PinnedAgentSkill> => {
+  const response = await api.post<PinnedAgentSkill>(`/agents/${agentId}/skills/${skillId}/upgrade`)
+  return normalizeInt64(response.data, [...AGENT_SKILL_I64])
+}
+
+// Sync status of an installed skill in the agent pod. sync_error and
+// sync_attempts describe consecutive failures since the last success.
+export type SyncedAgentSkill = PinnedAgentSkill & {
+  last_sync_attempt_at?: string
+  last_synced_at?: string
+  sync_error?: string
+  sync_attempts?: number
+  next_sync_retry_at?: string
+}
+
+export interface AgentSyncStatus {
+  agent_id: number
+  skills: SyncedAgentSkill[]
+  failed: number
+  pending: number
+}
+
+export const getAgentSyncStatus = async (agentId: number): Promise<AgentSyncStatus> => {
+  const response = await api.get<AgentSyncStatus>(`/agents/${agentId}/sync-status`)
+  return {
+    agent_id: Number(response.data.agent_id),
+    skills: normalizeInt64Array(response.data.skills ?? [], [...AGENT_SKILL_I64]),
+    failed: response.data.failed ?? 0,
+    pending: response.data.pending ?? 0,
+  }
 }
 
 // Uninstall a skill from an agent
@@ -0,0 +1,79 @@
+import api from './api'
+import { normalizeInt64Array } from '../utils/proto'
+
+export type PermissionMode = 'default' | 'acceptEdits' | 'plan' | 'bypassPermissions'
+
+export interface ClaudeHook {
+  event: string
+  matcher?: string
+  command: string
+  timeout?: number
+}
+
+// One layer of Claude Code settings. Layers merge platform, then group,
+// then agent: deny and ask rules accumulate, denied rules cannot be
+// allowed again, and later layers win for mode, model and env.
+export interface ClaudeSettings {
+  allow?: string[]
+  deny?: string[]
+  ask?: string[]
+  default_mode?: PermissionMode | ''
+  disable_bypass_permissions?: boolean
+  model?: string
+  env?: Record<string, string>
+  hooks?: ClaudeHook[]
+}
+
+export interface ClaudeSettingsLayer {
+  source: 'platform' | 'group' | 'agent'
+  id?: number
+  name?: string
+  settings: ClaudeSettings
+}
+
+export interface ClaudeSettingsPreview {
+  layers?: ClaudeSettingsLayer[]
+  merged: ClaudeSettings
+  settings_json?: string
+  notes?: string[]
+}
+
+// Admin only.
+export const getPlatformClaudeSettings = async (): Promise<ClaudeSettings> => {
+  const response = await api.get<ClaudeSettings>('/admin/claude-settings')
+  return response.data
+}
+
+export const updatePlatformClaudeSettings = async (settings: ClaudeSettings): Promise<ClaudeSettings> => {
+  const response = await api.put<ClaudeSettings>('/admin/claude-settings', settings)
+  return response.data
+}
+
+export const getGroupClaudeSettings = async (groupId: number): Promise<ClaudeSettings> => {
+  const response = await api.get<ClaudeSettings>(`/groups/${groupId}/claude-settings`)
+  return response.data
+}
+
+// Group admins only.
+export const updateGroupClaudeSettings = async (groupId: number, settings: ClaudeSettings): Promise<ClaudeSettings> => {
+  const response = await api.put<ClaudeSettings>(`/groups/${groupId}/claude-settings`, settings)
+  return response.data
+}
+
+export const getAgentClaudeSettings = async (agentId: number): Promise<ClaudeSettings> => {
+  const response = await api.get<ClaudeSettings>(`/agents/${agentId}/claude-settings`)
+  return response.data
+}
+
+// Applied on the agent's next session start.
+export const updateAgentClaudeSettings = async (agentId: number, settings: ClaudeSettings): Promise<ClaudeSettings> => {
+  const response = await api.put<ClaudeSettings>(`/agents/${agentId}/claude-settings`, settings)
+  return response.data
+}
+
+export const previewAgentClaudeSettings = async (agentId: number): Promise<ClaudeSettingsPreview> => {
+  const response = await api.get<ClaudeSettingsPreview>(`/agents/${agentId}/claude-settings/preview`)
+  const preview = response.data
+  preview.layers = normalizeInt64Array(preview.layers ?? [], ['id'])
+  return preview
+}
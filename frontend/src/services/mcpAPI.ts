@@ -0,0 +1,108 @@
+import api from './api'
+import { normalizeInt64, normalizeInt64Array } from '../utils/proto'
+
+export type MCPTransport = 'stdio' | 'http' | 'sse'
+
+// MCP server catalogue entry. Env and header values may reference the
+// installing user's secrets as ${secret:NAME}.
+export interface MCPServer {
+  id: number
+  name: string
+  description?: string
+  transport: MCPTransport
+  command?: string
+  args?: string[]
+  url?: string
+  env?: Record<string, string>
+  headers?: Record<string, string>
+  is_official?: boolean
+  created_by: number
+  secret_refs?: string[]
+  created_at?: string
+  updated_at?: string
+}
+
+export interface MCPServerInput {
+  name: string
+  description?: string
+  transport: MCPTransport
+  command?: string
+  args?: string[]
+  url?: string
+  env?: Record<string, string>
+  headers?: Record<string, string>
+}
+
+export interface AgentMCPServer {
+  agent_id: number
+  mcp_server: MCPServer
+  missing_secrets?: string[]
+  created_at?: string
+}
+
+export interface UserSecret {
+  name: string
+  created_at?: string
+  updated_at?: string
+}
+
+const MCP_SERVER_I64 = ['id', 'created_by'] as const
+
+export const listMCPServers = async (): Promise<MCPServer[]> => {
+  const response = await api.get<{ mcp_servers?: MCPServer[] }>('/mcp-servers')
+  return normalizeInt64Array(response.data.mcp_servers ?? [], [...MCP_SERVER_I64])
+}
+
+export const createMCPServer = async (data: MCPServerInput): Promise<MCPServer> => {
+  const response = await api.post<MCPServer>('/mcp-servers', data)
+  return normalizeInt64(response.data, [...MCP_SERVER_I64])
+}
+
+// Admin only: official entries are visible to every user.
+export const adminCreateMCPServer = async (data: MCPServerInput): Promise<MCPServer> => {
+  const response = await api.post<MCPServer>('/admin/mcp-servers', data)
+  return normalizeInt64(response.data, [...MCP_SERVER_I64])
+}
+
+// Replaces the whole definition.
+export const updateMCPServer = async (id: number, data: MCPServerInput): Promise<MCPServer> => {
+  const response = await api.put<MCPServer>(`/mcp-servers/${id}`, data)
+  return normalizeInt64(response.data, [...MCP_SERVER_I64])
+}
+
+export const deleteMCPServer = async (id: number): Promise<void> => {
+  await api.delete(`/mcp-servers/${id}`)
+}
+
+export const listAgentMCPServers = async (agentId: number): Promise<AgentMCPServer[]> => {
+  const response = await api.get<{ mcp_servers?: AgentMCPServer[] }>(`/agents/${agentId}/mcp-servers`)
+  return (response.data.mcp_servers ?? []).map((s) => {
+    s.agent_id = Number(s.agent_id)
+    normalizeInt64(s.mcp_server, [...MCP_SERVER_I64])
+    return s
+  })
+}
+
+export const installMCPServer = async (agentId: number, mcpServerId: number): Promise<string> => {
+  const response = await api.post<{ message: string }>(`/agents/${agentId}/mcp-servers`, { mcp_server_id: mcpServerId })
+  return response.data.message
+}
+
+export const uninstallMCPServer = async (agentId: number, mcpServerId: number): Promise<void> => {
+  await api.delete(`/agents/${agentId}/mcp-servers/${mcpServerId}`)
+}
+
+// Secret values are write-only; only names and timestamps come back.
+export const listSecrets = async (): Promise<UserSecret[]> => {
+  const response = await api.get<{ secrets?: UserSecret[] }>('/secrets')
+  return response.data.secrets ?? []
+}
+
+export const setSecret = async (name: string, value: string): Promise<UserSecret> => {
+  const response = await api.put<UserSecret>(`/secrets/${encodeURIComponent(name)}`, { value })
+  return response.data
+}
+
+export const deleteSecret = async (name: string): Promise<void> => {
+  await api.delete(`/secrets/${encodeURIComponent(name)}`)
+}
@@ -39,9 +39,45 @@ export async function forkSkill(id: number): Promise<Skill> {
   return normalizeSkill(response.data)
 }
 
-export async function getPublicSkills(): Promise<Skill[]> {
-  const response = await api.get<SkillListResponse>('/skills/public')
-  return (response.data.skills ?? []).map(normalizeSkill)
+export type CatalogSkill = Skill & {
+  tags?: string[]
+  install_count?: number
+  fork_count?: number
+  invocation_count?: number
+}
+
+export type SkillCatalogSort = 'relevance' | 'name' | 'newest' | 'updated' | 'installs' | 'forks' | 'invocations'
+
+export interface SkillCatalogQuery {
+  query?: string
+  category?: string
+  tags?: string[]
+  sort?: SkillCatalogSort
+  limit?: number
+  offset?: number
+}
+
+export interface SkillCatalogPage {
+  skills: CatalogSkill[]
+  total: number
+}
+
+// Searches the public catalogue; relevance is the default sort with a query.
+export async function searchPublicSkills(q: SkillCatalogQuery = {}): Promise<SkillCatalogPage> {
+  const response = await api.get<{ skills?: CatalogSkill[]; total?: number }>('/skills/public', {
+    params: q,
+    paramsSerializer: { indexes: null }, // tags=a&tags=b
+  })
+  return { skills: (response.data.skills ?? []).map(normalizeSkill), total: response.data.total ?? 0 }
+}
+
+export async function getPublicSkills(): Promise<CatalogSkill[]> {
+  return (await searchPublicSkills({ limit: 200 })).skills
+}
+
+export async function listPublicSkillTags(): Promise<{ tag: string; count: number }[]> {
+  const response = await api.get<{ tags?: { tag: string; count: number }[] }>('/skills/public/tags')
+  return response.data.tags ?? []
 }
 
 export async function syncAgentSkills(agentId: number): Promise<void> {
@@ -85,3 +121,342 @@ export async function getSkillFileContent(skillId: number, filepath: string): Pr
   const response = await api.get<SkillFileContentResponse>(`/skills/${skillId}/files/content`, { params: { path: filepath } })
   return response.data
 }
+
+// --- Import / export ---
+
+export function skillExportUrl(skillId: number, format: 'zip' | 'tar' = 'zip'): string {
+  return `/api/skills/${skillId}/export?format=${format}`
+}
+
+// Import a skill directory archive (zip, tar or tar.gz). On a command name
+// collision the server picks a free name unless onConflict is 'fail'.
+export async function importSkill(
+  file: File, opts?: { commandName?: string; onConflict?: 'rename' | 'fail' }
+): Promise<Skill> {
+  const formData = new FormData()
+  formData.append('file', file)
+  if (opts?.commandName) formData.append('command_name', opts.commandName)
+  if (opts?.onConflict) formData.append('on_conflict', opts.onConflict)
+  const response = await api.post('/skills/import', formData, {
+    headers: { 'Content-Type': 'multipart/form-data' },
+  })
+  return normalizeSkill(response.data)
+}
+
+// --- Version history ---
+
+// bundle_error is set when the last bundle build failed; agents keep the
+// previous version until a build succeeds.
+export type BundledSkill = Skill & { bundle_error?: string }
+
+export interface SkillVersionFile {
+  filepath: string
+  checksum: string
+  size: number
+  content_type: string
+}
+
+export interface SkillVersion {
+  id: number
+  skill_id: number
+  version: number
+  skill_md: string
+  frontmatter?: SkillFrontmatter
+  files: SkillVersionFile[]
+  content_checksum: string
+  note: string
+  created_by?: number
+  created_at: string
+  restorable: boolean
+}
+
+export interface SkillFileChange {
+  filepath: string
+  change: 'added' | 'removed' | 'changed'
+  old_size: number
+  new_size: number
+}
+
+export interface SkillVersionDiff {
+  from_version: number
+  to_version: number
+  skill_md_changed: boolean
+  skill_md_diff: string
+  files: SkillFileChange[]
+}
+
+export async function listSkillVersions(skillId: number): Promise<SkillVersion[]> {
+  const response = await api.get<{ versions?: SkillVersion[] }>(`/skills/${skillId}/versions`)
+  return (response.data.versions ?? []).map(v => normalizeInt64(v, ['id', 'skill_id', 'created_by']))
+}
+
+export async function diffSkillVersions(skillId: number, fromVersion: number, toVersion = 0): Promise<SkillVersionDiff> {
+  const response = await api.get<SkillVersionDiff>(`/skills/${skillId}/versions/diff`, {
+    params: { from_version: fromVersion, to_version: toVersion },
+  })
+  return response.data
+}
+
+export async function rollbackSkill(skillId: number, version: number): Promise<Skill> {
+  const response = await api.post(`/skills/${skillId}/rollback`, { version })
+  return normalizeSkill(response.data)
+}
+
+// --- Git sources ---
+
+// Skill synced from a Git source. Such skills are read-only; edit them in the
+// repository instead.
+export type SourcedSkill = Skill & { source_id?: number; source_path?: string; source_commit?: string }
+
+export interface SkillSource {
+  id: number
+  name: string
+  repo_url: string
+  branch: string
+  subdir: string
+  auth_type: 'none' | 'token' | 'deploy_key'
+  has_credential: boolean
+  webhook_path: string
+  // Only returned by createSkillSource; configure it on the push webhook.
+  webhook_secret?: string
+  sync_interval_minutes: number
+  is_public: boolean
+  group_id?: number
+  last_commit: string
+  last_synced_at?: string
+  last_error: string
+  created_by: number
+  created_at: string
+  updated_at: string
+}
+
+export interface SkillSourceRequest {
+  name?: string
+  repo_url?: string
+  branch?: string
+  subdir?: string
+  auth_type?: SkillSource['auth_type']
+  credential?: string
+  sync_interval_minutes?: number
+  is_public?: boolean
+  group_id?: number
+}
+
+export interface SkillSourceSyncResult {
+  commit: string
+  created: number
+  updated: number
+  deleted: number
+  unchanged: number
+  errors?: string[]
+}
+
+const SKILL_SOURCE_I64 = ['id', 'group_id', 'created_by'] as const
+
+export async function listSkillSources(): Promise<SkillSource[]> {
+  const response = await api.get<{ sources?: SkillSource[] }>('/skill-sources')
+  return (response.data.sources ?? []).map(s => normalizeInt64(s, [...SKILL_SOURCE_I64]))
+}
+
+export async function createSkillSource(req: SkillSourceRequest): Promise<SkillSource> {
+  const response = await api.post<SkillSource>('/skill-sources', req)
+  return normalizeInt64(response.data, [...SKILL_SOURCE_I64])
+}
+
+export async function updateSkillSource(id: number, req: SkillSourceRequest): Promise<SkillSource> {
+  const response = await api.put<SkillSource>(`/skill-sources/${id}`, req)
+  return normalizeInt64(response.data, [...SKILL_SOURCE_I64])
+}
+
+// Deleting a source keeps its skills; they become regular editable skills.
+export async function deleteSkillSource(id: number): Promise<void> {
+  await api.delete(`/skill-sources/${id}`)
+}
+
+export async function syncSkillSource(id: number): Promise<SkillSourceSyncResult> {
+  const response = await api.post<SkillSourceSyncResult>(`/skill-sources/${id}/sync`)
+  return response.data
+}
+
+// --- Review workflow ---
+
+// Other users only see a skill's published_version, which is set once a
+// review is approved. Changing the audience unpublishes the skill.
+export type PublishedSkill = Skill & { published_version?: number }
+
+export type SkillReviewTarget = 'public' | 'group' | 'official'
+export type SkillReviewStatus = 'pending' | 'approved' | 'rejected' | 'withdrawn'
+
+export interface SkillReview {
+  id: number
+  skill_id: number
+  skill_name: string
+  command_name: string
+  version: number
+  target: SkillReviewTarget
+  group_id?: number
+  status: SkillReviewStatus
+  comment: string
+  submitted_by: number
+  submitter?: { id: number; username: string; display_name: string }
+  reviewed_by?: number
+  reviewer?: { id: number; username: string; display_name: string }
+  review_comment: string
+  reviewed_at?: string
+  created_at: string
+}
+
+export interface SkillAuditEvent {
+  id: number
+  skill_id: number
+  review_id?: number
+  actor_id?: number
+  actor?: { id: number; username: string; display_name: string }
+  action: 'review_submitted' | 'review_approved' | 'review_rejected' | 'review_withdrawn' | 'unpublished'
+  version: number
+  comment: string
+  created_at: string
+}
+
+const SKILL_REVIEW_I64 = ['id', 'skill_id', 'group_id', 'submitted_by', 'reviewed_by'] as const
+
+function normalizeReview(r: SkillReview): SkillReview {
+  return normalizeInt64(r, [...SKILL_REVIEW_I64])
+}
+
+export async function submitSkillReview(
+  skillId: number, target: SkillReviewTarget, opts?: { groupId?: number; comment?: string }
+): Promise<SkillReview> {
+  const response = await api.post<SkillReview>(`/skills/${skillId}/reviews`, {
+    target, group_id: opts?.groupId, comment: opts?.comment ?? '',
+  })
+  return normalizeReview(response.data)
+}
+
+export async function listSkillReviews(skillId: number): Promise<SkillReview[]> {
+  const response = await api.get<{ reviews?: SkillReview[] }>(`/skills/${skillId}/reviews`)
+  return (response.data.reviews ?? []).map(normalizeReview)
+}
+
+export async function listSkillAuditEvents(skillId: number): Promise<SkillAuditEvent[]> {
+  const response = await api.get<{ events?: SkillAuditEvent[] }>(`/skills/${skillId}/audit`)
+  return (response.data.events ?? []).map(e => normalizeInt64(e, ['id', 'skill_id', 'review_id', 'actor_id']))
+}
+
+// Reviews the current user can decide (all for admins, their groups' for group admins)
+export async function listSkillReviewQueue(status: SkillReviewStatus = 'pending'): Promise<SkillReview[]> {
+  const response = await api.get<{ reviews?: SkillReview[] }>('/skill-reviews', { params: { status } })
+  return (response.data.reviews ?? []).map(normalizeReview)
+}
+
+export async function approveSkillReview(reviewId: number, comment = ''): Promise<SkillReview> {
+  const response = await api.post<SkillReview>(`/skill-reviews/${reviewId}/approve`, { comment })
+  return normalizeReview(response.data)
+}
+
+// A comment explaining the rejection is required.
+export async function rejectSkillReview(reviewId: number, comment: string): Promise<SkillReview> {
+  const response = await api.post<SkillReview>(`/skill-reviews/${reviewId}/reject`, { comment })
+  return normalizeReview(response.data)
+}
+
+export async function withdrawSkillReview(reviewId: number): Promise<SkillReview> {
+  const response = await api.post<SkillReview>(`/skill-reviews/${reviewId}/withdraw`, {})
+  return normalizeReview(response.data)
+}
+
+export type SkillLintSeverity = 'error' | 'warning'
+
+export interface SkillLintIssue {
+  rule: string
+  severity: SkillLintSeverity
+  field: string
+  message: string
+}
+
+export interface SkillValidationResult {
+  valid: boolean
+  issues: SkillLintIssue[]
+}
+
+// Dry-run of the checks CreateSkill/UpdateSkill apply. Pass the id when
+// validating an edit so the skill's attached files are checked too.
+export async function validateSkill(skill: Partial<CreateSkillRequest>, id?: number): Promise<SkillValidationResult> {
+  const response = await api.post<SkillValidationResult>('/skills/validate', { id, skill })
+  return { valid: !!response.data.valid, issues: response.data.issues ?? [] }
+}
+
+// Lint issues attached to a rejected create/update (gRPC status details).
+export function skillLintIssuesFromError(err: unknown): SkillLintIssue[] {
+  const details = (err as { response?: { data?: { details?: Array<{ issues?: SkillLintIssue[] }> } } })
+    ?.response?.data?.details ?? []
+  return details.flatMap(d => d.issues ?? [])
+}
+
+export interface SkillInvocation {
+  invocation: string
+  arguments: string[]
+  sent: boolean
+}
+
+// Builds the slash command for parameter values. With agentId the command is
+// typed into that agent's live terminal (and run when submit is set).
+export async function renderSkillInvocation(
+  skillId: number,
+  values: Record<string, string>,
+  opts: { agentId?: number; submit?: boolean } = {},
+): Promise<SkillInvocation> {
+  const response = await api.post<SkillInvocation>(`/skills/${skillId}/invocation`, {
+    values,
+    agent_id: opts.agentId,
+    submit: opts.submit ?? false,
+  })
+  return { invocation: response.data.invocation, arguments: response.data.arguments ?? [], sent: !!response.data.sent }
+}
+
+// --- Upstream updates of forks ---
+
+export type ForkedSkill = Skill & { upstream_version?: number; upstream_latest_version?: number }
+
+export type SkillUpstreamChange = 'added' | 'changed' | 'removed' | 'unchanged'
+
+export interface SkillUpstreamFile {
+  filepath: string
+  upstream_change: SkillUpstreamChange
+  local_change: SkillUpstreamChange
+  resolution: 'take_upstream' | 'keep_local' | 'merge'
+}
+
+export interface SkillUpstreamStatus {
+  upstream_id: number
+  base_version?: number
+  upstream_version: number
+  update_available: boolean
+  upstream_diff: string
+  local_diff: string
+  files: SkillUpstreamFile[]
+  conflicts: string[]
+}
+
+export interface MergeUpstreamResult {
+  skill: ForkedSkill
+  merged_version: number
+  conflicts: string[]
+}
+
+export async function getSkillUpstream(skillId: number): Promise<SkillUpstreamStatus> {
+  const response = await api.get<SkillUpstreamStatus>(`/skills/${skillId}/upstream`)
+  const status = normalizeInt64(response.data, ['upstream_id'])
+  return { ...status, files: status.files ?? [], conflicts: status.conflicts ?? [] }
+}
+
+// Applies the upstream changes since the fork point. Regions both sides
+// edited are left with conflict markers and listed in conflicts.
+export async function mergeUpstream(skillId: number): Promise<MergeUpstreamResult> {
+  const response = await api.post<MergeUpstreamResult>(`/skills/${skillId}/upstream/merge`, {})
+  return {
+    skill: normalizeSkill(response.data.skill),
+    merged_version: response.data.merged_version,
+    conflicts: response.data.conflicts ?? [],
+  }
+}
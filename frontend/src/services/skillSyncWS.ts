@@ -11,6 +11,7 @@ export interface SkillSyncEvent {
   message: string
   current?: number
   total?: number
+  replay?: boolean   // rebuilt from the stored sync status on connect
 }
 
 /**
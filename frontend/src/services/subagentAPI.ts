@@ -0,0 +1,96 @@
+import api from './api'
+import type { UserBrief } from '../generated/sac/v1/common'
+import { normalizeInt64, normalizeInt64Array } from '../utils/proto'
+
+// Claude Code subagent definition, synced into agent pods as
+// .claude/agents/<name>.md. Skills with context: fork name it in their
+// `agent` frontmatter.
+export interface Subagent {
+  id: number
+  name: string
+  description: string
+  tools?: string[]
+  model?: string
+  system_prompt: string
+  is_official?: boolean
+  is_public?: boolean
+  group_id?: number
+  created_by: number
+  creator?: UserBrief
+  created_at?: string
+  updated_at?: string
+}
+
+export interface SubagentInput {
+  name: string
+  description: string
+  tools?: string[]
+  model?: string
+  system_prompt: string
+  is_public?: boolean
+  group_id?: number
+}
+
+export interface AgentSubagent {
+  agent_id: number
+  subagent?: Subagent
+  created_at?: string
+}
+
+const SUBAGENT_I64 = ['id', 'created_by', 'group_id'] as const
+
+function normalizeSubagent(s: Subagent): Subagent {
+  normalizeInt64(s, [...SUBAGENT_I64])
+  if (s.creator) normalizeInt64(s.creator, ['id'])
+  return s
+}
+
+// Own, official, public and group-shared subagents.
+export const listSubagents = async (): Promise<Subagent[]> => {
+  const response = await api.get<{ subagents?: Subagent[] }>('/subagents')
+  return (response.data.subagents ?? []).map(normalizeSubagent)
+}
+
+export const getSubagent = async (id: number): Promise<Subagent> => {
+  const response = await api.get<Subagent>(`/subagents/${id}`)
+  return normalizeSubagent(response.data)
+}
+
+export const createSubagent = async (data: SubagentInput): Promise<Subagent> => {
+  const response = await api.post<Subagent>('/subagents', data)
+  return normalizeSubagent(response.data)
+}
+
+// Admin only: official subagents are visible to every user.
+export const adminCreateSubagent = async (data: SubagentInput): Promise<Subagent> => {
+  const response = await api.post<Subagent>('/admin/subagents', data)
+  return normalizeSubagent(response.data)
+}
+
+// Replaces the whole definition.
+export const updateSubagent = async (id: number, data: SubagentInput): Promise<Subagent> => {
+  const response = await api.put<Subagent>(`/subagents/${id}`, data)
+  return normalizeSubagent(response.data)
+}
+
+export const deleteSubagent = async (id: number): Promise<void> => {
+  await api.delete(`/subagents/${id}`)
+}
+
+export const listAgentSubagents = async (agentId: number): Promise<AgentSubagent[]> => {
+  const response = await api.get<{ subagents?: AgentSubagent[] }>(`/agents/${agentId}/subagents`)
+  const items = normalizeInt64Array(response.data.subagents ?? [], ['agent_id'])
+  for (const item of items) {
+    if (item.subagent) normalizeSubagent(item.subagent)
+  }
+  return items
+}
+
+export const installSubagent = async (agentId: number, subagentId: number): Promise<void> => {
+  await api.post(`/agents/${agentId}/subagents`, { subagent_id: subagentId })
+}
+
+// Fails while an installed skill still runs in the subagent.
+export const uninstallSubagent = async (agentId: number, subagentId: number): Promise<void> => {
+  await api.delete(`/agents/${agentId}/subagents/${subagentId}`)
+}
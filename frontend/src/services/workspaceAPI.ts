@@ -2,11 +2,11 @@ import api, { getApiWsBaseUrl } from './api'
 import type { FileItem, FileListResponse } from '../generated/sac/v1/common'
 import type {
   ShareResponse,
-  SharedFileMeta,
+  SharedFileMeta as SharedFileBase,
 } from '../generated/sac/v1/workspace'
 import { normalizeInt64, normalizeInt64Array } from '../utils/proto'
 
-export type { FileListResponse, SharedFileMeta }
+export type { FileListResponse }
 export type WorkspaceFile = FileItem
 export type ListFilesResponse = FileListResponse
 
@@ -15,6 +15,27 @@ function normalizeFileList(data: FileListResponse): FileListResponse {
   return data
 }
 
+// ---- Quota ----
+
+// Storage used by an agent's input and output workspaces. Uploads that
+// would exceed max_bytes or max_file_count fail with HTTP 413.
+export interface WorkspaceQuota {
+  user_id: number
+  agent_id: number
+  used_bytes: number
+  max_bytes: number
+  file_count: number
+  max_file_count: number
+  updated_at?: string
+}
+
+export const WORKSPACE_QUOTA_I64 = ['user_id', 'agent_id', 'used_bytes', 'max_bytes'] as const
+
+export const getWorkspaceQuota = async (agentId: number): Promise<WorkspaceQuota> => {
+  const response = await api.get<WorkspaceQuota>('/workspace/quota', { params: { agent_id: agentId } })
+  return normalizeInt64(response.data, [...WORKSPACE_QUOTA_I64])
+}
+
 // ---- Output workspace ----
 
 export const uploadOutputFile = async (
@@ -65,6 +86,127 @@ export const deleteOutputFile = async (agentId: number, path: string): Promise<v
   await api.delete('/workspace/output/files', { params: { agent_id: agentId, path } })
 }
 
+// ---- Input workspace ----
+
+export interface InputFileResult {
+  file: { file_path: string; size_bytes: number }
+  pod_synced: boolean
+}
+
+interface InputUpload {
+  upload_id: string
+  offset: number
+  size_bytes: number
+  max_chunk_bytes: number
+}
+
+const SMALL_UPLOAD_LIMIT = 100 * 1024 * 1024
+
+/**
+ * Uploads a file into the agent's /workspace. `path` is relative to
+ * /workspace; empty or a trailing slash uploads into that directory
+ * (default: input/). Files over 100MB use the resumable chunked API,
+ * which retries each chunk from the server-reported offset.
+ */
+export const uploadInputFile = async (
+  agentId: number, file: File, path = '', onProgress?: (sent: number, total: number) => void,
+): Promise<InputFileResult> => {
+  if (file.size <= SMALL_UPLOAD_LIMIT) {
+    const formData = new FormData()
+    formData.append('file', file)
+    formData.append('agent_id', String(agentId))
+    formData.append('path', path)
+    const response = await api.post('/workspace/input/files', formData, {
+      headers: { 'Content-Type': 'multipart/form-data' },
+      onUploadProgress: (e) => onProgress?.(e.loaded, file.size),
+    })
+    return response.data
+  }
+
+  const created = await api.post('/workspace/input/uploads', {
+    agent_id: agentId, path, file_name: file.name, size_bytes: file.size, content_type: file.type,
+  })
+  const upload = normalizeInt64(created.data, ['offset', 'size_bytes', 'max_chunk_bytes']) as InputUpload
+  let offset = upload.offset
+  let retries = 0
+  while (offset < file.size) {
+    const chunk = file.slice(offset, offset + upload.max_chunk_bytes)
+    try {
+      const r = await api.put(`/workspace/input/uploads/${upload.upload_id}`, chunk, {
+        params: { offset },
+        headers: { 'Content-Type': 'application/octet-stream' },
+      })
+      offset = Number(r.data.offset)
+      retries = 0
+      onProgress?.(offset, file.size)
+    } catch {
+      if (++retries > 5) throw new Error('Upload failed')
+      await new Promise((resolve) => setTimeout(resolve, 1000 * retries))
+      const r = await api.get(`/workspace/input/uploads/${upload.upload_id}`)
+      offset = Number(r.data.offset)
+    }
+  }
+  const done = await api.post(`/workspace/input/uploads/${upload.upload_id}/complete`)
+  return done.data
+}
+
+export const listInputFiles = async (agentId: number, path = ''): Promise<FileListResponse> => {
+  const response = await api.get('/workspace/input/files', { params: { agent_id: agentId, path } })
+  return normalizeFileList(response.data)
+}
+
+export const deleteInputFile = async (agentId: number, path: string): Promise<void> => {
+  await api.delete('/workspace/input/files', { params: { agent_id: agentId, path } })
+}
+
+// ---- Live pod workspace ----
+
+export interface PodFileContent {
+  path: string
+  size: number
+  content: string
+  is_binary: boolean
+  truncated: boolean
+  content_type: string
+}
+
+export const listPodFiles = async (agentId: number, path = ''): Promise<FileListResponse> => {
+  const response = await api.get('/workspace/pod/files', { params: { agent_id: agentId, path } })
+  return normalizeFileList(response.data)
+}
+
+export const readPodFile = async (agentId: number, path: string, maxBytes?: number): Promise<PodFileContent> => {
+  const response = await api.get('/workspace/pod/files/content', {
+    params: { agent_id: agentId, path, max_bytes: maxBytes },
+  })
+  return normalizeInt64(response.data, ['size'])
+}
+
+export const deletePodFile = async (agentId: number, path: string): Promise<void> => {
+  await api.delete('/workspace/pod/files', { params: { agent_id: agentId, path } })
+}
+
+/** Downloads a file, or a directory as a zip, from the agent's live /workspace. */
+export const downloadPodFile = async (agentId: number, path: string, asZip = false): Promise<void> => {
+  const token = localStorage.getItem('token')
+  const baseUrl = api.defaults.baseURL
+  const zip = asZip ? '&archive=zip' : ''
+  const url = `${baseUrl}/workspace/pod/files/download?agent_id=${agentId}&path=${encodeURIComponent(path)}${zip}`
+  const r = await fetch(url, { headers: { Authorization: `Bearer ${token}` } })
+  if (!r.ok) throw new Error(`Download failed: ${r.status}`)
+  const blob = await r.blob()
+  const disposition = r.headers.get('Content-Disposition') || ''
+  const name = /filename="([^"]+)"/.exec(disposition)?.[1] || path.split('/').filter(Boolean).pop() || 'workspace'
+  const objUrl = URL.createObjectURL(blob)
+  const a = document.createElement('a')
+  a.href = objUrl
+  a.download = name
+  document.body.appendChild(a)
+  a.click()
+  a.remove()
+  URL.revokeObjectURL(objUrl)
+}
+
 // ---- Output workspace WebSocket watch ----
 
 export interface OutputWatchEvent {
@@ -130,18 +272,65 @@ export const watchOutputFiles = (
 
 // ---- Shared links ----
 
-export type ShareResult = ShareResponse
+export type ShareAccess = 'public' | 'login' | 'groups'
+
+export interface ShareOptions {
+  expires_at?: string // RFC 3339
+  password?: string
+  max_downloads?: number // 0 = unlimited
+  access?: ShareAccess
+  group_ids?: number[] // required for 'groups'
+}
+
+export interface SharedLink {
+  short_code: string
+  url: string
+  agent_id: number
+  file_path: string
+  file_name: string
+  expires_at?: string
+  has_password?: boolean
+  max_downloads?: number
+  download_count?: number
+  access: ShareAccess
+  group_ids?: number[]
+  last_accessed_at?: string
+  created_at?: string
+  expired?: boolean
+}
 
-export const shareOutputFile = async (agentId: number, path: string): Promise<ShareResult> => {
-  const response = await api.post('/workspace/output/share', { agent_id: agentId, path })
+export type ShareResult = ShareResponse & { link?: SharedLink }
+
+export interface SharedFileMeta extends SharedFileBase {
+  requires_password?: boolean
+  expires_at?: string
+  downloads_remaining?: number
+}
+
+// Without options the file's existing unrestricted link is reused.
+export const shareOutputFile = async (agentId: number, path: string, options: ShareOptions = {}): Promise<ShareResult> => {
+  const response = await api.post('/workspace/output/share', { agent_id: agentId, path, ...options })
   return response.data
 }
 
+export const listMyShares = async (agentId?: number): Promise<SharedLink[]> => {
+  const response = await api.get<{ links?: SharedLink[] }>('/workspace/output/shares', {
+    params: agentId ? { agent_id: agentId } : undefined,
+  })
+  const links = normalizeInt64Array(response.data.links ?? [], ['agent_id'])
+  for (const l of links) {
+    if (l.group_ids) l.group_ids = l.group_ids.map(Number)
+  }
+  return links
+}
+
 export const deleteShare = async (code: string): Promise<void> => {
   await api.delete(`/workspace/output/share/${code}`)
 }
 
-// Public endpoints (no auth required) — use raw fetch without JWT
+// Public endpoints — use raw fetch so an anonymous visitor is not sent to
+// the login page; the JWT is still attached when present so links limited
+// to logged-in users or groups can be opened.
 const getPublicApiBaseUrl = () => {
   if (import.meta.env.VITE_API_URL) {
     return import.meta.env.VITE_API_URL
@@ -154,19 +343,48 @@ const getPublicApiBaseUrl = () => {
   return `${protocol}//${window.location.host}/api`
 }
 
+const publicHeaders = (password?: string): Record<string, string> => {
+  const headers: Record<string, string> = {}
+  const token = localStorage.getItem('token')
+  if (token) headers.Authorization = `Bearer ${token}`
+  if (password) headers['X-Share-Password'] = password
+  return headers
+}
+
+// SharedLinkError carries the HTTP status: 401 = login or password
+// required, 403 = not allowed or wrong password, 404 = gone or expired,
+// 429 = too many wrong passwords.
+export class SharedLinkError extends Error {
+  constructor(public status: number, message: string) {
+    super(message)
+  }
+}
+
+const sharedLinkError = async (r: Response): Promise<SharedLinkError> => {
+  let msg = `Request failed: ${r.status}`
+  try {
+    const body = await r.json()
+    msg = body.message ?? body.error ?? msg
+  } catch {
+    // non-JSON error body
+  }
+  return new SharedLinkError(r.status, msg)
+}
+
 export const getSharedFile = async (code: string): Promise<SharedFileMeta> => {
   const baseUrl = getPublicApiBaseUrl()
-  const r = await fetch(`${baseUrl}/s/${code}`)
-  if (!r.ok) throw new Error(`Not found: ${r.status}`)
+  const r = await fetch(`${baseUrl}/s/${code}`, { headers: publicHeaders() })
+  if (!r.ok) throw await sharedLinkError(r)
   const json = await r.json()
   const meta = json.data ?? json
   return normalizeInt64(meta, ['size_bytes'])
 }
 
-export const fetchSharedFileBlob = async (code: string): Promise<Blob> => {
+// Each call counts as a download against the link's limit.
+export const fetchSharedFileBlob = async (code: string, password?: string): Promise<Blob> => {
   const baseUrl = getPublicApiBaseUrl()
-  const r = await fetch(`${baseUrl}/s/${code}/raw`)
-  if (!r.ok) throw new Error(`Not found: ${r.status}`)
+  const r = await fetch(`${baseUrl}/s/${code}/raw`, { headers: publicHeaders(password) })
+  if (!r.ok) throw await sharedLinkError(r)
   return r.blob()
 }
 